@@ -2,7 +2,11 @@
 
 package mocks
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
 
 // Publisher is an autogenerated mock type for the Publisher type
 type Publisher struct {
@@ -23,13 +27,13 @@ func (_m *Publisher) Close() error {
 	return r0
 }
 
-// Publish provides a mock function with given fields: body, contentType
-func (_m *Publisher) Publish(body []byte, contentType string) error {
-	ret := _m.Called(body, contentType)
+// Publish provides a mock function with given fields: ctx, body, contentType
+func (_m *Publisher) Publish(ctx context.Context, body []byte, contentType string) error {
+	ret := _m.Called(ctx, body, contentType)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func([]byte, string) error); ok {
-		r0 = rf(body, contentType)
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, string) error); ok {
+		r0 = rf(ctx, body, contentType)
 	} else {
 		r0 = ret.Error(0)
 	}
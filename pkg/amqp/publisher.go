@@ -1,7 +1,9 @@
 package amqp
 
+import "context"
+
 //go:generate mockery -name=Publisher
 type Publisher interface {
-	Publish(body []byte, contentType string) error
+	Publish(ctx context.Context, body []byte, contentType string) error
 	Close() error
 }
@@ -0,0 +1,74 @@
+package rabbitmq
+
+import (
+	"log"
+
+	"github.com/hyperledger/aries-framework-go/pkg/didcomm/common/transport"
+	"github.com/pkg/errors"
+	"github.com/streadway/amqp"
+
+	"github.com/scoir/canis/pkg/tracing"
+)
+
+// Inbound is an aries inbound transport for the DIDComm messages the load balancer publishes to a queue.  Unlike
+// the aries AMQP transport it continues the trace carried in the message headers
+type Inbound struct {
+	listener *Listener
+	external string
+}
+
+func NewInbound(addr, external, queue string) (*Inbound, error) {
+	l, err := NewListener(addr, queue)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create inbound listener")
+	}
+
+	return &Inbound{listener: l, external: external}, nil
+}
+
+// Start hands each message received on the queue to aries
+func (r *Inbound) Start(prov transport.Provider) error {
+	msgs, err := r.listener.Listen()
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		for d := range msgs {
+			r.receive(prov, d)
+		}
+	}()
+
+	return nil
+}
+
+// receive traces the handling of a message in a consumer span and remembers the trace for the message thread
+// so protocol handlers can continue it
+func (r *Inbound) receive(prov transport.Provider, d amqp.Delivery) {
+	ctx, span := tracing.StartConsumer(r.listener.queue, d.Headers)
+
+	var err error
+	defer func() { tracing.End(span, err) }()
+
+	unpackMsg, err := prov.Packager().UnpackMessage(d.Body)
+	if err != nil {
+		log.Println("unable to unpack inbound message", err)
+		return
+	}
+
+	tracing.SetThreadContext(ctx, unpackMsg.Message)
+
+	messageHandler := prov.InboundMessageHandler()
+	err = messageHandler(unpackMsg.Message, unpackMsg.ToDID, unpackMsg.FromDID)
+	if err != nil {
+		log.Println("unable to handle inbound message", err)
+	}
+}
+
+func (r *Inbound) Stop() error {
+	return r.listener.Close()
+}
+
+func (r *Inbound) Endpoint() string {
+	return r.external
+}
@@ -1,8 +1,14 @@
 package rabbitmq
 
 import (
+	"context"
+
 	"github.com/pkg/errors"
 	"github.com/streadway/amqp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/scoir/canis/pkg/tracing"
 )
 
 type Publisher struct {
@@ -42,16 +48,26 @@ func NewPublisher(addr, queue string) (*Publisher, error) {
 	}, nil
 }
 
-func (r *Publisher) Publish(body []byte, contentType string) error {
+func (r *Publisher) Publish(ctx context.Context, body []byte, contentType string) error {
+	ctx, span := tracing.Start(ctx, r.queue+" send",
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(attribute.String("messaging.system", "rabbitmq"),
+			attribute.String("messaging.destination", r.queue)))
+
+	headers := amqp.Table{}
+	tracing.InjectAMQP(ctx, headers)
+
 	err := r.ch.Publish(
 		"",      // exchange
 		r.queue, // routing key
 		false,   // mandatory
 		false,   // immediate
 		amqp.Publishing{
+			Headers:     headers,
 			ContentType: contentType,
 			Body:        body,
 		})
+	tracing.End(span, err)
 
 	return errors.Wrap(err, "rabbitMQ publish failed")
 
@@ -1,6 +1,7 @@
 package rabbitmq
 
 import (
+	"context"
 	"os"
 	"testing"
 
@@ -26,7 +27,7 @@ func TestPublisher_Publish(t *testing.T) {
 			msgCh <- incoming.Body
 		}()
 
-		err = publisher.Publish([]byte("{}"), "application/json")
+		err = publisher.Publish(context.Background(), []byte("{}"), "application/json")
 		require.NoError(t, err)
 
 		err = publisher.Close()
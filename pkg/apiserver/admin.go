@@ -29,7 +29,10 @@ import (
 	"github.com/hyperledger/indy-vdr/wrappers/golang/identifiers"
 
 	api "github.com/scoir/canis/pkg/apiserver/api/protogen"
+	"github.com/scoir/canis/pkg/aries/vdri/web"
+	"github.com/scoir/canis/pkg/credential/engine/indy"
 	"github.com/scoir/canis/pkg/datastore"
+	indywrapper "github.com/scoir/canis/pkg/indy"
 	"github.com/scoir/canis/pkg/protogen/common"
 	"github.com/scoir/canis/pkg/static"
 )
@@ -49,7 +52,7 @@ func (r *APIServer) APISpec() (http.HandlerFunc, error) {
 	return static.ServeHTTP, nil
 }
 
-func (r *APIServer) CreateSchema(_ context.Context, req *api.CreateSchemaRequest) (*api.CreateSchemaResponse, error) {
+func (r *APIServer) CreateSchema(ctx context.Context, req *api.CreateSchemaRequest) (*api.CreateSchemaResponse, error) {
 	s := &datastore.Schema{
 		ID:      uuid.New().String(),
 		Name:    req.Schema.Name,
@@ -57,6 +60,7 @@ func (r *APIServer) CreateSchema(_ context.Context, req *api.CreateSchemaRequest
 		Type:    req.Schema.Type,
 		Version: req.Schema.Version,
 		Context: req.Schema.Context,
+		Ledger:  req.Schema.Ledger,
 	}
 
 	if s.Name == "" {
@@ -76,7 +80,7 @@ func (r *APIServer) CreateSchema(_ context.Context, req *api.CreateSchemaRequest
 		return nil, status.Error(codes.AlreadyExists, fmt.Sprintf("schema with id %s already exists", req.Schema.Name))
 	}
 
-	if id, err := r.schemaRegistry.CreateSchema(s); err == nil {
+	if id, err := r.schemaRegistry.CreateSchema(ctx, s); err == nil {
 		s.ExternalSchemaID = id
 	} else {
 		log.Println("error creating schema", err)
@@ -92,6 +96,71 @@ func (r *APIServer) CreateSchema(_ context.Context, req *api.CreateSchemaRequest
 	}, nil
 }
 
+// ImportSchema adopts a schema already written to a ledger, by us or anyone else, so agents can register
+// their own cred defs for it and issue against it
+func (r *APIServer) ImportSchema(_ context.Context, req *api.ImportSchemaRequest) (*api.ImportSchemaResponse, error) {
+	namespace, schemaID, err := indywrapper.LedgerID(req.SchemaId)
+	if err != nil || schemaID == "" {
+		return nil, status.Error(codes.InvalidArgument, "a valid ledger schema ID is required")
+	}
+
+	if namespace == "" {
+		namespace = req.Ledger
+	}
+
+	client, err := r.ledger(namespace)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	_, err = r.schemaStore.GetSchemaByExternalID(req.SchemaId)
+	if err == nil {
+		return nil, status.Error(codes.AlreadyExists, fmt.Sprintf("schema %s has already been imported", req.SchemaId))
+	}
+
+	ls, err := indywrapper.GetSchema(client, schemaID)
+	if errors.Is(err, indywrapper.ErrSchemaNotFound) {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("schema %s not found on ledger", req.SchemaId))
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, errors.Wrap(err, "unable to read schema from ledger").Error())
+	}
+
+	s := &datastore.Schema{
+		ID:               uuid.New().String(),
+		Name:             req.Name,
+		Format:           indy.Indy,
+		Version:          ls.Version,
+		ExternalSchemaID: req.SchemaId,
+		Ledger:           namespace,
+		Attributes:       make([]*datastore.Attribute, len(ls.AttrNames)),
+	}
+
+	if s.Name == "" {
+		s.Name = ls.Name
+	}
+
+	for i, name := range ls.AttrNames {
+		s.Attributes[i] = &datastore.Attribute{
+			Name: name,
+			Type: int32(api.Attribute_STRING),
+		}
+	}
+
+	_, err = r.schemaStore.GetSchema(s.Name)
+	if err == nil {
+		return nil, status.Error(codes.AlreadyExists, fmt.Sprintf("schema with id %s already exists", s.Name))
+	}
+
+	id, err := r.schemaStore.InsertSchema(s)
+	if err != nil {
+		return nil, status.Error(codes.Internal, errors.Wrapf(err, "failed to import schema %s", req.SchemaId).Error())
+	}
+
+	return &api.ImportSchemaResponse{
+		Id: id,
+	}, nil
+}
+
 func (r *APIServer) ListSchema(_ context.Context, req *api.ListSchemaRequest) (*api.ListSchemaResponse, error) {
 	critter := &datastore.SchemaCriteria{
 		Start:    int(req.Start),
@@ -111,13 +180,15 @@ func (r *APIServer) ListSchema(_ context.Context, req *api.ListSchemaRequest) (*
 
 	for i, schema := range results.Schema {
 		out.Schema[i] = &api.Schema{
-			Id:         schema.ID,
-			Name:       schema.Name,
-			Version:    schema.Version,
-			Context:    schema.Context,
-			Format:     schema.Format,
-			Type:       schema.Type,
-			Attributes: make([]*api.Attribute, len(schema.Attributes)),
+			Id:               schema.ID,
+			Name:             schema.Name,
+			Version:          schema.Version,
+			Context:          schema.Context,
+			Format:           schema.Format,
+			Type:             schema.Type,
+			Ledger:           schema.Ledger,
+			Attributes:       make([]*api.Attribute, len(schema.Attributes)),
+			ExternalSchemaId: schema.ExternalSchemaID,
 		}
 
 		for x, attribute := range schema.Attributes {
@@ -140,13 +211,15 @@ func (r *APIServer) GetSchema(_ context.Context, req *api.GetSchemaRequest) (*ap
 	out := &api.GetSchemaResponse{}
 
 	out.Schema = &api.Schema{
-		Id:         schema.ID,
-		Name:       schema.Name,
-		Version:    schema.Version,
-		Context:    schema.Context,
-		Format:     schema.Format,
-		Type:       schema.Type,
-		Attributes: make([]*api.Attribute, len(schema.Attributes)),
+		Id:               schema.ID,
+		Name:             schema.Name,
+		Version:          schema.Version,
+		Context:          schema.Context,
+		Format:           schema.Format,
+		Type:             schema.Type,
+		Ledger:           schema.Ledger,
+		Attributes:       make([]*api.Attribute, len(schema.Attributes)),
+		ExternalSchemaId: schema.ExternalSchemaID,
 	}
 
 	for x, attribute := range schema.Attributes {
@@ -199,24 +272,36 @@ func (r *APIServer) UpdateSchema(_ context.Context, req *api.UpdateSchemaRequest
 	return &api.UpdateSchemaResponse{}, nil
 }
 
-func (r *APIServer) CreateAgent(_ context.Context, req *api.CreateAgentRequest) (*api.CreateAgentResponse, error) {
+func (r *APIServer) CreateAgent(ctx context.Context, req *api.CreateAgentRequest) (*api.CreateAgentResponse, error) {
 	a := &datastore.Agent{
 		ID:                    uuid.New().String(),
 		Name:                  req.Agent.Name,
 		EndorsableSchemaNames: []string{},
 		HasPublicDID:          req.Agent.PublicDid,
+		PublicDIDMethod:       req.Agent.PublicDidMethod,
+		Ledger:                req.Agent.Ledger,
 	}
 
 	if a.Name == "" {
 		return nil, status.Error(codes.InvalidArgument, "name is a required field")
 	}
+
+	switch a.PublicDIDMethod {
+	case "", indywrapper.SovMethod, web.Method:
+	default:
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("unsupported public DID method %s", a.PublicDIDMethod))
+	}
 	_, err := r.agentStore.GetAgent(a.Name)
 	if err == nil {
 		return nil, status.Error(codes.AlreadyExists, fmt.Sprintf("agent with name %s already exists", req.Agent.Name))
 	}
 
 	if a.HasPublicDID {
-		err = r.createAgentPublicDID(a)
+		if a.PublicDIDMethod == web.Method {
+			err = r.createAgentWebDID(a)
+		} else {
+			err = r.createAgentPublicDID(a)
+		}
 		if err != nil {
 			return nil, status.Error(codes.Internal, errors.Wrapf(err, "failed to provision agent wallet %s", req.Agent.Name).Error())
 		}
@@ -226,7 +311,7 @@ func (r *APIServer) CreateAgent(_ context.Context, req *api.CreateAgentRequest)
 			if err != nil {
 				continue
 			}
-			err = r.schemaRegistry.RegisterSchema(a.PublicDID, schema)
+			err = r.schemaRegistry.RegisterSchema(ctx, a.PublicDID, schema)
 			if err != nil {
 				return nil, errors.Wrap(err, "")
 			}
@@ -266,6 +351,8 @@ func (r *APIServer) ListAgent(_ context.Context, req *api.ListAgentRequest) (*ap
 			Id:                    Agent.ID,
 			Name:                  Agent.Name,
 			EndorsableSchemaNames: Agent.EndorsableSchemaNames,
+			PublicDidMethod:       Agent.PublicDIDMethod,
+			Ledger:                Agent.Ledger,
 		}
 	}
 
@@ -284,6 +371,8 @@ func (r *APIServer) GetAgent(_ context.Context, req *api.GetAgentRequest) (*api.
 		Id:                    Agent.ID,
 		Name:                  Agent.Name,
 		EndorsableSchemaNames: Agent.EndorsableSchemaNames,
+		PublicDidMethod:       Agent.PublicDIDMethod,
+		Ledger:                Agent.Ledger,
 	}
 
 	return out, nil
@@ -322,6 +411,32 @@ func (r *APIServer) GetAgentInvitationImage(ctx context.Context, request *common
 	}, nil
 }
 
+func (r *APIServer) GetAgentDIDDocument(_ context.Context, req *api.GetAgentDIDDocumentRequest) (*httpbody.HttpBody, error) {
+	a, err := r.agentStore.GetAgent(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, errors.Wrapf(err, "unable to find agent %s", req.Id).Error())
+	}
+
+	if a.PublicDIDMethod != web.Method || a.PublicDID == nil {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("agent %s does not have a did:web public DID", req.Id))
+	}
+
+	doc, err := webDIDDocument(a.PublicDID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, errors.Wrap(err, "unable to build DID document").Error())
+	}
+
+	d, err := doc.JSONBytes()
+	if err != nil {
+		return nil, status.Error(codes.Internal, errors.Wrap(err, "unable to encode DID document").Error())
+	}
+
+	return &httpbody.HttpBody{
+		ContentType: "application/did+json",
+		Data:        d,
+	}, nil
+}
+
 func (r *APIServer) DeleteAgent(_ context.Context, req *api.DeleteAgentRequest) (*api.DeleteAgentResponse, error) {
 
 	_, err := r.agentStore.GetAgent(req.Id)
@@ -337,7 +452,7 @@ func (r *APIServer) DeleteAgent(_ context.Context, req *api.DeleteAgentRequest)
 	return &api.DeleteAgentResponse{}, nil
 }
 
-func (r *APIServer) UpdateAgent(_ context.Context, req *api.UpdateAgentRequest) (*api.UpdateAgentResponse, error) {
+func (r *APIServer) UpdateAgent(ctx context.Context, req *api.UpdateAgentRequest) (*api.UpdateAgentResponse, error) {
 	if req.Agent.Name == "" {
 		return nil, status.Error(codes.InvalidArgument, "id is required fields")
 	}
@@ -366,7 +481,7 @@ func (r *APIServer) UpdateAgent(_ context.Context, req *api.UpdateAgentRequest)
 		if err != nil {
 			continue
 		}
-		err = r.schemaRegistry.RegisterSchema(upd.PublicDID, schema)
+		err = r.schemaRegistry.RegisterSchema(ctx, upd.PublicDID, schema)
 		if err != nil {
 			return nil, errors.Wrap(err, "")
 		}
@@ -382,7 +497,12 @@ func (r *APIServer) UpdateAgent(_ context.Context, req *api.UpdateAgentRequest)
 }
 
 func (r *APIServer) SeedPublicDID(_ context.Context, req *api.SeedPublicDIDRequest) (*api.SeedPublicDIDResponse, error) {
-	_, err := r.store.GetPublicDID()
+	client, err := r.ledger(req.Ledger)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	_, err = r.store.GetLedgerPublicDID(req.Ledger)
 	if err == nil {
 		return nil, status.Error(codes.FailedPrecondition, "public DID already exists")
 	}
@@ -409,13 +529,13 @@ func (r *APIServer) SeedPublicDID(_ context.Context, req *api.SeedPublicDIDReque
 	did, err := identifiers.CreateDID(&identifiers.MyDIDInfo{
 		PublicKey:  pubkey,
 		Cid:        true,
-		MethodName: "sov",
+		MethodName: indywrapper.DIDMethod(req.Ledger),
 	})
 	if err != nil {
 		return nil, status.Error(codes.Internal, errors.Wrapf(err, "failed to create new DID for apiserver PublicDID").Error())
 	}
 
-	_, err = r.client.GetNym(did.String())
+	_, err = client.GetNym(did.DIDVal.MethodSpecificID)
 	if err != nil {
 		return nil, errors.Wrap(err, "DID must be registered")
 	}
@@ -437,6 +557,7 @@ func (r *APIServer) SeedPublicDID(_ context.Context, req *api.SeedPublicDIDReque
 			PublicKey: encPubKey,
 		},
 		Endpoint: "",
+		Ledger:   req.Ledger,
 	}
 
 	err = r.store.SetPublicDID(d)
@@ -444,6 +565,10 @@ func (r *APIServer) SeedPublicDID(_ context.Context, req *api.SeedPublicDIDReque
 		return nil, errors.Wrap(err, "unable to save public DID")
 	}
 
+	if req.Ledger != "" {
+		return &api.SeedPublicDIDResponse{}, nil
+	}
+
 	_, err = r.store.GetMediatorDID()
 	if err != nil {
 		err = r.createMediatorPublicDID()
@@ -602,3 +727,39 @@ func (r *APIServer) RegisterEdgeAgent(ctx context.Context, request *common.Regis
 
 	return resp, nil
 }
+
+func (r *APIServer) GetMailboxStatus(ctx context.Context, request *common.MailboxStatusRequest) (*common.MailboxStatusResponse, error) {
+	resp, err := r.mediator.GetMailboxStatus(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (r *APIServer) ListEdgeAgentRoutes(ctx context.Context, request *common.ListEdgeAgentRoutesRequest) (*common.ListEdgeAgentRoutesResponse, error) {
+	resp, err := r.mediator.ListEdgeAgentRoutes(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (r *APIServer) GetEdgeAgentRoutes(ctx context.Context, request *common.GetEdgeAgentRoutesRequest) (*common.GetEdgeAgentRoutesResponse, error) {
+	resp, err := r.mediator.GetEdgeAgentRoutes(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (r *APIServer) RevokeEdgeAgentRoute(ctx context.Context, request *common.RevokeEdgeAgentRouteRequest) (*common.RevokeEdgeAgentRouteResponse, error) {
+	resp, err := r.mediator.RevokeEdgeAgentRoute(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
@@ -10,14 +10,21 @@ import (
 	"context"
 	"testing"
 
+	"github.com/hyperledger/indy-vdr/wrappers/golang/vdr"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/hyperledger/indy-vdr/wrappers/golang/identifiers"
 
+	amqpmocks "github.com/scoir/canis/pkg/amqp/mocks"
 	api "github.com/scoir/canis/pkg/apiserver/api/protogen"
 	apimocks "github.com/scoir/canis/pkg/apiserver/mocks"
+	"github.com/scoir/canis/pkg/audit"
+	"github.com/scoir/canis/pkg/credential/engine/indy"
+	indymocks "github.com/scoir/canis/pkg/credential/engine/indy/mocks"
 	emocks "github.com/scoir/canis/pkg/credential/engine/mocks"
 	"github.com/scoir/canis/pkg/datastore"
 	"github.com/scoir/canis/pkg/datastore/mocks"
@@ -37,6 +44,15 @@ type AdminTestSuite struct {
 	Issuer            *apimocks.MockIssuer
 	Verifier          *apimocks.MockVerifier
 	Mediator          *apimocks.MockMediator
+	Publisher         *amqpmocks.Publisher
+}
+
+type storeProvider struct {
+	store datastore.Store
+}
+
+func (r *storeProvider) Store() datastore.Store {
+	return r.store
 }
 
 func SetupTest() (*APIServer, *AdminTestSuite) {
@@ -54,6 +70,7 @@ func SetupTest() (*APIServer, *AdminTestSuite) {
 	suite.Issuer = &apimocks.MockIssuer{}
 	suite.Verifier = &apimocks.MockVerifier{}
 	suite.Mediator = &apimocks.MockMediator{}
+	suite.Publisher = &amqpmocks.Publisher{}
 
 	target := &APIServer{
 		keyMgr:         suite.KMS,
@@ -68,6 +85,8 @@ func SetupTest() (*APIServer, *AdminTestSuite) {
 		verifier:       suite.Verifier,
 		loadbalancer:   suite.LoadbalanceClient,
 		mediator:       suite.Mediator,
+		audit:          audit.New(&storeProvider{store: suite.Store}),
+		notifications:  suite.Publisher,
 	}
 
 	return target, suite
@@ -124,14 +143,71 @@ func TestCreateAgent(t *testing.T) {
 
 		suite.Store.On("GetAgent", "Test Agent").Return(nil, errors.New("not found"))
 		suite.Store.On("InsertAgent", mock.MatchedBy(match)).Return("123", nil)
-		suite.Store.On("GetPublicDID").Return(did, nil)
+		suite.Store.On("GetLedgerPublicDID", "").Return(did, nil)
 		suite.Store.On("GetSchema", "test-schema-id").Return(s, nil)
-		suite.CredRegistry.On("RegisterSchema", mock.AnythingOfType("*datastore.DID"), s).Return(nil)
+		suite.CredRegistry.On("RegisterSchema", mock.Anything, mock.AnythingOfType("*datastore.DID"), s).Return(nil)
 
 		_, err = target.CreateAgent(context.Background(), request)
 		require.Nil(t, err)
 		//require.Equal(t, resp.Id, "123")
 	})
+	t.Run("with did:web public did", func(t *testing.T) {
+		target, suite := SetupTest()
+		target.didWebDomain = "canis.example.com"
+
+		request := &api.CreateAgentRequest{
+			Agent: &api.NewAgent{
+				Name:            "Test Agent",
+				PublicDid:       true,
+				PublicDidMethod: "web",
+			},
+		}
+
+		match := func(m *datastore.Agent) bool {
+			return m.PublicDID.DID.String() == "did:web:canis.example.com:agents:Test%20Agent" &&
+				m.PublicDID.Endpoint == "0.0.0.0:420" && m.PublicDIDMethod == "web"
+		}
+
+		suite.Store.On("GetAgent", "Test Agent").Return(nil, errors.New("not found"))
+		suite.Store.On("InsertAgent", mock.MatchedBy(match)).Return("123", nil)
+
+		resp, err := target.CreateAgent(context.Background(), request)
+		require.NoError(t, err)
+		require.Equal(t, "123", resp.Id)
+		suite.Store.AssertNotCalled(t, "GetLedgerPublicDID", mock.Anything)
+	})
+	t.Run("did:web without domain", func(t *testing.T) {
+		target, suite := SetupTest()
+
+		request := &api.CreateAgentRequest{
+			Agent: &api.NewAgent{
+				Name:            "Test Agent",
+				PublicDid:       true,
+				PublicDidMethod: "web",
+			},
+		}
+
+		suite.Store.On("GetAgent", "Test Agent").Return(nil, errors.New("not found"))
+
+		resp, err := target.CreateAgent(context.Background(), request)
+		require.Nil(t, resp)
+		require.Equal(t, codes.Internal, status.Code(err))
+	})
+	t.Run("unsupported did method", func(t *testing.T) {
+		target, _ := SetupTest()
+
+		request := &api.CreateAgentRequest{
+			Agent: &api.NewAgent{
+				Name:            "Test Agent",
+				PublicDid:       true,
+				PublicDidMethod: "key",
+			},
+		}
+
+		resp, err := target.CreateAgent(context.Background(), request)
+		require.Nil(t, resp)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
 }
 
 func TestCreateAgentFails(t *testing.T) {
@@ -320,9 +396,9 @@ func TestUpdateAgent(t *testing.T) {
 		}
 
 		suite.Store.On("GetAgent", "123").Return(a, nil)
-		suite.Store.On("GetPublicDID").Return(did, nil)
+		suite.Store.On("GetLedgerPublicDID", "").Return(did, nil)
 		suite.Store.On("GetSchema", "test-schema-id").Return(s, nil)
-		suite.CredRegistry.On("RegisterSchema", mock.AnythingOfType("*datastore.DID"), s).Return(nil)
+		suite.CredRegistry.On("RegisterSchema", mock.Anything, mock.AnythingOfType("*datastore.DID"), s).Return(nil)
 		suite.Store.On("UpdateAgent", mock.MatchedBy(match)).Return(nil)
 
 		resp, err := target.UpdateAgent(context.Background(), request)
@@ -402,7 +478,7 @@ func TestCreateSchema(t *testing.T) {
 	}
 
 	suite.Store.On("GetSchema", "Test Schema").Return(nil, errors.New("not found"))
-	suite.CredRegistry.On("CreateSchema", mock.MatchedBy(match)).Return("abc", nil)
+	suite.CredRegistry.On("CreateSchema", mock.Anything, mock.MatchedBy(match)).Return("abc", nil)
 	suite.Store.On("InsertSchema", mock.MatchedBy(match)).Return("123", nil)
 
 	resp, err := target.CreateSchema(context.Background(), request)
@@ -423,7 +499,7 @@ func TestCreateSchemaFails(t *testing.T) {
 	}
 
 	suite.Store.On("GetSchema", "Test Schema").Return(nil, errors.New("not found"))
-	suite.CredRegistry.On("CreateSchema", mock.MatchedBy(match)).Return("abc", nil)
+	suite.CredRegistry.On("CreateSchema", mock.Anything, mock.MatchedBy(match)).Return("abc", nil)
 	suite.Store.On("InsertSchema", mock.MatchedBy(match)).Return("", errors.New("Boom"))
 
 	resp, err := target.CreateSchema(context.Background(), request)
@@ -462,6 +538,67 @@ func TestCreateSchemaAlreadyExists(t *testing.T) {
 	require.Equal(t, "rpc error: code = AlreadyExists desc = schema with id Test Schema already exists", err.Error())
 }
 
+func TestImportSchema(t *testing.T) {
+	schemaID := "WgWxqztrNooG92RXvxSTWv:2:degree:1.0"
+	ledgerSchema := &vdr.ReadReply{
+		SeqNo: 42,
+		Data:  map[string]interface{}{"name": "degree", "version": "1.0", "attr_names": []interface{}{"name", "gpa"}},
+	}
+
+	t.Run("imports", func(t *testing.T) {
+		target, suite := SetupTest()
+		suite.IndyClient.GetSchemaReply = ledgerSchema
+
+		match := func(m *datastore.Schema) bool {
+			return m.Name == "State Degree" &&
+				m.Version == "1.0" &&
+				m.Format == indy.Indy &&
+				m.ExternalSchemaID == schemaID &&
+				len(m.Attributes) == 2 &&
+				m.Attributes[1].Name == "gpa"
+		}
+
+		suite.Store.On("GetSchemaByExternalID", schemaID).Return(nil, errors.New("not found"))
+		suite.Store.On("GetSchema", "State Degree").Return(nil, errors.New("not found"))
+		suite.Store.On("InsertSchema", mock.MatchedBy(match)).Return("123", nil)
+
+		resp, err := target.ImportSchema(context.Background(), &api.ImportSchemaRequest{SchemaId: schemaID, Name: "State Degree"})
+		require.NoError(t, err)
+		require.Equal(t, "123", resp.Id)
+		suite.CredRegistry.AssertNotCalled(t, "CreateSchema", mock.Anything, mock.Anything)
+	})
+	t.Run("already imported", func(t *testing.T) {
+		target, suite := SetupTest()
+		suite.Store.On("GetSchemaByExternalID", schemaID).Return(&datastore.Schema{}, nil)
+
+		_, err := target.ImportSchema(context.Background(), &api.ImportSchemaRequest{SchemaId: schemaID})
+		require.Equal(t, codes.AlreadyExists, status.Code(err))
+	})
+	t.Run("not on ledger", func(t *testing.T) {
+		target, suite := SetupTest()
+		suite.IndyClient.GetSchemaReply = &vdr.ReadReply{}
+		suite.Store.On("GetSchemaByExternalID", schemaID).Return(nil, errors.New("not found"))
+
+		_, err := target.ImportSchema(context.Background(), &api.ImportSchemaRequest{SchemaId: schemaID})
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+	t.Run("name taken", func(t *testing.T) {
+		target, suite := SetupTest()
+		suite.IndyClient.GetSchemaReply = ledgerSchema
+		suite.Store.On("GetSchemaByExternalID", schemaID).Return(nil, errors.New("not found"))
+		suite.Store.On("GetSchema", "degree").Return(&datastore.Schema{}, nil)
+
+		_, err := target.ImportSchema(context.Background(), &api.ImportSchemaRequest{SchemaId: schemaID})
+		require.Equal(t, codes.AlreadyExists, status.Code(err))
+	})
+	t.Run("missing schema ID", func(t *testing.T) {
+		target, _ := SetupTest()
+
+		_, err := target.ImportSchema(context.Background(), &api.ImportSchemaRequest{})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
 func TestGetSchema(t *testing.T) {
 	target, suite := SetupTest()
 	request := &api.GetSchemaRequest{
@@ -685,6 +822,49 @@ func TestGetAgentInvitationIamge(t *testing.T) {
 
 }
 
+func TestGetAgentDIDDocument(t *testing.T) {
+	t.Run("happy", func(t *testing.T) {
+		target, suite := SetupTest()
+		a := &datastore.Agent{
+			Name:            "faber",
+			PublicDIDMethod: "web",
+			PublicDID: &datastore.DID{
+				DID: &identifiers.DID{
+					DIDVal: identifiers.DIDValue{Method: "web", MethodSpecificID: "canis.example.com:agents:faber"},
+				},
+				KeyPair: &datastore.KeyPair{
+					ID:        "id",
+					PublicKey: "H3C2AVvLMv6gmMNam3uVAjZpfkcJCwDwnZn6z3wXmqPV",
+				},
+				Endpoint: "https://canis.example.com/faber",
+			},
+		}
+		suite.Store.On("GetAgent", "faber").Return(a, nil)
+
+		result, err := target.GetAgentDIDDocument(context.Background(), &api.GetAgentDIDDocumentRequest{Id: "faber"})
+		require.NoError(t, err)
+		require.Equal(t, "application/did+json", result.ContentType)
+		require.Contains(t, string(result.Data), `"id":"did:web:canis.example.com:agents:faber"`)
+		require.Contains(t, string(result.Data), "https://canis.example.com/faber")
+	})
+	t.Run("not did:web", func(t *testing.T) {
+		target, suite := SetupTest()
+		suite.Store.On("GetAgent", "faber").Return(&datastore.Agent{Name: "faber"}, nil)
+
+		result, err := target.GetAgentDIDDocument(context.Background(), &api.GetAgentDIDDocumentRequest{Id: "faber"})
+		require.Nil(t, result)
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+	t.Run("unknown agent", func(t *testing.T) {
+		target, suite := SetupTest()
+		suite.Store.On("GetAgent", "faber").Return(nil, errors.New("not found"))
+
+		result, err := target.GetAgentDIDDocument(context.Background(), &api.GetAgentDIDDocumentRequest{Id: "faber"})
+		require.Nil(t, result)
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
 func TestSeedPublicDID(t *testing.T) {
 	t.Run("happy", func(t *testing.T) {
 		target, suite := SetupTest()
@@ -692,7 +872,7 @@ func TestSeedPublicDID(t *testing.T) {
 			Seed: "b2352b32947e188eb72871093ac6217e",
 		}
 
-		suite.Store.On("GetPublicDID").Return(nil, errors.New("not found"))
+		suite.Store.On("GetLedgerPublicDID", "").Return(nil, errors.New("not found"))
 		suite.Store.On("SetPublicDID", mock.AnythingOfType("*datastore.DID")).Return(nil)
 		suite.Store.On("GetMediatorDID").Return(&datastore.DID{}, nil)
 
@@ -719,7 +899,7 @@ func TestSeedPublicDID(t *testing.T) {
 			return did.Endpoint == "ws://test:1000"
 		}
 
-		suite.Store.On("GetPublicDID").Return(nil, errors.New("not found")).Once()
+		suite.Store.On("GetLedgerPublicDID", "").Return(nil, errors.New("not found")).Once()
 		suite.Store.On("SetPublicDID", mock.MatchedBy(match)).Return(nil)
 		suite.Store.On("GetMediatorDID").Return(nil, errors.New("not found"))
 		suite.Store.On("GetPublicDID").Return(&newPubDID, nil).Once()
@@ -736,7 +916,7 @@ func TestSeedPublicDID(t *testing.T) {
 			Seed: "",
 		}
 
-		suite.Store.On("GetPublicDID").Return(nil, errors.New("not found"))
+		suite.Store.On("GetLedgerPublicDID", "").Return(nil, errors.New("not found"))
 		suite.Store.On("SetPublicDID", mock.AnythingOfType("*datastore.DID")).Return(nil)
 		suite.Store.On("GetMediatorDID").Return(&datastore.DID{}, nil)
 
@@ -751,7 +931,7 @@ func TestSeedPublicDID(t *testing.T) {
 			Seed: "b2352b32947e188eb72871093ac6217e",
 		}
 
-		suite.Store.On("GetPublicDID").Return(nil, errors.New("not found"))
+		suite.Store.On("GetLedgerPublicDID", "").Return(nil, errors.New("not found"))
 		suite.IndyClient.GetNymErr = errors.New("not found")
 
 		resp, err := target.SeedPublicDID(context.Background(), req)
@@ -765,7 +945,7 @@ func TestSeedPublicDID(t *testing.T) {
 			Seed: "abc",
 		}
 
-		suite.Store.On("GetPublicDID").Return(nil, nil)
+		suite.Store.On("GetLedgerPublicDID", "").Return(nil, nil)
 
 		resp, err := target.SeedPublicDID(context.Background(), req)
 		require.Error(t, err)
@@ -779,7 +959,7 @@ func TestSeedPublicDID(t *testing.T) {
 			Seed: "b2352b32947e188eb72871093ac6217e",
 		}
 
-		suite.Store.On("GetPublicDID").Return(nil, errors.New("not found"))
+		suite.Store.On("GetLedgerPublicDID", "").Return(nil, errors.New("not found"))
 		suite.KMS.ImportPrivateKeyErr = errors.New("unexpected")
 
 		resp, err := target.SeedPublicDID(context.Background(), req)
@@ -794,7 +974,7 @@ func TestSeedPublicDID(t *testing.T) {
 			Seed: "b2352b32947e188eb72871093ac6217e",
 		}
 
-		suite.Store.On("GetPublicDID").Return(nil, errors.New("not found"))
+		suite.Store.On("GetLedgerPublicDID", "").Return(nil, errors.New("not found"))
 		suite.Store.On("SetPublicDID", mock.AnythingOfType("*datastore.DID")).Return(errors.New("BOOM"))
 
 		resp, err := target.SeedPublicDID(context.Background(), req)
@@ -809,7 +989,7 @@ func TestSeedPublicDID(t *testing.T) {
 			Seed: "abc",
 		}
 
-		suite.Store.On("GetPublicDID").Return(nil, errors.New("not found"))
+		suite.Store.On("GetLedgerPublicDID", "").Return(nil, errors.New("not found"))
 
 		resp, err := target.SeedPublicDID(context.Background(), req)
 
@@ -817,6 +997,48 @@ func TestSeedPublicDID(t *testing.T) {
 		require.Nil(t, resp)
 
 	})
+
+	t.Run("named ledger", func(t *testing.T) {
+		target, suite := SetupTest()
+		req := &api.SeedPublicDIDRequest{
+			Seed:   "b2352b32947e188eb72871093ac6217e",
+			Ledger: "sovrin:staging",
+		}
+
+		client := &indymocks.VDRClient{}
+		client.On("GetNym", mock.AnythingOfType("string")).Return(&vdr.ReadReply{}, nil)
+		target.ledgers = func(namespace string) (indy.VDRClient, error) {
+			require.Equal(t, "sovrin:staging", namespace)
+			return client, nil
+		}
+
+		match := func(did *datastore.DID) bool {
+			return did.Ledger == "sovrin:staging" && did.DID.DIDVal.Method == "indy:sovrin:staging"
+		}
+
+		suite.Store.On("GetLedgerPublicDID", "sovrin:staging").Return(nil, errors.New("not found"))
+		suite.Store.On("SetPublicDID", mock.MatchedBy(match)).Return(nil)
+
+		resp, err := target.SeedPublicDID(context.Background(), req)
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+		suite.Store.AssertNotCalled(t, "GetMediatorDID")
+	})
+
+	t.Run("unknown ledger", func(t *testing.T) {
+		target, _ := SetupTest()
+		req := &api.SeedPublicDIDRequest{
+			Ledger: "sovrin:builder",
+		}
+
+		target.ledgers = func(namespace string) (indy.VDRClient, error) {
+			return nil, errors.New("no ledger configured")
+		}
+
+		resp, err := target.SeedPublicDID(context.Background(), req)
+		require.Nil(t, resp)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
 }
 
 func TestIssueCredential(t *testing.T) {
@@ -0,0 +1,176 @@
+/*
+Copyright Scoir Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package apiserver
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"path"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	api "github.com/scoir/canis/pkg/apiserver/api/protogen"
+	"github.com/scoir/canis/pkg/audit"
+	"github.com/scoir/canis/pkg/datastore"
+)
+
+// read only RPCs that are not recorded in the audit log
+var unaudited = map[string]bool{
+	"ListSchema":                    true,
+	"GetSchema":                     true,
+	"ListAgent":                     true,
+	"GetAgent":                      true,
+	"GetAgentDIDDocument":           true,
+	"ListWebhook":                   true,
+	"ListConnections":               true,
+	"ListAuditEvents":               true,
+	"GetTransactionAuthorAgreement": true,
+	"ListEndorsements":              true,
+	"GetEndorsement":                true,
+	"GetLedgerStatus":               true,
+	"GetMailboxStatus":              true,
+	"ListEdgeAgentRoutes":           true,
+	"GetEdgeAgentRoutes":            true,
+}
+
+func (r *APIServer) UnaryServerInterceptors() []grpc.UnaryServerInterceptor {
+	return []grpc.UnaryServerInterceptor{r.auditInterceptor}
+}
+
+func (r *APIServer) auditInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+
+	resp, err := handler(ctx, req)
+
+	method := path.Base(info.FullMethod)
+	if unaudited[method] {
+		return resp, err
+	}
+
+	e := &datastore.AuditEvent{
+		Caller:  auditCaller(ctx),
+		RPC:     method,
+		Outcome: audit.OutcomeSuccess,
+	}
+	e.AgentName, e.ExternalID = auditTarget(req)
+
+	if err != nil {
+		e.Outcome = audit.OutcomeFailure
+		e.Error = status.Convert(err).Message()
+	}
+
+	// the operation has already completed, so the caller gets its real result and a failure to audit it
+	// is only logged
+	aerr := r.audit.Record(e)
+	if aerr != nil {
+		log.Printf("%s completed with outcome %s but could not be recorded in the audit log: (%v)\n",
+			method, e.Outcome, aerr)
+	}
+
+	return resp, err
+}
+
+// auditCaller identifies the caller by the subject of its verified client certificate.  Without mutual TLS
+// only the address of the caller is known, request metadata is never trusted to name the caller
+func auditCaller(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return "unknown"
+	}
+
+	addr := "unknown"
+	if p.Addr != nil {
+		addr = p.Addr.String()
+	}
+
+	ti, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(ti.State.VerifiedChains) == 0 || len(ti.State.VerifiedChains[0]) == 0 {
+		return fmt.Sprintf("unauthenticated (%s)", addr)
+	}
+
+	cert := ti.State.VerifiedChains[0][0]
+	name := cert.Subject.CommonName
+	if name == "" && len(cert.DNSNames) > 0 {
+		name = cert.DNSNames[0]
+	}
+
+	return fmt.Sprintf("%s (%s)", name, addr)
+}
+
+func auditTarget(req interface{}) (string, string) {
+	switch v := req.(type) {
+	case *api.CreateAgentRequest:
+		return v.GetAgent().GetName(), ""
+	case *api.UpdateAgentRequest:
+		return v.GetAgent().GetName(), ""
+	case *api.DeleteAgentRequest:
+		return v.GetId(), ""
+	}
+
+	var agentName, externalID string
+	if n, ok := req.(interface{ GetAgentName() string }); ok {
+		agentName = n.GetAgentName()
+	}
+	if x, ok := req.(interface{ GetExternalId() string }); ok {
+		externalID = x.GetExternalId()
+	}
+
+	return agentName, externalID
+}
+
+func (r *APIServer) ListAuditEvents(_ context.Context, req *api.ListAuditEventsRequest) (*api.ListAuditEventsResponse, error) {
+	critter := &datastore.AuditEventCriteria{
+		Start:    int(req.Start),
+		PageSize: int(req.PageSize),
+	}
+
+	if req.StartTime != nil {
+		critter.From = req.StartTime.AsTime()
+	}
+
+	if req.EndTime != nil {
+		critter.To = req.EndTime.AsTime()
+	}
+
+	if !critter.From.IsZero() && !critter.To.IsZero() && !critter.From.Before(critter.To) {
+		return nil, status.Error(codes.InvalidArgument, "start_time must be before end_time")
+	}
+
+	results, err := r.store.ListAuditEvents(critter)
+	if err != nil {
+		return nil, status.Error(codes.Internal, errors.Wrap(err, "unable to list audit events").Error())
+	}
+
+	out := &api.ListAuditEventsResponse{
+		Count:  int64(results.Count),
+		Events: make([]*api.AuditEvent, len(results.Events)),
+	}
+
+	for i, e := range results.Events {
+		out.Events[i] = &api.AuditEvent{
+			Sequence:   e.Sequence,
+			Timestamp:  timestamppb.New(e.Timestamp),
+			Caller:     e.Caller,
+			Rpc:        e.RPC,
+			AgentName:  e.AgentName,
+			ExternalId: e.ExternalID,
+			Outcome:    e.Outcome,
+			Error:      e.Error,
+			PrevHash:   e.PrevHash,
+			Hash:       e.Hash,
+		}
+	}
+
+	return out, nil
+}
@@ -0,0 +1,212 @@
+/*
+Copyright Scoir Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package apiserver
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	api "github.com/scoir/canis/pkg/apiserver/api/protogen"
+	"github.com/scoir/canis/pkg/audit"
+	"github.com/scoir/canis/pkg/datastore"
+	"github.com/scoir/canis/pkg/protogen/common"
+)
+
+func TestAuditInterceptor(t *testing.T) {
+	info := func(method string) *grpc.UnaryServerInfo {
+		return &grpc.UnaryServerInfo{FullMethod: "/apiserver.Admin/" + method}
+	}
+
+	t.Run("records successful issuance", func(t *testing.T) {
+		target, suite := SetupTest()
+
+		var recorded *datastore.AuditEvent
+		suite.Store.On("GetLastAuditEvent").Return(nil, nil)
+		suite.Store.On("InsertAuditEvent", mock.AnythingOfType("*datastore.AuditEvent")).
+			Run(func(args mock.Arguments) {
+				recorded = args.Get(0).(*datastore.AuditEvent)
+			}).Return(nil)
+
+		ctx := peer.NewContext(context.Background(), &peer.Peer{
+			Addr:     &net.TCPAddr{IP: net.IPv4(10, 0, 0, 1), Port: 443},
+			AuthInfo: tlsAuthInfo("alice"),
+		})
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("x-canis-caller", "mallory"))
+		req := &common.IssueCredentialRequest{AgentName: "agent", ExternalId: "student"}
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			return &common.IssueCredentialResponse{CredentialId: "123"}, nil
+		}
+
+		resp, err := target.auditInterceptor(ctx, req, info("IssueCredential"), handler)
+		require.NoError(t, err)
+		require.Equal(t, "123", resp.(*common.IssueCredentialResponse).CredentialId)
+
+		require.NotNil(t, recorded)
+		require.Equal(t, "IssueCredential", recorded.RPC)
+		require.Equal(t, "alice (10.0.0.1:443)", recorded.Caller)
+		require.Equal(t, "agent", recorded.AgentName)
+		require.Equal(t, "student", recorded.ExternalID)
+		require.Equal(t, audit.OutcomeSuccess, recorded.Outcome)
+		require.Equal(t, audit.Hash(recorded), recorded.Hash)
+	})
+
+	t.Run("records failures", func(t *testing.T) {
+		target, suite := SetupTest()
+
+		var recorded *datastore.AuditEvent
+		suite.Store.On("GetLastAuditEvent").Return(nil, nil)
+		suite.Store.On("InsertAuditEvent", mock.AnythingOfType("*datastore.AuditEvent")).
+			Run(func(args mock.Arguments) {
+				recorded = args.Get(0).(*datastore.AuditEvent)
+			}).Return(nil)
+
+		req := &api.CreateAgentRequest{Agent: &api.NewAgent{Name: "agent"}}
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, status.Error(codes.AlreadyExists, "agent with name agent already exists")
+		}
+
+		_, err := target.auditInterceptor(context.Background(), req, info("CreateAgent"), handler)
+		require.Error(t, err)
+
+		require.NotNil(t, recorded)
+		require.Equal(t, "CreateAgent", recorded.RPC)
+		require.Equal(t, "agent", recorded.AgentName)
+		require.Equal(t, audit.OutcomeFailure, recorded.Outcome)
+		require.Equal(t, "agent with name agent already exists", recorded.Error)
+	})
+
+	t.Run("skips read only calls", func(t *testing.T) {
+		target, suite := SetupTest()
+
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			return &api.ListAgentResponse{}, nil
+		}
+
+		_, err := target.auditInterceptor(context.Background(), &api.ListAgentRequest{}, info("ListAgent"), handler)
+		require.NoError(t, err)
+		suite.Store.AssertNotCalled(t, "InsertAuditEvent", mock.Anything)
+	})
+
+	t.Run("audit failure returns the real result", func(t *testing.T) {
+		target, suite := SetupTest()
+
+		suite.Store.On("GetLastAuditEvent").Return(nil, nil)
+		suite.Store.On("InsertAuditEvent", mock.AnythingOfType("*datastore.AuditEvent")).Return(errors.New("boom"))
+
+		want := &api.DeleteConnectionResponse{}
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			return want, nil
+		}
+
+		req := &api.DeleteConnectionRequest{AgentName: "agent", ExternalId: "student"}
+		resp, err := target.auditInterceptor(context.Background(), req, info("DeleteConnection"), handler)
+		require.NoError(t, err)
+		require.Same(t, want, resp)
+
+		handler = func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, status.Error(codes.NotFound, "no such connection")
+		}
+
+		_, err = target.auditInterceptor(context.Background(), req, info("DeleteConnection"), handler)
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func tlsAuthInfo(cn string) credentials.TLSInfo {
+	cert := &x509.Certificate{Subject: pkix.Name{CommonName: cn}}
+	return credentials.TLSInfo{State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}}}
+}
+
+func TestAuditCaller(t *testing.T) {
+	addr := &net.TCPAddr{IP: net.IPv4(10, 0, 0, 1), Port: 443}
+
+	t.Run("verified client certificate", func(t *testing.T) {
+		ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: addr, AuthInfo: tlsAuthInfo("sirius")})
+		require.Equal(t, "sirius (10.0.0.1:443)", auditCaller(ctx))
+	})
+
+	t.Run("TLS without a client certificate", func(t *testing.T) {
+		ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: addr, AuthInfo: credentials.TLSInfo{}})
+		require.Equal(t, "unauthenticated (10.0.0.1:443)", auditCaller(ctx))
+	})
+
+	t.Run("headers are ignored", func(t *testing.T) {
+		ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: addr})
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("x-canis-caller", "admin", "x-forwarded-for", "10.9.9.9"))
+		require.Equal(t, "unauthenticated (10.0.0.1:443)", auditCaller(ctx))
+	})
+
+	t.Run("no peer", func(t *testing.T) {
+		require.Equal(t, "unknown", auditCaller(context.Background()))
+	})
+}
+
+func TestListAuditEvents(t *testing.T) {
+	from := time.Date(2020, 11, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2020, 12, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("success", func(t *testing.T) {
+		target, suite := SetupTest()
+
+		critter := &datastore.AuditEventCriteria{Start: 0, PageSize: 10, From: from, To: to}
+		suite.Store.On("ListAuditEvents", critter).Return(&datastore.AuditEventList{
+			Count: 1,
+			Events: []*datastore.AuditEvent{
+				{Sequence: 1, Timestamp: from, RPC: "CreateAgent", AgentName: "agent", Hash: "abc"},
+			},
+		}, nil)
+
+		resp, err := target.ListAuditEvents(context.Background(), &api.ListAuditEventsRequest{
+			StartTime: timestamppb.New(from),
+			EndTime:   timestamppb.New(to),
+			PageSize:  10,
+		})
+		require.NoError(t, err)
+		require.Equal(t, int64(1), resp.Count)
+		require.Len(t, resp.Events, 1)
+		require.Equal(t, "CreateAgent", resp.Events[0].Rpc)
+		require.Equal(t, "abc", resp.Events[0].Hash)
+	})
+
+	t.Run("invalid range", func(t *testing.T) {
+		target, _ := SetupTest()
+
+		_, err := target.ListAuditEvents(context.Background(), &api.ListAuditEventsRequest{
+			StartTime: timestamppb.New(to),
+			EndTime:   timestamppb.New(from),
+		})
+		require.Error(t, err)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("store error", func(t *testing.T) {
+		target, suite := SetupTest()
+
+		suite.Store.On("ListAuditEvents", mock.AnythingOfType("*datastore.AuditEventCriteria")).
+			Return(nil, errors.New("boom"))
+
+		_, err := target.ListAuditEvents(context.Background(), &api.ListAuditEventsRequest{})
+		require.Error(t, err)
+		require.Equal(t, codes.Internal, status.Code(err))
+	})
+}
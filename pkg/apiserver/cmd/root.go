@@ -8,10 +8,11 @@ package cmd
 
 import (
 	"fmt"
-	"io/ioutil"
+	"io"
 	"log"
 	"os"
 	"strings"
+	"sync"
 
 	vdriapi "github.com/hyperledger/aries-framework-go/pkg/framework/aries/api/vdri"
 	"github.com/hyperledger/aries-framework-go/pkg/kms"
@@ -27,6 +28,8 @@ import (
 
 	"github.com/hyperledger/indy-vdr/wrappers/golang/vdr"
 
+	"github.com/scoir/canis/pkg/amqp"
+	"github.com/scoir/canis/pkg/amqp/rabbitmq"
 	"github.com/scoir/canis/pkg/config"
 	cengine "github.com/scoir/canis/pkg/credential/engine"
 	credengine "github.com/scoir/canis/pkg/credential/engine"
@@ -40,9 +43,11 @@ import (
 	verifier "github.com/scoir/canis/pkg/didcomm/verifier/api/protogen"
 	"github.com/scoir/canis/pkg/framework"
 	"github.com/scoir/canis/pkg/framework/context"
+	indywrapper "github.com/scoir/canis/pkg/indy"
 	presentengine "github.com/scoir/canis/pkg/presentproof/engine"
 	presentindyengine "github.com/scoir/canis/pkg/presentproof/engine/indy"
 	presentjsonldengine "github.com/scoir/canis/pkg/presentproof/engine/jsonld"
+	"github.com/scoir/canis/pkg/tracing"
 	"github.com/scoir/canis/pkg/ursa"
 )
 
@@ -68,6 +73,9 @@ type Provider struct {
 	keyMgr         kms.KeyManager
 	mediatorKeyMgr kms.KeyManager
 	conf           config.Config
+	poolsOnce      sync.Once
+	pools          *indywrapper.Pools
+	ledgerCache    *indywrapper.LedgerCache
 }
 
 func Execute() {
@@ -91,6 +99,7 @@ func initConfig() {
 		Load(cfgFile).
 		WithDatastore().
 		WithLedgerStore().
+		WithAMQP().
 		WithMasterLockKey().
 		WithVDRI().
 		WithLedgerGenesis()
@@ -171,9 +180,12 @@ func (r *Provider) Oracle() credindyengine.Oracle {
 }
 
 func (r *Provider) IndyVDR() (credindyengine.VDRClient, error) {
-	genesisFile := r.conf.LedgerGenesis()
-	re := strings.NewReader(genesisFile)
-	cl, err := vdr.New(ioutil.NopCloser(re))
+	return r.IndyLedger("")
+}
+
+// IndyLedger returns the client for the ledger with the did:indy namespace, or the default ledger if empty
+func (r *Provider) IndyLedger(namespace string) (credindyengine.VDRClient, error) {
+	cl, err := r.LedgerPools().Client(namespace)
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to get indy vdr client")
 	}
@@ -181,6 +193,47 @@ func (r *Provider) IndyVDR() (credindyengine.VDRClient, error) {
 	return cl, nil
 }
 
+// LedgerPools returns the configured ledger pools, refreshed on the configured schedule
+func (r *Provider) LedgerPools() *indywrapper.Pools {
+	r.poolsOnce.Do(func() {
+		ledgers, err := r.conf.Ledgers()
+		if err != nil {
+			log.Fatalln("invalid ledgers configuration", err)
+		}
+
+		lc, err := r.conf.LedgerCache()
+		if err != nil {
+			log.Fatalln("invalid ledgerCache configuration", err)
+		}
+		r.ledgerCache = indywrapper.NewLedgerCache(lc)
+
+		pc, err := r.conf.PoolRefresh()
+		if err != nil {
+			log.Fatalln("invalid poolRefresh configuration", err)
+		}
+		r.pools = indywrapper.NewPools(ledgers, r.conf.LedgerGenesis(), r.openLedger)
+		r.pools.Monitor(pc)
+	})
+
+	return r.pools
+}
+
+// Ready returns an error if the default ledger can not be reached
+func (r *Provider) Ready() error {
+	return r.LedgerPools().Ready()
+}
+
+func (r *Provider) openLedger(namespace string, genesis io.ReadCloser) (indywrapper.IndyVDRClient, error) {
+	cl, err := vdr.New(genesis)
+	if err != nil {
+		return nil, err
+	}
+
+	ec := indywrapper.NewEndorsingClient(cl, r.store, r.KMS(), r.conf.GetString("endorser"), namespace)
+	tc := indywrapper.NewTracedClient(indywrapper.NewTAAClient(ec, r.store, namespace))
+	return indywrapper.NewCachingClient(tc, r.ledgerCache, namespace), nil
+}
+
 func (r *Provider) GetGRPCEndpoint() (*framework.Endpoint, error) {
 	return r.conf.Endpoint("api.grpc")
 }
@@ -189,13 +242,50 @@ func (r *Provider) GetBridgeEndpoint() (*framework.Endpoint, error) {
 	return r.conf.Endpoint("api.grpcBridge")
 }
 
+// DIDWebDomain returns the domain agent did:web DID documents are served from
+func (r *Provider) DIDWebDomain() string {
+	return r.conf.GetString("didweb.domain")
+}
+
+func (r *Provider) GetAMQPPublisher(queue string) amqp.Publisher {
+	cfg, err := r.conf.AMQPConfig()
+	if err != nil {
+		log.Fatalln("unexpected error reading amqp config", err)
+	}
+
+	pub, err := rabbitmq.NewPublisher(cfg.Endpoint(), queue)
+	if err != nil {
+		log.Fatalln("unable to launch rabbitmq publisher", err)
+	}
+
+	return pub
+}
+
+func (r *Provider) GetTLSConfig() (*framework.TLSConfig, error) {
+	return r.conf.TLS()
+}
+
+func (r *Provider) dial(ep *framework.Endpoint) (*grpc.ClientConn, error) {
+	tc, err := r.GetTLSConfig()
+	if err != nil {
+		return nil, errors.Wrap(err, "tls is not properly configured")
+	}
+
+	creds, err := tc.DialOption()
+	if err != nil {
+		return nil, err
+	}
+
+	return grpc.Dial(ep.Address(), creds, grpc.WithUnaryInterceptor(tracing.UnaryClientInterceptor()))
+}
+
 func (r *Provider) GetDoormanClient() (doormanapi.DoormanClient, error) {
 	ep, err := r.conf.Endpoint("doorman.grpc")
 	if err != nil {
 		return nil, errors.Wrap(err, "doorman grpc is not properly configured")
 	}
 
-	cc, err := grpc.Dial(ep.Address(), grpc.WithInsecure())
+	cc, err := r.dial(ep)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to dial grpc for doorman client")
 	}
@@ -209,7 +299,7 @@ func (r *Provider) GetIssuerClient() (issuerapi.IssuerClient, error) {
 		return nil, errors.Wrap(err, "issuer grpc is not properly configured")
 	}
 
-	cc, err := grpc.Dial(ep.Address(), grpc.WithInsecure())
+	cc, err := r.dial(ep)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to dial grpc for issuer client")
 	}
@@ -223,7 +313,7 @@ func (r *Provider) GetVerifierClient() (verifier.VerifierClient, error) {
 		return nil, errors.Wrap(err, "verifier grpc is not properly configured")
 	}
 
-	cc, err := grpc.Dial(ep.Address(), grpc.WithInsecure())
+	cc, err := r.dial(ep)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to dial grpc for verifier client")
 	}
@@ -237,7 +327,7 @@ func (r *Provider) GetLoadbalancerClient() (lbapi.LoadbalancerClient, error) {
 		return nil, errors.Wrap(err, "loadbalancer grpc is not properly configured")
 	}
 
-	cc, err := grpc.Dial(ep.Address(), grpc.WithInsecure())
+	cc, err := r.dial(ep)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to dial grpc for load balancer client")
 	}
@@ -251,7 +341,7 @@ func (r *Provider) GetMediatorClient() (api.MediatorClient, error) {
 		return nil, errors.Wrap(err, "mediator grpc is not properly configured")
 	}
 
-	cc, err := grpc.Dial(ep.Address(), grpc.WithInsecure())
+	cc, err := r.dial(ep)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to dial grpc for mediator client")
 	}
@@ -13,6 +13,7 @@ import (
 
 	"github.com/scoir/canis/pkg/apiserver"
 	"github.com/scoir/canis/pkg/controller"
+	"github.com/scoir/canis/pkg/tracing"
 )
 
 var startCmd = &cobra.Command{
@@ -23,6 +24,7 @@ var startCmd = &cobra.Command{
 }
 
 func runStart(cmd *cobra.Command, args []string) {
+	defer tracing.Setup("canis-apiserver", ctx.conf)()
 
 	srv, err := apiserver.New(ctx)
 	if err != nil {
@@ -0,0 +1,152 @@
+/*
+Copyright Scoir Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package apiserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	api "github.com/scoir/canis/pkg/apiserver/api/protogen"
+	"github.com/scoir/canis/pkg/datastore"
+)
+
+func (r *APIServer) ListEndorsements(_ context.Context, req *api.ListEndorsementsRequest) (*api.ListEndorsementsResponse, error) {
+	critter := &datastore.EndorsementCriteria{
+		Status:   req.Status,
+		Start:    int(req.Start),
+		PageSize: int(req.PageSize),
+	}
+
+	if critter.PageSize == 0 {
+		critter.PageSize = 10
+	}
+
+	results, err := r.store.ListEndorsements(critter)
+	if err != nil {
+		return nil, status.Error(codes.Internal, errors.Wrap(err, "unable to list endorsements").Error())
+	}
+
+	out := &api.ListEndorsementsResponse{
+		Count:        int64(results.Count),
+		Endorsements: make([]*api.Endorsement, len(results.Endorsements)),
+	}
+
+	for i, e := range results.Endorsements {
+		out.Endorsements[i] = endorsementToAPI(e)
+	}
+
+	return out, nil
+}
+
+func (r *APIServer) GetEndorsement(_ context.Context, req *api.GetEndorsementRequest) (*api.GetEndorsementResponse, error) {
+	e, err := r.store.GetEndorsement(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("endorsement with id %s not found", req.Id))
+	}
+
+	return &api.GetEndorsementResponse{
+		Endorsement: endorsementToAPI(e),
+	}, nil
+}
+
+// CompleteEndorsement marks a pending endorsement as completed.  If the endorser returned the endorsed
+// transaction instead of submitting it to the ledger it is submitted here.
+func (r *APIServer) CompleteEndorsement(_ context.Context, req *api.CompleteEndorsementRequest) (*api.CompleteEndorsementResponse, error) {
+	e, err := r.pendingEndorsement(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.EndorsedRequest != "" {
+		if !sameTransaction(e.Request, req.EndorsedRequest) {
+			return nil, status.Error(codes.InvalidArgument, "endorsed request does not match the transaction awaiting endorsement")
+		}
+
+		client, err := r.ledger(e.Ledger)
+		if err != nil {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+
+		_, err = client.Submit([]byte(req.EndorsedRequest))
+		if err != nil {
+			return nil, status.Error(codes.Unavailable, errors.Wrap(err, "unable to submit endorsed transaction").Error())
+		}
+		e.Request = req.EndorsedRequest
+	}
+
+	e.Status = datastore.EndorsementCompleted
+	err = r.store.UpdateEndorsement(e)
+	if err != nil {
+		return nil, status.Error(codes.Internal, errors.Wrap(err, "unable to update endorsement").Error())
+	}
+
+	return &api.CompleteEndorsementResponse{}, nil
+}
+
+func (r *APIServer) RejectEndorsement(_ context.Context, req *api.RejectEndorsementRequest) (*api.RejectEndorsementResponse, error) {
+	e, err := r.pendingEndorsement(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	e.Status = datastore.EndorsementRejected
+	err = r.store.UpdateEndorsement(e)
+	if err != nil {
+		return nil, status.Error(codes.Internal, errors.Wrap(err, "unable to update endorsement").Error())
+	}
+
+	return &api.RejectEndorsementResponse{}, nil
+}
+
+func (r *APIServer) pendingEndorsement(id string) (*datastore.Endorsement, error) {
+	e, err := r.store.GetEndorsement(id)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("endorsement with id %s not found", id))
+	}
+
+	if e.Status != datastore.EndorsementPending {
+		return nil, status.Error(codes.FailedPrecondition, fmt.Sprintf("endorsement %s is already %s", id, e.Status))
+	}
+
+	return e, nil
+}
+
+// sameTransaction compares the author, endorser and request ID of two signed requests
+func sameTransaction(pending, endorsed string) bool {
+	type txn struct {
+		Identifier string      `json:"identifier"`
+		Endorser   string      `json:"endorser"`
+		ReqID      json.Number `json:"reqId"`
+	}
+
+	var a, b txn
+	if json.Unmarshal([]byte(pending), &a) != nil || json.Unmarshal([]byte(endorsed), &b) != nil {
+		return false
+	}
+
+	return a == b
+}
+
+func endorsementToAPI(e *datastore.Endorsement) *api.Endorsement {
+	return &api.Endorsement{
+		Id:          e.ID,
+		TxnType:     e.TxnType,
+		AuthorDid:   e.AuthorDID,
+		EndorserDid: e.EndorserDID,
+		Request:     e.Request,
+		Status:      e.Status,
+		Created:     timestamppb.New(e.Created),
+		Updated:     timestamppb.New(e.Updated),
+		Ledger:      e.Ledger,
+	}
+}
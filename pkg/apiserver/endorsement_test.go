@@ -0,0 +1,132 @@
+/*
+Copyright Scoir Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package apiserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	api "github.com/scoir/canis/pkg/apiserver/api/protogen"
+	"github.com/scoir/canis/pkg/datastore"
+)
+
+const pendingRequest = `{"identifier":"author","endorser":"endorser","reqId":1234,"signatures":{"author":"sig"}}`
+
+func pendingEndorsement() *datastore.Endorsement {
+	return &datastore.Endorsement{
+		ID:          "endorsement-id",
+		TxnType:     "102",
+		AuthorDID:   "author",
+		EndorserDID: "endorser",
+		Request:     pendingRequest,
+		Status:      datastore.EndorsementPending,
+	}
+}
+
+func TestListEndorsements(t *testing.T) {
+	t.Run("store error", func(t *testing.T) {
+		target, suite := SetupTest()
+		suite.Store.On("ListEndorsements", mock.AnythingOfType("*datastore.EndorsementCriteria")).Return(nil, errors.New("boom"))
+
+		resp, err := target.ListEndorsements(context.Background(), &api.ListEndorsementsRequest{})
+		require.Nil(t, resp)
+		require.Equal(t, codes.Internal, status.Code(err))
+	})
+	t.Run("pending", func(t *testing.T) {
+		target, suite := SetupTest()
+		match := func(c *datastore.EndorsementCriteria) bool {
+			return c.Status == datastore.EndorsementPending && c.PageSize == 10
+		}
+		suite.Store.On("ListEndorsements", mock.MatchedBy(match)).Return(&datastore.EndorsementList{
+			Count:        1,
+			Endorsements: []*datastore.Endorsement{pendingEndorsement()},
+		}, nil)
+
+		resp, err := target.ListEndorsements(context.Background(), &api.ListEndorsementsRequest{Status: datastore.EndorsementPending})
+		require.NoError(t, err)
+		require.Equal(t, int64(1), resp.Count)
+		require.Equal(t, "endorsement-id", resp.Endorsements[0].Id)
+		require.Equal(t, "endorser", resp.Endorsements[0].EndorserDid)
+	})
+}
+
+func TestCompleteEndorsement(t *testing.T) {
+	t.Run("not found", func(t *testing.T) {
+		target, suite := SetupTest()
+		suite.Store.On("GetEndorsement", "endorsement-id").Return(nil, errors.New("not found"))
+
+		resp, err := target.CompleteEndorsement(context.Background(), &api.CompleteEndorsementRequest{Id: "endorsement-id"})
+		require.Nil(t, resp)
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+	t.Run("already rejected", func(t *testing.T) {
+		target, suite := SetupTest()
+		e := pendingEndorsement()
+		e.Status = datastore.EndorsementRejected
+		suite.Store.On("GetEndorsement", "endorsement-id").Return(e, nil)
+
+		resp, err := target.CompleteEndorsement(context.Background(), &api.CompleteEndorsementRequest{Id: "endorsement-id"})
+		require.Nil(t, resp)
+		require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+	t.Run("different transaction", func(t *testing.T) {
+		target, suite := SetupTest()
+		suite.Store.On("GetEndorsement", "endorsement-id").Return(pendingEndorsement(), nil)
+
+		resp, err := target.CompleteEndorsement(context.Background(), &api.CompleteEndorsementRequest{
+			Id:              "endorsement-id",
+			EndorsedRequest: `{"identifier":"author","endorser":"endorser","reqId":9999}`,
+		})
+		require.Nil(t, resp)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		require.Nil(t, suite.IndyClient.Submitted)
+	})
+	t.Run("submit endorsed", func(t *testing.T) {
+		target, suite := SetupTest()
+		endorsed := `{"identifier":"author","endorser":"endorser","reqId":1234,"signatures":{"author":"sig","endorser":"sig"}}`
+		suite.Store.On("GetEndorsement", "endorsement-id").Return(pendingEndorsement(), nil)
+		match := func(e *datastore.Endorsement) bool {
+			return e.Status == datastore.EndorsementCompleted && e.Request == endorsed
+		}
+		suite.Store.On("UpdateEndorsement", mock.MatchedBy(match)).Return(nil)
+
+		_, err := target.CompleteEndorsement(context.Background(), &api.CompleteEndorsementRequest{
+			Id:              "endorsement-id",
+			EndorsedRequest: endorsed,
+		})
+		require.NoError(t, err)
+		require.Equal(t, []byte(endorsed), suite.IndyClient.Submitted)
+	})
+	t.Run("submitted by endorser", func(t *testing.T) {
+		target, suite := SetupTest()
+		suite.Store.On("GetEndorsement", "endorsement-id").Return(pendingEndorsement(), nil)
+		suite.Store.On("UpdateEndorsement", mock.AnythingOfType("*datastore.Endorsement")).Return(nil)
+
+		_, err := target.CompleteEndorsement(context.Background(), &api.CompleteEndorsementRequest{Id: "endorsement-id"})
+		require.NoError(t, err)
+		require.Nil(t, suite.IndyClient.Submitted)
+	})
+}
+
+func TestRejectEndorsement(t *testing.T) {
+	target, suite := SetupTest()
+	suite.Store.On("GetEndorsement", "endorsement-id").Return(pendingEndorsement(), nil)
+	match := func(e *datastore.Endorsement) bool {
+		return e.Status == datastore.EndorsementRejected
+	}
+	suite.Store.On("UpdateEndorsement", mock.MatchedBy(match)).Return(nil)
+
+	_, err := target.RejectEndorsement(context.Background(), &api.RejectEndorsementRequest{Id: "endorsement-id"})
+	require.NoError(t, err)
+	suite.Store.AssertExpectations(t)
+}
@@ -0,0 +1,46 @@
+/*
+Copyright Scoir Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package apiserver
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	api "github.com/scoir/canis/pkg/apiserver/api/protogen"
+	"github.com/scoir/canis/pkg/enrollment"
+)
+
+// CreateEnrollmentToken mints a single use token an edge or cloud agent registers for an external ID with
+func (r *APIServer) CreateEnrollmentToken(_ context.Context, req *api.CreateEnrollmentTokenRequest) (*api.CreateEnrollmentTokenResponse, error) {
+	if req.ExternalId == "" {
+		return nil, status.Error(codes.InvalidArgument, "external ID is required")
+	}
+
+	agentType := enrollment.EdgeAgent
+	if req.AgentType == api.CreateEnrollmentTokenRequest_CLOUD {
+		agentType = enrollment.CloudAgent
+	}
+
+	ttl := time.Duration(req.TtlSeconds) * time.Second
+	if ttl > enrollment.MaxTTL {
+		return nil, status.Errorf(codes.InvalidArgument, "token lifetime can not exceed %s", enrollment.MaxTTL)
+	}
+
+	token, expires, err := enrollment.Mint(r.store, agentType, req.ExternalId, ttl)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to create enrollment token: (%v)", err)
+	}
+
+	return &api.CreateEnrollmentTokenResponse{
+		Token:   token,
+		Expires: timestamppb.New(expires),
+	}, nil
+}
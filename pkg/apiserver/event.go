@@ -0,0 +1,28 @@
+/*
+Copyright Scoir Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package apiserver
+
+const (
+	AgentTopic      = "agents"
+	KeyRotatedEvent = "key_rotated"
+)
+
+// AgentKeyRotation is published when an agent's public DID key is rotated so the connections that hold
+// the old verkey can be told to use the new one
+type AgentKeyRotation struct {
+	AgentName   string            `json:"agent_name"`
+	DID         string            `json:"did"`
+	Verkey      string            `json:"verkey"`
+	Connections []*ConnectionInfo `json:"connections"`
+}
+
+type ConnectionInfo struct {
+	ConnectionID string `json:"connection_id"`
+	ExternalID   string `json:"external_id"`
+	TheirDID     string `json:"their_did"`
+	MyDID        string `json:"my_did"`
+}
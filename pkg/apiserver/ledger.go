@@ -0,0 +1,52 @@
+/*
+Copyright Scoir Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package apiserver
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	api "github.com/scoir/canis/pkg/apiserver/api/protogen"
+)
+
+func (r *APIServer) GetLedgerStatus(_ context.Context, req *api.GetLedgerStatusRequest) (*api.GetLedgerStatusResponse, error) {
+	if r.pools == nil {
+		return nil, status.Error(codes.Unavailable, "no ledgers are configured")
+	}
+
+	if req.Refresh {
+		r.pools.RefreshAll()
+	}
+
+	out := &api.GetLedgerStatusResponse{}
+	for _, h := range r.pools.Status() {
+		out.Ledgers = append(out.Ledgers, &api.LedgerPoolStatus{
+			Ledger:      h.Namespace,
+			Connected:   h.Connected,
+			Validators:  int32(h.Validators),
+			LastRefresh: timestamp(h.LastRefresh),
+			LastRead:    timestamp(h.LastRead),
+			LastWrite:   timestamp(h.LastWrite),
+			LastError:   h.LastError,
+		})
+	}
+
+	return out, nil
+}
+
+// timestamp converts t to a protobuf timestamp, nil if t is zero
+func timestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+
+	return timestamppb.New(t)
+}
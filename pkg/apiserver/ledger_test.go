@@ -0,0 +1,52 @@
+/*
+Copyright Scoir Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package apiserver
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	api "github.com/scoir/canis/pkg/apiserver/api/protogen"
+	"github.com/scoir/canis/pkg/indy"
+	"github.com/scoir/canis/pkg/indy/sim"
+)
+
+func TestGetLedgerStatus(t *testing.T) {
+	t.Run("no pools", func(t *testing.T) {
+		target, _ := SetupTest()
+
+		resp, err := target.GetLedgerStatus(context.Background(), &api.GetLedgerStatusRequest{})
+		require.Nil(t, resp)
+		require.Equal(t, codes.Unavailable, status.Code(err))
+	})
+	t.Run("refresh", func(t *testing.T) {
+		target, _ := SetupTest()
+		target.pools = indy.NewPools(nil, "genesis", func(string, io.ReadCloser) (indy.IndyVDRClient, error) {
+			return sim.New(), nil
+		})
+
+		resp, err := target.GetLedgerStatus(context.Background(), &api.GetLedgerStatusRequest{})
+		require.NoError(t, err)
+		require.Len(t, resp.Ledgers, 1)
+		require.False(t, resp.Ledgers[0].Connected)
+		require.Nil(t, resp.Ledgers[0].LastRefresh)
+
+		_, err = target.pools.Client("")
+		require.NoError(t, err)
+
+		resp, err = target.GetLedgerStatus(context.Background(), &api.GetLedgerStatusRequest{Refresh: true})
+		require.NoError(t, err)
+		require.True(t, resp.Ledgers[0].Connected)
+		require.NotNil(t, resp.Ledgers[0].LastRefresh)
+		require.Empty(t, resp.Ledgers[0].LastError)
+	})
+}
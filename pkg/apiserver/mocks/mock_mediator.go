@@ -9,10 +9,18 @@ import (
 )
 
 type MockMediator struct {
-	RegisterResponse *common.RegisterEdgeAgentResponse
-	RegisterErr      error
-	EndpointResponse *common.EndpointResponse
-	EndpointErr      error
+	RegisterResponse   *common.RegisterEdgeAgentResponse
+	RegisterErr        error
+	EndpointResponse   *common.EndpointResponse
+	EndpointErr        error
+	MailboxResponse    *common.MailboxStatusResponse
+	MailboxErr         error
+	ListRoutesResponse *common.ListEdgeAgentRoutesResponse
+	ListRoutesErr      error
+	RoutesResponse     *common.GetEdgeAgentRoutesResponse
+	RoutesErr          error
+	RevokeResponse     *common.RevokeEdgeAgentRouteResponse
+	RevokeErr          error
 }
 
 func (r *MockMediator) RegisterEdgeAgent(ctx context.Context, in *common.RegisterEdgeAgentRequest, opts ...grpc.CallOption) (*common.RegisterEdgeAgentResponse, error) {
@@ -30,3 +38,35 @@ func (r *MockMediator) GetEndpoint(ctx context.Context, in *common.EndpointReque
 
 	return r.EndpointResponse, nil
 }
+
+func (r *MockMediator) GetMailboxStatus(ctx context.Context, in *common.MailboxStatusRequest, opts ...grpc.CallOption) (*common.MailboxStatusResponse, error) {
+	if r.MailboxErr != nil {
+		return nil, r.MailboxErr
+	}
+
+	return r.MailboxResponse, nil
+}
+
+func (r *MockMediator) ListEdgeAgentRoutes(ctx context.Context, in *common.ListEdgeAgentRoutesRequest, opts ...grpc.CallOption) (*common.ListEdgeAgentRoutesResponse, error) {
+	if r.ListRoutesErr != nil {
+		return nil, r.ListRoutesErr
+	}
+
+	return r.ListRoutesResponse, nil
+}
+
+func (r *MockMediator) GetEdgeAgentRoutes(ctx context.Context, in *common.GetEdgeAgentRoutesRequest, opts ...grpc.CallOption) (*common.GetEdgeAgentRoutesResponse, error) {
+	if r.RoutesErr != nil {
+		return nil, r.RoutesErr
+	}
+
+	return r.RoutesResponse, nil
+}
+
+func (r *MockMediator) RevokeEdgeAgentRoute(ctx context.Context, in *common.RevokeEdgeAgentRouteRequest, opts ...grpc.CallOption) (*common.RevokeEdgeAgentRouteResponse, error) {
+	if r.RevokeErr != nil {
+		return nil, r.RevokeErr
+	}
+
+	return r.RevokeResponse, nil
+}
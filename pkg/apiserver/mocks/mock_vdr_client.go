@@ -5,11 +5,29 @@ import (
 )
 
 type MockVDRClient struct {
-	GetNymReply *vdr.ReadReply
-	GetNymErr   error
+	GetNymReply    *vdr.ReadReply
+	GetNymErr      error
+	GetTAAReply    *vdr.ReadReply
+	GetTAAErr      error
+	GetAMLReply    *vdr.ReadReply
+	GetAMLErr      error
+	Submitted      []byte
+	SubmitErr      error
+	CreateNymErr   error
+	GetSchemaReply *vdr.ReadReply
+	GetSchemaErr   error
+	NymVerkeys     map[string]string
 }
 
 func (r *MockVDRClient) CreateNym(did, verkey, role, from string, signer vdr.Signer) error {
+	if r.CreateNymErr != nil {
+		return r.CreateNymErr
+	}
+
+	if r.NymVerkeys == nil {
+		r.NymVerkeys = map[string]string{}
+	}
+	r.NymVerkeys[did] = verkey
 	return nil
 }
 
@@ -25,6 +43,39 @@ func (r *MockVDRClient) GetNym(did string) (*vdr.ReadReply, error) {
 	return r.GetNymReply, nil
 }
 
+func (r *MockVDRClient) GetSchema(schemaID string) (*vdr.ReadReply, error) {
+	if r.GetSchemaErr != nil {
+		return nil, r.GetSchemaErr
+	}
+
+	return r.GetSchemaReply, nil
+}
+
 func (r *MockVDRClient) GetPoolStatus() (*vdr.PoolStatus, error) {
 	return &vdr.PoolStatus{}, nil
 }
+
+func (r *MockVDRClient) GetTxnAuthorAgreement() (*vdr.ReadReply, error) {
+	if r.GetTAAErr != nil {
+		return nil, r.GetTAAErr
+	}
+
+	return r.GetTAAReply, nil
+}
+
+func (r *MockVDRClient) GetAcceptanceMethodList() (*vdr.ReadReply, error) {
+	if r.GetAMLErr != nil {
+		return nil, r.GetAMLErr
+	}
+
+	return r.GetAMLReply, nil
+}
+
+func (r *MockVDRClient) Submit(request []byte) (*vdr.ReadReply, error) {
+	if r.SubmitErr != nil {
+		return nil, r.SubmitErr
+	}
+
+	r.Submitted = request
+	return &vdr.ReadReply{}, nil
+}
@@ -3,6 +3,8 @@
 package mocks
 
 import (
+	amqp "github.com/scoir/canis/pkg/amqp"
+
 	api "github.com/scoir/canis/pkg/didcomm/doorman/api/protogen"
 	apiprotogen "github.com/scoir/canis/pkg/didcomm/loadbalancer/api/protogen"
 
@@ -18,10 +20,14 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	pkgindy "github.com/scoir/canis/pkg/indy"
+
 	presentproofengine "github.com/scoir/canis/pkg/presentproof/engine"
 
 	protogen "github.com/scoir/canis/pkg/didcomm/issuer/api/protogen"
 
+	storage "github.com/hyperledger/aries-framework-go/pkg/storage"
+
 	verifierapiprotogen "github.com/scoir/canis/pkg/didcomm/verifier/api/protogen"
 )
 
@@ -30,6 +36,36 @@ type Provider struct {
 	mock.Mock
 }
 
+// DIDWebDomain provides a mock function with given fields:
+func (_m *Provider) DIDWebDomain() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// GetAMQPPublisher provides a mock function with given fields: queue
+func (_m *Provider) GetAMQPPublisher(queue string) amqp.Publisher {
+	ret := _m.Called(queue)
+
+	var r0 amqp.Publisher
+	if rf, ok := ret.Get(0).(func(string) amqp.Publisher); ok {
+		r0 = rf(queue)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(amqp.Publisher)
+		}
+	}
+
+	return r0
+}
+
 // GetCredentialEngineRegistry provides a mock function with given fields:
 func (_m *Provider) GetCredentialEngineRegistry() (engine.CredentialRegistry, error) {
 	ret := _m.Called()
@@ -191,6 +227,29 @@ func (_m *Provider) GetVerifierClient() (verifierapiprotogen.VerifierClient, err
 	return r0, r1
 }
 
+// IndyLedger provides a mock function with given fields: namespace
+func (_m *Provider) IndyLedger(namespace string) (indy.VDRClient, error) {
+	ret := _m.Called(namespace)
+
+	var r0 indy.VDRClient
+	if rf, ok := ret.Get(0).(func(string) indy.VDRClient); ok {
+		r0 = rf(namespace)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(indy.VDRClient)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(namespace)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // IndyVDR provides a mock function with given fields:
 func (_m *Provider) IndyVDR() (indy.VDRClient, error) {
 	ret := _m.Called()
@@ -230,6 +289,22 @@ func (_m *Provider) KMS() kms.KeyManager {
 	return r0
 }
 
+// LedgerPools provides a mock function with given fields:
+func (_m *Provider) LedgerPools() *pkgindy.Pools {
+	ret := _m.Called()
+
+	var r0 *pkgindy.Pools
+	if rf, ok := ret.Get(0).(func() *pkgindy.Pools); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pkgindy.Pools)
+		}
+	}
+
+	return r0
+}
+
 // MediatorKMS provides a mock function with given fields:
 func (_m *Provider) MediatorKMS() kms.KeyManager {
 	ret := _m.Called()
@@ -246,6 +321,22 @@ func (_m *Provider) MediatorKMS() kms.KeyManager {
 	return r0
 }
 
+// StorageProvider provides a mock function with given fields:
+func (_m *Provider) StorageProvider() storage.Provider {
+	ret := _m.Called()
+
+	var r0 storage.Provider
+	if rf, ok := ret.Get(0).(func() storage.Provider); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(storage.Provider)
+		}
+	}
+
+	return r0
+}
+
 // Store provides a mock function with given fields:
 func (_m *Provider) Store() datastore.Store {
 	ret := _m.Called()
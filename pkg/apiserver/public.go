@@ -2,24 +2,40 @@ package apiserver
 
 import (
 	"context"
+	"net/url"
 
 	"github.com/google/tink/go/keyset"
 	"github.com/google/tink/go/signature/subtle"
+	diddoc "github.com/hyperledger/aries-framework-go/pkg/doc/did"
+	vdriapi "github.com/hyperledger/aries-framework-go/pkg/framework/aries/api/vdri"
 	"github.com/hyperledger/aries-framework-go/pkg/kms"
+	"github.com/hyperledger/aries-framework-go/pkg/kms/localkms"
 	"github.com/mr-tron/base58"
 	"github.com/pkg/errors"
 
 	"github.com/hyperledger/indy-vdr/wrappers/golang/identifiers"
 	"github.com/hyperledger/indy-vdr/wrappers/golang/vdr"
 
+	"github.com/scoir/canis/pkg/aries/vdri/web"
 	"github.com/scoir/canis/pkg/datastore"
+	indywrapper "github.com/scoir/canis/pkg/indy"
 	"github.com/scoir/canis/pkg/protogen/common"
 )
 
+const (
+	agentsPath     = "agents"
+	didContextV1   = "https://w3id.org/did/v1"
+	ed25519KeyType = "Ed25519VerificationKey2018"
+)
+
 func (r *APIServer) createAgentPublicDID(a *datastore.Agent) error {
-	//TODO:  where is the methodName stored
 	//TODO: use Indy IndyVDR for now but do NOT tie ourselves to Indy!
-	did, err := r.store.GetPublicDID()
+	client, err := r.ledger(a.Ledger)
+	if err != nil {
+		return err
+	}
+
+	did, err := r.store.GetLedgerPublicDID(a.Ledger)
 	if err != nil {
 		return errors.Wrap(err, "unable to get public DID.")
 	}
@@ -44,17 +60,17 @@ func (r *APIServer) createAgentPublicDID(a *datastore.Agent) error {
 		return errors.Wrap(err, "unable to load signer primitives")
 	}
 
-	agentPublicDID, err := identifiers.CreateDID(&identifiers.MyDIDInfo{PublicKey: pubKey, MethodName: "sov", Cid: true})
+	agentPublicDID, err := identifiers.CreateDID(&identifiers.MyDIDInfo{PublicKey: pubKey, MethodName: indywrapper.DIDMethod(a.Ledger), Cid: true})
 	if err != nil {
 		return errors.Wrap(err, "unable to create agent DID")
 	}
 
-	err = r.client.CreateNym(agentPublicDID.DIDVal.MethodSpecificID, agentPublicDID.Verkey, vdr.EndorserRole, did.DID.DIDVal.MethodSpecificID, mysig)
+	err = client.CreateNym(agentPublicDID.DIDVal.MethodSpecificID, agentPublicDID.Verkey, vdr.EndorserRole, did.DID.DIDVal.MethodSpecificID, mysig)
 	if err != nil {
 		return errors.Wrap(err, "unable to set nym")
 	}
 
-	err = r.client.SetEndpoint(agentPublicDID.DIDVal.MethodSpecificID, agentPublicDID.DIDVal.MethodSpecificID,
+	err = client.SetEndpoint(agentPublicDID.DIDVal.MethodSpecificID, agentPublicDID.DIDVal.MethodSpecificID,
 		endpoint.Endpoint, newDIDsig)
 	if err != nil {
 		return errors.Wrap(err, "unable to set endpoint")
@@ -67,11 +83,88 @@ func (r *APIServer) createAgentPublicDID(a *datastore.Agent) error {
 			PublicKey: base58.Encode(pubKey),
 		},
 		Endpoint: endpoint.Endpoint,
+		Ledger:   a.Ledger,
+	}
+
+	return nil
+}
+
+// createAgentWebDID creates a did:web public DID for an agent.  Nothing is written to a ledger, the DID
+// document is served by GetAgentDIDDocument from the configured did:web domain
+func (r *APIServer) createAgentWebDID(a *datastore.Agent) error {
+	if r.didWebDomain == "" {
+		return errors.New("didweb domain is not configured")
+	}
+
+	endpoint, err := r.loadbalancer.GetEndpoint(context.Background(), &common.EndpointRequest{})
+	if err != nil {
+		return errors.Wrap(err, "unable to retrieve endpoint for new agent")
+	}
+
+	newKeyID, pubKey, err := r.keyMgr.CreateAndExportPubKeyBytes(kms.ED25519Type)
+	if err != nil {
+		return errors.Wrap(err, "unable to create and export public key")
+	}
+
+	methodID := web.MethodID(r.didWebDomain, agentsPath, url.PathEscape(a.Name))
+	verkey := base58.Encode(pubKey)
+
+	a.PublicDID = &datastore.DID{
+		DID: &identifiers.DID{
+			DIDVal: identifiers.DIDValue{
+				Method:           web.Method,
+				MethodSpecificID: methodID,
+			},
+			Verkey: verkey,
+		},
+		KeyPair: &datastore.KeyPair{
+			ID:        newKeyID,
+			PublicKey: verkey,
+		},
+		Endpoint: endpoint.Endpoint,
 	}
 
 	return nil
 }
 
+// webDIDDocument builds the did:web DID document for an agent public DID
+func webDIDDocument(d *datastore.DID) (*diddoc.Doc, error) {
+	pubKeyValue, err := base58.Decode(d.KeyPair.PublicKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid public key")
+	}
+
+	kid, err := localkms.CreateKID(pubKeyValue, kms.ED25519Type)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create key ID")
+	}
+
+	id := d.DID.String()
+	pubKey := diddoc.NewPublicKeyFromBytes("#"+kid, ed25519KeyType, id, pubKeyValue)
+
+	doc := &diddoc.Doc{
+		Context:   []string{didContextV1},
+		ID:        id,
+		PublicKey: []diddoc.PublicKey{*pubKey},
+		Authentication: []diddoc.VerificationMethod{
+			*diddoc.NewReferencedVerificationMethod(pubKey, diddoc.Authentication, true),
+		},
+		AssertionMethod: []diddoc.VerificationMethod{
+			*diddoc.NewReferencedVerificationMethod(pubKey, diddoc.AssertionMethod, true),
+		},
+		Service: []diddoc.Service{
+			{
+				ID:              "#agent",
+				Type:            vdriapi.DIDCommServiceType,
+				ServiceEndpoint: d.Endpoint,
+				RecipientKeys:   []string{d.KeyPair.PublicKey},
+			},
+		},
+	}
+
+	return doc, nil
+}
+
 func (r *APIServer) createMediatorPublicDID() error {
 	did, err := r.store.GetPublicDID()
 	if err != nil {
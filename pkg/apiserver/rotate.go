@@ -0,0 +1,253 @@
+/*
+Copyright Scoir Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package apiserver
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"time"
+
+	"github.com/hyperledger/aries-framework-go/pkg/kms"
+	"github.com/hyperledger/indy-vdr/wrappers/golang/vdr"
+	"github.com/mr-tron/base58"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	api "github.com/scoir/canis/pkg/apiserver/api/protogen"
+	"github.com/scoir/canis/pkg/aries/vdri/web"
+	"github.com/scoir/canis/pkg/datastore"
+	"github.com/scoir/canis/pkg/notifier"
+)
+
+// DefaultKeyGracePeriod is how long a rotated key can still unpack messages when no grace period is requested
+const DefaultKeyGracePeriod = 24 * time.Hour
+
+func (r *APIServer) RotateAgentKey(_ context.Context, req *api.RotateAgentKeyRequest) (*api.RotateAgentKeyResponse, error) {
+	if req.GracePeriod < 0 {
+		return nil, status.Error(codes.InvalidArgument, "grace period can not be negative")
+	}
+
+	a, err := r.agentStore.GetAgent(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, errors.Wrapf(err, "unable to find agent %s", req.Id).Error())
+	}
+
+	if a.PublicDID == nil || a.PublicDID.KeyPair == nil {
+		return nil, status.Error(codes.FailedPrecondition, "agent does not have a public DID")
+	}
+
+	var client vdrClient
+	if a.PublicDIDMethod != web.Method {
+		client, err = r.ledger(a.Ledger)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	err = r.rotateKey(client, a.PublicDID, gracePeriod(req.GracePeriod), func() error {
+		return r.agentStore.UpdateAgent(a)
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, errors.Wrapf(err, "unable to rotate key for agent %s", req.Id).Error())
+	}
+
+	r.publishKeyRotation(a)
+
+	return &api.RotateAgentKeyResponse{
+		Did:    a.PublicDID.DID.String(),
+		Verkey: a.PublicDID.KeyPair.PublicKey,
+	}, nil
+}
+
+func (r *APIServer) RotatePublicDIDKey(_ context.Context, req *api.RotatePublicDIDKeyRequest) (*api.RotatePublicDIDKeyResponse, error) {
+	if req.GracePeriod < 0 {
+		return nil, status.Error(codes.InvalidArgument, "grace period can not be negative")
+	}
+
+	client, err := r.ledger(req.Ledger)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	d, err := r.store.GetLedgerPublicDID(req.Ledger)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, errors.Wrap(err, "public DID has not been seeded").Error())
+	}
+
+	err = r.rotateKey(client, d, gracePeriod(req.GracePeriod), func() error {
+		return r.store.SetPublicDID(d)
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, errors.Wrap(err, "unable to rotate public DID key").Error())
+	}
+
+	return &api.RotatePublicDIDKeyResponse{
+		Did:    d.DID.String(),
+		Verkey: d.KeyPair.PublicKey,
+	}, nil
+}
+
+// rotateKey replaces the key of d with a new one from the KMS.  The new key is saved as pending before, if
+// client is not nil, it is written to the DID's NYM signed by the current key, so a rotation that fails after
+// the ledger write is completed by retrying it.  The replaced key is retired until grace has passed and retired
+// keys that have expired are deleted from the KMS
+func (r *APIServer) rotateKey(client vdrClient, d *datastore.DID, grace time.Duration, save func() error) error {
+	resumed := d.PendingKey != nil
+	if !resumed {
+		newKeyID, pubKey, err := r.keyMgr.CreateAndExportPubKeyBytes(kms.ED25519Type)
+		if err != nil {
+			return errors.Wrap(err, "unable to create and export public key")
+		}
+
+		d.PendingKey = &datastore.KeyPair{
+			ID:        newKeyID,
+			PublicKey: base58.Encode(pubKey),
+		}
+
+		err = save()
+		if err != nil {
+			return errors.Wrap(err, "unable to save pending key")
+		}
+	}
+
+	if client != nil {
+		err := r.writeVerkey(client, d, resumed)
+		if err != nil {
+			return errors.Wrapf(err, "rotation to pending key %s was not completed, retry to complete it",
+				d.PendingKey.PublicKey)
+		}
+	}
+
+	now := time.Now()
+	retired := []*datastore.RetiredKey{{KeyPair: d.KeyPair, Expires: now.Add(grace)}}
+	for _, k := range d.RetiredKeys {
+		if now.Before(k.Expires) {
+			retired = append(retired, k)
+			continue
+		}
+
+		err := r.retiredKeys.Delete(k.KeyPair.PublicKey, k.KeyPair.ID)
+		if err != nil {
+			log.Printf("unable to delete expired key %s, keeping it for the next rotation: %v\n", k.KeyPair.ID, err)
+			retired = append(retired, k)
+		}
+	}
+
+	err := r.retiredKeys.Retire(d.KeyPair.PublicKey, d.KeyPair.ID, now.Add(grace))
+	if err != nil {
+		return errors.Wrapf(err, "unable to retire key %s", d.KeyPair.ID)
+	}
+
+	d.RetiredKeys = retired
+	d.KeyPair = d.PendingKey
+	d.PendingKey = nil
+	d.DID.Verkey = d.KeyPair.PublicKey
+
+	err = save()
+	if err != nil {
+		return errors.Wrapf(err, "verkey %s is on the ledger but the rotation was not saved, retry to complete it",
+			d.KeyPair.PublicKey)
+	}
+
+	return nil
+}
+
+// writeVerkey writes the pending key of d to its NYM, unless a resumed rotation already wrote it
+func (r *APIServer) writeVerkey(client vdrClient, d *datastore.DID, resumed bool) error {
+	methodID := d.DID.DIDVal.MethodSpecificID
+	if resumed {
+		rply, err := client.GetNym(methodID)
+		if err != nil {
+			return errors.Wrap(err, "unable to read nym verkey")
+		}
+
+		if nymVerkey(rply) == d.PendingKey.PublicKey {
+			return nil
+		}
+	}
+
+	oldsig, err := r.getSignerForID(r.keyMgr, d.KeyPair.ID)
+	if err != nil {
+		return errors.Wrap(err, "unable to get signer for current key")
+	}
+
+	err = client.CreateNym(methodID, d.PendingKey.PublicKey, "", methodID, oldsig)
+	if err != nil {
+		return errors.Wrap(err, "unable to update nym verkey")
+	}
+
+	return nil
+}
+
+func nymVerkey(rply *vdr.ReadReply) string {
+	if rply == nil {
+		return ""
+	}
+
+	data, ok := rply.Data.(string)
+	if !ok {
+		return ""
+	}
+
+	nym := struct {
+		Verkey string `json:"verkey"`
+	}{}
+	_ = json.Unmarshal([]byte(data), &nym)
+	return nym.Verkey
+}
+
+// publishKeyRotation notifies webhook subscribers of the new verkey and the connections that should be told of it
+func (r *APIServer) publishKeyRotation(a *datastore.Agent) {
+	if r.notifications == nil {
+		return
+	}
+
+	evt := AgentKeyRotation{
+		AgentName: a.Name,
+		DID:       a.PublicDID.DID.String(),
+		Verkey:    a.PublicDID.KeyPair.PublicKey,
+	}
+
+	conns, err := r.agentStore.ListAgentConnections(a)
+	if err != nil {
+		log.Printf("unable to list connections of agent %s: %v\n", a.Name, err)
+	}
+
+	for _, conn := range conns {
+		evt.Connections = append(evt.Connections, &ConnectionInfo{
+			ConnectionID: conn.ConnectionID,
+			ExternalID:   conn.ExternalID,
+			TheirDID:     conn.TheirDID,
+			MyDID:        conn.MyDID,
+		})
+	}
+
+	message, err := json.Marshal(&notifier.Notification{
+		Topic:     AgentTopic,
+		Event:     KeyRotatedEvent,
+		EventData: evt,
+	})
+	if err != nil {
+		log.Println("unexpected error marshalling key rotation event", err)
+		return
+	}
+
+	err = r.notifications.Publish(context.Background(), message, "application/json")
+	if err != nil {
+		log.Println("unable to publish key rotation event", err)
+	}
+}
+
+func gracePeriod(seconds int64) time.Duration {
+	if seconds == 0 {
+		return DefaultKeyGracePeriod
+	}
+
+	return time.Duration(seconds) * time.Second
+}
@@ -0,0 +1,217 @@
+/*
+Copyright Scoir Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package apiserver
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/mr-tron/base58"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hyperledger/indy-vdr/wrappers/golang/identifiers"
+	"github.com/hyperledger/indy-vdr/wrappers/golang/vdr"
+
+	api "github.com/scoir/canis/pkg/apiserver/api/protogen"
+	"github.com/scoir/canis/pkg/datastore"
+	"github.com/scoir/canis/pkg/notifier"
+)
+
+func rotationDID(t *testing.T) *datastore.DID {
+	d, err := identifiers.CreateDID(&identifiers.MyDIDInfo{
+		PublicKey:  []byte("abcdefghijklmnopqrs"),
+		Cid:        true,
+		MethodName: "sov",
+	})
+	require.NoError(t, err)
+
+	return &datastore.DID{
+		DID: d,
+		KeyPair: &datastore.KeyPair{
+			ID:        "old",
+			PublicKey: d.Verkey,
+		},
+		RetiredKeys: []*datastore.RetiredKey{
+			{KeyPair: &datastore.KeyPair{ID: "expired"}, Expires: time.Now().Add(-time.Minute)},
+		},
+	}
+}
+
+type testRetiredKeys struct {
+	retired map[string]string
+	deleted []string
+}
+
+func (r *testRetiredKeys) Retire(verkey, keyID string, _ time.Time) error {
+	if r.retired == nil {
+		r.retired = map[string]string{}
+	}
+	r.retired[verkey] = keyID
+	return nil
+}
+
+func (r *testRetiredKeys) Delete(_, keyID string) error {
+	r.deleted = append(r.deleted, keyID)
+	return nil
+}
+
+func setupRotation() (*APIServer, *AdminTestSuite, *testRetiredKeys) {
+	target, suite := SetupTest()
+	keys := &testRetiredKeys{}
+	target.retiredKeys = keys
+	return target, suite, keys
+}
+
+func TestRotateAgentKey(t *testing.T) {
+	newVerkey := base58.Encode([]byte("abcdefghijklmnopqrstuvwxyz"))
+
+	t.Run("ledger DID", func(t *testing.T) {
+		target, suite, keys := setupRotation()
+		a := &datastore.Agent{Name: "agent", PublicDID: rotationDID(t)}
+		methodID := a.PublicDID.DID.DIDVal.MethodSpecificID
+
+		suite.Store.On("GetAgent", "agent").Return(a, nil)
+		suite.Store.On("UpdateAgent", a).Return(nil)
+		suite.Store.On("ListAgentConnections", a).Return([]*datastore.AgentConnection{
+			{ConnectionID: "conn-1", ExternalID: "ext-1", TheirDID: "did:peer:1"},
+		}, nil)
+
+		var published []byte
+		suite.Publisher.On("Publish", mock.Anything, mock.Anything, "application/json").
+			Run(func(args mock.Arguments) { published = args.Get(1).([]byte) }).Return(nil)
+
+		resp, err := target.RotateAgentKey(context.Background(), &api.RotateAgentKeyRequest{Id: "agent", GracePeriod: 60})
+		require.NoError(t, err)
+		require.Equal(t, newVerkey, resp.Verkey)
+		require.Equal(t, newVerkey, suite.IndyClient.NymVerkeys[methodID])
+
+		require.Equal(t, "id", a.PublicDID.KeyPair.ID)
+		require.Len(t, a.PublicDID.RetiredKeys, 1)
+		require.Equal(t, "old", a.PublicDID.RetiredKeys[0].KeyPair.ID)
+		require.WithinDuration(t, time.Now().Add(time.Minute), a.PublicDID.RetiredKeys[0].Expires, 5*time.Second)
+		require.Nil(t, a.PublicDID.PendingKey)
+		require.Equal(t, map[string]string{a.PublicDID.RetiredKeys[0].KeyPair.PublicKey: "old"}, keys.retired)
+		require.Equal(t, []string{"expired"}, keys.deleted)
+		suite.Store.AssertNumberOfCalls(t, "UpdateAgent", 2)
+
+		note := &notifier.Notification{EventData: &AgentKeyRotation{}}
+		require.NoError(t, json.Unmarshal(published, note))
+		require.Equal(t, AgentTopic, note.Topic)
+		require.Equal(t, KeyRotatedEvent, note.Event)
+		evt := note.EventData.(*AgentKeyRotation)
+		require.Equal(t, newVerkey, evt.Verkey)
+		require.Len(t, evt.Connections, 1)
+		require.Equal(t, "ext-1", evt.Connections[0].ExternalID)
+	})
+	t.Run("did:web DID", func(t *testing.T) {
+		target, suite, _ := setupRotation()
+		a := &datastore.Agent{Name: "agent", PublicDIDMethod: "web", PublicDID: rotationDID(t)}
+
+		suite.Store.On("GetAgent", "agent").Return(a, nil)
+		suite.Store.On("UpdateAgent", a).Return(nil)
+		suite.Store.On("ListAgentConnections", a).Return(nil, nil)
+		suite.Publisher.On("Publish", mock.Anything, mock.Anything, "application/json").Return(nil)
+
+		resp, err := target.RotateAgentKey(context.Background(), &api.RotateAgentKeyRequest{Id: "agent"})
+		require.NoError(t, err)
+		require.Equal(t, newVerkey, resp.Verkey)
+		require.Empty(t, suite.IndyClient.NymVerkeys)
+		require.WithinDuration(t, time.Now().Add(DefaultKeyGracePeriod), a.PublicDID.RetiredKeys[0].Expires, 5*time.Second)
+	})
+	t.Run("no public DID", func(t *testing.T) {
+		target, suite, _ := setupRotation()
+		suite.Store.On("GetAgent", "agent").Return(&datastore.Agent{Name: "agent"}, nil)
+
+		_, err := target.RotateAgentKey(context.Background(), &api.RotateAgentKeyRequest{Id: "agent"})
+		require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+	t.Run("nym write fails", func(t *testing.T) {
+		target, suite, keys := setupRotation()
+		a := &datastore.Agent{Name: "agent", PublicDID: rotationDID(t)}
+		suite.Store.On("GetAgent", "agent").Return(a, nil)
+		suite.Store.On("UpdateAgent", a).Return(nil)
+		suite.IndyClient.CreateNymErr = errors.New("boom")
+
+		_, err := target.RotateAgentKey(context.Background(), &api.RotateAgentKeyRequest{Id: "agent"})
+		require.Equal(t, codes.Internal, status.Code(err))
+		require.Contains(t, err.Error(), "retry to complete it")
+		require.Equal(t, "old", a.PublicDID.KeyPair.ID)
+		require.Equal(t, "id", a.PublicDID.PendingKey.ID)
+		require.Empty(t, keys.retired)
+		suite.Store.AssertNumberOfCalls(t, "UpdateAgent", 1)
+	})
+	t.Run("pending key can not be saved", func(t *testing.T) {
+		target, suite, _ := setupRotation()
+		a := &datastore.Agent{Name: "agent", PublicDID: rotationDID(t)}
+		suite.Store.On("GetAgent", "agent").Return(a, nil)
+		suite.Store.On("UpdateAgent", a).Return(errors.New("boom"))
+
+		_, err := target.RotateAgentKey(context.Background(), &api.RotateAgentKeyRequest{Id: "agent"})
+		require.Equal(t, codes.Internal, status.Code(err))
+		require.Empty(t, suite.IndyClient.NymVerkeys)
+	})
+	t.Run("rotation is not saved after the nym write", func(t *testing.T) {
+		target, suite, _ := setupRotation()
+		a := &datastore.Agent{Name: "agent", PublicDID: rotationDID(t)}
+		suite.Store.On("GetAgent", "agent").Return(a, nil)
+		suite.Store.On("UpdateAgent", a).Return(nil).Once()
+		suite.Store.On("UpdateAgent", a).Return(errors.New("boom")).Once()
+
+		_, err := target.RotateAgentKey(context.Background(), &api.RotateAgentKeyRequest{Id: "agent"})
+		require.Equal(t, codes.Internal, status.Code(err))
+		require.Contains(t, err.Error(), "is on the ledger but the rotation was not saved")
+		suite.Publisher.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything, mock.Anything)
+	})
+	t.Run("resumed rotation already on the ledger", func(t *testing.T) {
+		target, suite, keys := setupRotation()
+		a := &datastore.Agent{Name: "agent", PublicDID: rotationDID(t)}
+		a.PublicDID.PendingKey = &datastore.KeyPair{ID: "pending", PublicKey: newVerkey}
+
+		suite.Store.On("GetAgent", "agent").Return(a, nil)
+		suite.Store.On("UpdateAgent", a).Return(nil)
+		suite.Store.On("ListAgentConnections", a).Return(nil, nil)
+		suite.Publisher.On("Publish", mock.Anything, mock.Anything, "application/json").Return(nil)
+		suite.IndyClient.GetNymReply = &vdr.ReadReply{Data: `{"verkey":"` + newVerkey + `"}`}
+		suite.IndyClient.CreateNymErr = errors.New("nym should not be written again")
+
+		resp, err := target.RotateAgentKey(context.Background(), &api.RotateAgentKeyRequest{Id: "agent"})
+		require.NoError(t, err)
+		require.Equal(t, newVerkey, resp.Verkey)
+		require.Equal(t, "pending", a.PublicDID.KeyPair.ID)
+		require.Nil(t, a.PublicDID.PendingKey)
+		require.Contains(t, keys.retired, a.PublicDID.RetiredKeys[0].KeyPair.PublicKey)
+		suite.Store.AssertNumberOfCalls(t, "UpdateAgent", 1)
+	})
+	t.Run("negative grace period", func(t *testing.T) {
+		target, _, _ := setupRotation()
+
+		_, err := target.RotateAgentKey(context.Background(), &api.RotateAgentKeyRequest{Id: "agent", GracePeriod: -1})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestRotatePublicDIDKey(t *testing.T) {
+	target, suite, _ := setupRotation()
+	d := rotationDID(t)
+	methodID := d.DID.DIDVal.MethodSpecificID
+
+	suite.Store.On("GetLedgerPublicDID", "").Return(d, nil)
+	suite.Store.On("SetPublicDID", d).Return(nil)
+
+	resp, err := target.RotatePublicDIDKey(context.Background(), &api.RotatePublicDIDKeyRequest{})
+	require.NoError(t, err)
+	require.Equal(t, d.KeyPair.PublicKey, resp.Verkey)
+	require.Equal(t, resp.Verkey, suite.IndyClient.NymVerkeys[methodID])
+	require.Equal(t, "old", d.RetiredKeys[0].KeyPair.ID)
+	suite.Publisher.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -7,11 +7,17 @@ SPDX-License-Identifier: Apache-2.0
 package apiserver
 
 import (
+	"time"
+
 	"github.com/hyperledger/aries-framework-go/pkg/kms"
+	"github.com/hyperledger/aries-framework-go/pkg/storage"
 	"github.com/pkg/errors"
 
 	"github.com/hyperledger/indy-vdr/wrappers/golang/vdr"
 
+	"github.com/scoir/canis/pkg/amqp"
+	"github.com/scoir/canis/pkg/aries/retiredkeys"
+	"github.com/scoir/canis/pkg/audit"
 	cengine "github.com/scoir/canis/pkg/credential/engine"
 	"github.com/scoir/canis/pkg/credential/engine/indy"
 	"github.com/scoir/canis/pkg/datastore"
@@ -20,6 +26,8 @@ import (
 	lbapi "github.com/scoir/canis/pkg/didcomm/loadbalancer/api/protogen"
 	mdapi "github.com/scoir/canis/pkg/didcomm/mediator/api/protogen"
 	verifier "github.com/scoir/canis/pkg/didcomm/verifier/api/protogen"
+	indywrapper "github.com/scoir/canis/pkg/indy"
+	"github.com/scoir/canis/pkg/notifier"
 	pengine "github.com/scoir/canis/pkg/presentproof/engine"
 )
 
@@ -30,6 +38,8 @@ type APIServer struct {
 	schemaStore          datastore.Store
 	store                datastore.Store
 	client               vdrClient
+	ledgers              func(namespace string) (indy.VDRClient, error)
+	pools                *indywrapper.Pools
 	schemaRegistry       cengine.CredentialRegistry
 	presentationRegistry pengine.PresentationRegistry
 	doorman              doorman.DoormanClient
@@ -37,14 +47,21 @@ type APIServer struct {
 	verifier             verifier.VerifierClient
 	loadbalancer         lbapi.LoadbalancerClient
 	mediator             mdapi.MediatorClient
+	audit                *audit.Recorder
+	didWebDomain         string
+	notifications        amqp.Publisher
+	retiredKeys          retiredKeyStore
 }
 
 //go:generate mockery -name=provider --structname=Provider
 type provider interface {
 	KMS() kms.KeyManager
+	DIDWebDomain() string
 	MediatorKMS() kms.KeyManager
 	Store() datastore.Store
 	IndyVDR() (indy.VDRClient, error)
+	IndyLedger(namespace string) (indy.VDRClient, error)
+	LedgerPools() *indywrapper.Pools
 	GetIssuerClient() (api.IssuerClient, error)
 	GetDoormanClient() (doorman.DoormanClient, error)
 	GetMediatorClient() (mdapi.MediatorClient, error)
@@ -52,12 +69,24 @@ type provider interface {
 	GetLoadbalancerClient() (lbapi.LoadbalancerClient, error)
 	GetCredentialEngineRegistry() (cengine.CredentialRegistry, error)
 	GetPresentationEngineRegistry() (pengine.PresentationRegistry, error)
+	GetAMQPPublisher(queue string) amqp.Publisher
+	StorageProvider() storage.Provider
+}
+
+// retiredKeyStore records keys replaced by a rotation for the DIDComm services that unpack messages
+type retiredKeyStore interface {
+	Retire(verkey, keyID string, expires time.Time) error
+	Delete(verkey, keyID string) error
 }
 
 type vdrClient interface {
 	SetEndpoint(did, from string, ep string, signer vdr.Signer) error
 	CreateNym(did, verkey, role, from string, signer vdr.Signer) error
 	GetNym(did string) (*vdr.ReadReply, error)
+	GetSchema(schemaID string) (*vdr.ReadReply, error)
+	GetTxnAuthorAgreement() (*vdr.ReadReply, error)
+	GetAcceptanceMethodList() (*vdr.ReadReply, error)
+	Submit(request []byte) (*vdr.ReadReply, error)
 }
 
 func New(ctx provider) (*APIServer, error) {
@@ -70,11 +99,16 @@ func New(ctx provider) (*APIServer, error) {
 	r.schemaStore = ctx.Store()
 	r.agentStore = ctx.Store()
 	r.store = ctx.Store()
+	r.audit = audit.New(ctx)
+	r.didWebDomain = ctx.DIDWebDomain()
+	r.notifications = ctx.GetAMQPPublisher(notifier.QueueName)
 
 	r.client, err = ctx.IndyVDR()
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to get IndyVDR")
 	}
+	r.ledgers = ctx.IndyLedger
+	r.pools = ctx.LedgerPools()
 
 	r.issuer, err = ctx.GetIssuerClient()
 	if err != nil {
@@ -111,5 +145,24 @@ func New(ctx provider) (*APIServer, error) {
 		return nil, errors.Wrap(err, "unable to load presentation engine registry")
 	}
 
+	r.retiredKeys, err = retiredkeys.New(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to open retired keys")
+	}
+
 	return r, nil
 }
+
+// ledger returns the client for the ledger with namespace, the default ledger if namespace is empty
+func (r *APIServer) ledger(namespace string) (vdrClient, error) {
+	if namespace == "" {
+		return r.client, nil
+	}
+
+	cl, err := r.ledgers(namespace)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to get client for ledger %s", namespace)
+	}
+
+	return cl, nil
+}
@@ -3,11 +3,13 @@ package apiserver
 import (
 	"testing"
 
+	"github.com/hyperledger/aries-framework-go/pkg/storage/mem"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 
 	"github.com/scoir/canis/pkg/apiserver/mocks"
 	dsstore "github.com/scoir/canis/pkg/datastore/mocks"
+	"github.com/scoir/canis/pkg/notifier"
 )
 
 func TestNew(t *testing.T) {
@@ -17,6 +19,7 @@ func TestNew(t *testing.T) {
 
 		p.On("Store").Return(ds, nil).Times(3)
 		p.On("IndyVDR").Return(nil, nil)
+		p.On("LedgerPools").Return(nil)
 		p.On("GetDoormanClient").Return(nil, nil)
 		p.On("GetMediatorClient").Return(nil, nil)
 		p.On("GetIssuerClient").Return(nil, nil)
@@ -26,6 +29,9 @@ func TestNew(t *testing.T) {
 		p.On("GetPresentationEngineRegistry").Return(nil, nil)
 		p.On("KMS").Return(nil, nil)
 		p.On("MediatorKMS").Return(nil, nil)
+		p.On("DIDWebDomain").Return("")
+		p.On("GetAMQPPublisher", notifier.QueueName).Return(nil)
+		p.On("StorageProvider").Return(mem.NewProvider())
 
 		server, err := New(p)
 		require.Nil(t, err)
@@ -41,6 +47,8 @@ func TestNew(t *testing.T) {
 		p.On("Store").Return(ds, nil).Times(3)
 		p.On("KMS").Return(nil, nil)
 		p.On("MediatorKMS").Return(nil, nil)
+		p.On("DIDWebDomain").Return("")
+		p.On("GetAMQPPublisher", notifier.QueueName).Return(nil)
 		p.On("IndyVDR").Return(nil, errors.New("Boom"))
 
 		steward, err := New(p)
@@ -0,0 +1,130 @@
+/*
+Copyright Scoir Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package apiserver
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	api "github.com/scoir/canis/pkg/apiserver/api/protogen"
+	"github.com/scoir/canis/pkg/datastore"
+	"github.com/scoir/canis/pkg/indy"
+)
+
+func (r *APIServer) GetTransactionAuthorAgreement(_ context.Context, req *api.GetTransactionAuthorAgreementRequest) (*api.GetTransactionAuthorAgreementResponse, error) {
+	client, err := r.ledger(req.Ledger)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	taa, err := indy.GetTAA(client)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	out := &api.GetTransactionAuthorAgreementResponse{}
+	if taa == nil {
+		return out, nil
+	}
+
+	out.Agreement = &api.TransactionAuthorAgreement{
+		Version:          taa.Version,
+		Text:             taa.Text,
+		Digest:           taa.Digest,
+		RatificationTime: taa.RatificationTS,
+	}
+
+	aml, err := indy.GetAML(client)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	for name, desc := range aml.Mechanisms {
+		out.Mechanisms = append(out.Mechanisms, &api.AcceptanceMechanism{
+			Name:        name,
+			Description: desc,
+		})
+	}
+	sort.Slice(out.Mechanisms, func(i, j int) bool {
+		return out.Mechanisms[i].Name < out.Mechanisms[j].Name
+	})
+
+	acc, err := r.store.GetTAAAcceptance(req.Ledger)
+	if err == nil && acc.Digest == taa.Digest {
+		out.Acceptance = acceptanceToAPI(acc)
+	}
+
+	return out, nil
+}
+
+func (r *APIServer) AcceptTransactionAuthorAgreement(ctx context.Context, req *api.AcceptTransactionAuthorAgreementRequest) (*api.AcceptTransactionAuthorAgreementResponse, error) {
+	if req.Version == "" || req.Mechanism == "" {
+		return nil, status.Error(codes.InvalidArgument, "version and mechanism are required to accept the transaction author agreement")
+	}
+
+	client, err := r.ledger(req.Ledger)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	taa, err := indy.GetTAA(client)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	if taa == nil {
+		return nil, status.Error(codes.FailedPrecondition, "ledger does not have a transaction author agreement")
+	}
+
+	if taa.Version != req.Version {
+		return nil, status.Errorf(codes.FailedPrecondition, "version %s is not the current transaction author agreement, current version is %s", req.Version, taa.Version)
+	}
+
+	aml, err := indy.GetAML(client)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	if _, ok := aml.Mechanisms[req.Mechanism]; !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "%s is not an acceptance mechanism allowed by the ledger", req.Mechanism)
+	}
+
+	acc := &datastore.TAAAcceptance{
+		Version:    taa.Version,
+		Text:       taa.Text,
+		Digest:     taa.Digest,
+		Mechanism:  req.Mechanism,
+		Time:       indy.AcceptanceTime(time.Now()),
+		AcceptedBy: auditCaller(ctx),
+		Ledger:     req.Ledger,
+	}
+
+	err = r.store.SetTAAAcceptance(acc)
+	if err != nil {
+		return nil, status.Error(codes.Internal, errors.Wrap(err, "unable to save transaction author agreement acceptance").Error())
+	}
+
+	return &api.AcceptTransactionAuthorAgreementResponse{
+		Acceptance: acceptanceToAPI(acc),
+	}, nil
+}
+
+func acceptanceToAPI(acc *datastore.TAAAcceptance) *api.TAAAcceptance {
+	return &api.TAAAcceptance{
+		Version:    acc.Version,
+		Digest:     acc.Digest,
+		Mechanism:  acc.Mechanism,
+		Time:       acc.Time,
+		AcceptedBy: acc.AcceptedBy,
+		Ledger:     acc.Ledger,
+	}
+}
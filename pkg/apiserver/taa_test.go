@@ -0,0 +1,140 @@
+/*
+Copyright Scoir Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package apiserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hyperledger/indy-vdr/wrappers/golang/vdr"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	api "github.com/scoir/canis/pkg/apiserver/api/protogen"
+	"github.com/scoir/canis/pkg/datastore"
+	"github.com/scoir/canis/pkg/indy"
+)
+
+func setupTAA(suite *AdminTestSuite) {
+	suite.IndyClient.GetTAAReply = &vdr.ReadReply{Data: map[string]interface{}{
+		"text":            "agreement text",
+		"version":         "1.0",
+		"ratification_ts": 1604188800,
+	}}
+	suite.IndyClient.GetAMLReply = &vdr.ReadReply{Data: `{"version":"1","aml":{"service_agreement":"Agreement was included in the service terms","wallet_agreement":"Agreement was accepted in the wallet"}}`}
+}
+
+func TestGetTransactionAuthorAgreement(t *testing.T) {
+	t.Run("no agreement", func(t *testing.T) {
+		target, suite := SetupTest()
+		suite.IndyClient.GetTAAReply = &vdr.ReadReply{}
+
+		resp, err := target.GetTransactionAuthorAgreement(context.Background(), &api.GetTransactionAuthorAgreementRequest{})
+		require.NoError(t, err)
+		require.Nil(t, resp.Agreement)
+	})
+	t.Run("ledger error", func(t *testing.T) {
+		target, suite := SetupTest()
+		suite.IndyClient.GetTAAErr = errors.New("boom")
+
+		resp, err := target.GetTransactionAuthorAgreement(context.Background(), &api.GetTransactionAuthorAgreementRequest{})
+		require.Nil(t, resp)
+		require.Equal(t, codes.Unavailable, status.Code(err))
+	})
+	t.Run("not accepted", func(t *testing.T) {
+		target, suite := SetupTest()
+		setupTAA(suite)
+		suite.Store.On("GetTAAAcceptance", "").Return(nil, errors.New("not found"))
+
+		resp, err := target.GetTransactionAuthorAgreement(context.Background(), &api.GetTransactionAuthorAgreementRequest{})
+		require.NoError(t, err)
+		require.Equal(t, "1.0", resp.Agreement.Version)
+		require.Equal(t, indy.TAADigest("1.0", "agreement text"), resp.Agreement.Digest)
+		require.Len(t, resp.Mechanisms, 2)
+		require.Equal(t, "service_agreement", resp.Mechanisms[0].Name)
+		require.Nil(t, resp.Acceptance)
+	})
+	t.Run("accepted", func(t *testing.T) {
+		target, suite := SetupTest()
+		setupTAA(suite)
+		suite.Store.On("GetTAAAcceptance", "").Return(&datastore.TAAAcceptance{
+			Version:   "1.0",
+			Digest:    indy.TAADigest("1.0", "agreement text"),
+			Mechanism: "wallet_agreement",
+			Time:      1604188800,
+		}, nil)
+
+		resp, err := target.GetTransactionAuthorAgreement(context.Background(), &api.GetTransactionAuthorAgreementRequest{})
+		require.NoError(t, err)
+		require.Equal(t, "wallet_agreement", resp.Acceptance.Mechanism)
+	})
+}
+
+func TestAcceptTransactionAuthorAgreement(t *testing.T) {
+	t.Run("missing mechanism", func(t *testing.T) {
+		target, _ := SetupTest()
+
+		resp, err := target.AcceptTransactionAuthorAgreement(context.Background(), &api.AcceptTransactionAuthorAgreementRequest{Version: "1.0"})
+		require.Nil(t, resp)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("stale version", func(t *testing.T) {
+		target, suite := SetupTest()
+		setupTAA(suite)
+
+		resp, err := target.AcceptTransactionAuthorAgreement(context.Background(), &api.AcceptTransactionAuthorAgreementRequest{
+			Version:   "0.9",
+			Mechanism: "service_agreement",
+		})
+		require.Nil(t, resp)
+		require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+	t.Run("unknown mechanism", func(t *testing.T) {
+		target, suite := SetupTest()
+		setupTAA(suite)
+
+		resp, err := target.AcceptTransactionAuthorAgreement(context.Background(), &api.AcceptTransactionAuthorAgreementRequest{
+			Version:   "1.0",
+			Mechanism: "click_agreement",
+		})
+		require.Nil(t, resp)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("store error", func(t *testing.T) {
+		target, suite := SetupTest()
+		setupTAA(suite)
+		suite.Store.On("SetTAAAcceptance", mock.AnythingOfType("*datastore.TAAAcceptance")).Return(errors.New("boom"))
+
+		resp, err := target.AcceptTransactionAuthorAgreement(context.Background(), &api.AcceptTransactionAuthorAgreementRequest{
+			Version:   "1.0",
+			Mechanism: "service_agreement",
+		})
+		require.Nil(t, resp)
+		require.Equal(t, codes.Internal, status.Code(err))
+	})
+	t.Run("success", func(t *testing.T) {
+		target, suite := SetupTest()
+		setupTAA(suite)
+
+		match := func(a *datastore.TAAAcceptance) bool {
+			return a.Version == "1.0" && a.Mechanism == "service_agreement" &&
+				a.Digest == indy.TAADigest("1.0", "agreement text") && a.Time%86400 == 0
+		}
+		suite.Store.On("SetTAAAcceptance", mock.MatchedBy(match)).Return(nil)
+
+		resp, err := target.AcceptTransactionAuthorAgreement(context.Background(), &api.AcceptTransactionAuthorAgreementRequest{
+			Version:   "1.0",
+			Mechanism: "service_agreement",
+		})
+		require.NoError(t, err)
+		require.Equal(t, "service_agreement", resp.Acceptance.Mechanism)
+		suite.Store.AssertExpectations(t)
+	})
+}
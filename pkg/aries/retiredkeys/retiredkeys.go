@@ -0,0 +1,140 @@
+/*
+Copyright Scoir Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package retiredkeys
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/hyperledger/aries-framework-go/pkg/didcomm/common/transport"
+	"github.com/hyperledger/aries-framework-go/pkg/storage"
+	"github.com/mr-tron/base58"
+	"github.com/pkg/errors"
+)
+
+const (
+	// StoreName is the Aries store retired keys are recorded in, by verkey
+	StoreName = "retiredkeys"
+
+	// kmsStoreName is the Aries store the local KMS keeps private keys in, by key ID
+	kmsStoreName = "kmsdb"
+)
+
+// ErrExpired is returned for messages packed for a retired key whose grace period has passed
+var ErrExpired = errors.New("retired key has expired")
+
+type provider interface {
+	StorageProvider() storage.Provider
+}
+
+type retiredKey struct {
+	KeyID   string    `json:"key_id"`
+	Expires time.Time `json:"expires"`
+}
+
+// Keys tracks keys replaced by a key rotation, which can unpack messages until their grace period expires
+// and are then deleted from the KMS
+type Keys struct {
+	store storage.Store
+	kms   storage.Store
+}
+
+func New(ctx provider) (*Keys, error) {
+	s, err := ctx.StorageProvider().OpenStore(StoreName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to open store '%s'", StoreName)
+	}
+
+	k, err := ctx.StorageProvider().OpenStore(kmsStoreName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to open store '%s'", kmsStoreName)
+	}
+
+	return &Keys{store: s, kms: k}, nil
+}
+
+// Retire records that the key keyID with verkey can unpack messages until expires
+func (r *Keys) Retire(verkey, keyID string, expires time.Time) error {
+	d, err := json.Marshal(&retiredKey{KeyID: keyID, Expires: expires})
+	if err != nil {
+		return errors.Wrap(err, "unable to marshal retired key")
+	}
+
+	err = r.store.Put(verkey, d)
+	if err != nil {
+		return errors.Wrapf(err, "unable to retire key %s", verkey)
+	}
+
+	return nil
+}
+
+// Check returns ErrExpired if verkey is a retired key whose grace period has passed, deleting it if it is
+func (r *Keys) Check(verkey string) error {
+	d, err := r.store.Get(verkey)
+	if errors.Is(err, storage.ErrDataNotFound) {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrapf(err, "unable to look up retired key %s", verkey)
+	}
+
+	k := &retiredKey{}
+	err = json.Unmarshal(d, k)
+	if err != nil {
+		return errors.Wrapf(err, "invalid retired key %s", verkey)
+	}
+
+	if time.Now().Before(k.Expires) {
+		return nil
+	}
+
+	err = r.Delete(verkey, k.KeyID)
+	if err != nil {
+		return err
+	}
+
+	return ErrExpired
+}
+
+// Delete removes the retired key keyID with verkey from the KMS
+func (r *Keys) Delete(verkey, keyID string) error {
+	err := r.kms.Delete(keyID)
+	if err != nil && !errors.Is(err, storage.ErrDataNotFound) {
+		return errors.Wrapf(err, "unable to delete key %s from kms", keyID)
+	}
+
+	err = r.store.Delete(verkey)
+	if err != nil && !errors.Is(err, storage.ErrDataNotFound) {
+		return errors.Wrapf(err, "unable to delete retired key %s", verkey)
+	}
+
+	return nil
+}
+
+type packager struct {
+	transport.Packager
+	keys *Keys
+}
+
+// NewPackager wraps p so messages packed for retired keys are refused once the key's grace period has passed
+func NewPackager(p transport.Packager, keys *Keys) transport.Packager {
+	return &packager{Packager: p, keys: keys}
+}
+
+func (r *packager) UnpackMessage(encMessage []byte) (*transport.Envelope, error) {
+	env, err := r.Packager.UnpackMessage(encMessage)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.keys.Check(base58.Encode(env.ToKey))
+	if err != nil {
+		return nil, errors.Wrap(err, "message packed for a retired key")
+	}
+
+	return env, nil
+}
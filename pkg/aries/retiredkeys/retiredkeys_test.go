@@ -0,0 +1,81 @@
+package retiredkeys
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hyperledger/aries-framework-go/pkg/didcomm/common/transport"
+	"github.com/hyperledger/aries-framework-go/pkg/storage"
+	"github.com/hyperledger/aries-framework-go/pkg/storage/mem"
+	"github.com/mr-tron/base58"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+type testProvider struct {
+	sp storage.Provider
+}
+
+func (r *testProvider) StorageProvider() storage.Provider {
+	return r.sp
+}
+
+type testPackager struct {
+	transport.Packager
+	toKey []byte
+}
+
+func (r *testPackager) UnpackMessage(_ []byte) (*transport.Envelope, error) {
+	return &transport.Envelope{Message: []byte("msg"), ToKey: r.toKey}, nil
+}
+
+func setup(t *testing.T) (*Keys, storage.Store) {
+	sp := mem.NewProvider()
+	keys, err := New(&testProvider{sp: sp})
+	require.NoError(t, err)
+
+	kmsStore, err := sp.OpenStore(kmsStoreName)
+	require.NoError(t, err)
+	require.NoError(t, kmsStore.Put("key-id", []byte("private key")))
+
+	return keys, kmsStore
+}
+
+func TestKeys_Check(t *testing.T) {
+	t.Run("unknown key", func(t *testing.T) {
+		keys, _ := setup(t)
+		require.NoError(t, keys.Check("verkey"))
+	})
+	t.Run("key in its grace period", func(t *testing.T) {
+		keys, kmsStore := setup(t)
+		require.NoError(t, keys.Retire("verkey", "key-id", time.Now().Add(time.Minute)))
+
+		require.NoError(t, keys.Check("verkey"))
+		_, err := kmsStore.Get("key-id")
+		require.NoError(t, err)
+	})
+	t.Run("expired key is deleted", func(t *testing.T) {
+		keys, kmsStore := setup(t)
+		require.NoError(t, keys.Retire("verkey", "key-id", time.Now().Add(-time.Minute)))
+
+		require.True(t, errors.Is(keys.Check("verkey"), ErrExpired))
+		_, err := kmsStore.Get("key-id")
+		require.True(t, errors.Is(err, storage.ErrDataNotFound))
+		require.NoError(t, keys.Check("verkey"))
+	})
+}
+
+func TestPackager(t *testing.T) {
+	pub := []byte("abcdefghijklmnopqrstuvwxyz")
+	keys, _ := setup(t)
+	target := NewPackager(&testPackager{toKey: pub}, keys)
+
+	env, err := target.UnpackMessage([]byte("packed"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("msg"), env.Message)
+
+	require.NoError(t, keys.Retire(base58.Encode(pub), "key-id", time.Now().Add(-time.Minute)))
+	_, err = target.UnpackMessage([]byte("packed"))
+	require.Error(t, err)
+	require.True(t, errors.Is(err, ErrExpired))
+}
@@ -15,6 +15,8 @@ import (
 	"github.com/hyperledger/aries-framework-go/pkg/doc/did"
 	diddoc "github.com/hyperledger/aries-framework-go/pkg/doc/did"
 	vdriapi "github.com/hyperledger/aries-framework-go/pkg/framework/aries/api/vdri"
+
+	indywrapper "github.com/scoir/canis/pkg/indy"
 )
 
 func (r *VDRI) Build(pubKey *vdriapi.PubKey, opts ...vdriapi.DocOpts) (*diddoc.Doc, error) {
@@ -31,6 +33,9 @@ func (r *VDRI) Build(pubKey *vdriapi.PubKey, opts ...vdriapi.DocOpts) (*diddoc.D
 	pubKeyValue := base58.Decode(string(pubKey.Value))
 	methodID := base58.Encode(pubKeyValue[0:16])
 	didKey := fmt.Sprintf("did:%s:%s", r.methodName, methodID)
+	if r.methodName == indywrapper.IndyMethod {
+		didKey = fmt.Sprintf("did:%s:%s:%s", r.methodName, r.defaultNS, methodID)
+	}
 
 	publicKey := did.NewPublicKeyFromBytes(pubKey.ID, keyType, "#id", pubKey.Value)
 
@@ -8,6 +8,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	vdriapi "github.com/hyperledger/aries-framework-go/pkg/framework/aries/api/vdri"
+
+	indywrapper "github.com/scoir/canis/pkg/indy"
 )
 
 func TestVDRI_Build(t *testing.T) {
@@ -51,6 +53,24 @@ func TestVDRI_Build(t *testing.T) {
 		require.Nil(t, doc.Service)
 	})
 
+	t.Run("did:indy uses default namespace", func(t *testing.T) {
+		r := &VDRI{
+			methodName: indywrapper.IndyMethod,
+			defaultNS:  "sovrin:staging",
+		}
+
+		k := ed25519.NewKeyFromSeed([]byte("b2352b32947e188eb72871093ac6217e"))
+		pubKey := &vdriapi.PubKey{
+			ID:    "test",
+			Value: []byte(base58.Encode(k)),
+			Type:  "Ed25519VerificationKey2018",
+		}
+
+		doc, err := r.Build(pubKey)
+		require.NoError(t, err)
+		require.Equal(t, "did:indy:sovrin:staging:D8HmB7s9KCGuPGbi5Ymiqr", doc.ID)
+	})
+
 	t.Run("valid key with service endpoint", func(t *testing.T) {
 		r := &VDRI{
 			methodName: "sov",
@@ -18,6 +18,8 @@ import (
 
 	diddoc "github.com/hyperledger/aries-framework-go/pkg/doc/did"
 	vdriapi "github.com/hyperledger/aries-framework-go/pkg/framework/aries/api/vdri"
+
+	indywrapper "github.com/scoir/canis/pkg/indy"
 )
 
 const (
@@ -40,7 +42,12 @@ func (r *VDRI) Read(did string, opts ...vdriapi.ResolveOpts) (*diddoc.Doc, error
 		opt(resOpts)
 	}
 
-	rply, err := r.client.GetNym(parsedDID.MethodSpecificID)
+	client, nym, err := r.resolve(parsedDID.MethodSpecificID)
+	if err != nil {
+		return nil, err
+	}
+
+	rply, err := client.GetNym(nym)
 	if err != nil {
 		return nil, err
 	}
@@ -65,7 +72,7 @@ func (r *VDRI) Read(did string, opts ...vdriapi.ResolveOpts) (*diddoc.Doc, error
 	verMethod := diddoc.NewReferencedVerificationMethod(pubKey, diddoc.Authentication, true)
 
 	var svc []diddoc.Service
-	serviceEndpoint, err := r.getEndpoint(parsedDID.MethodSpecificID)
+	serviceEndpoint, err := r.getEndpoint(client, nym)
 	if err == nil {
 		s := diddoc.Service{
 			ID:              "#agent",
@@ -91,8 +98,8 @@ func (r *VDRI) Read(did string, opts ...vdriapi.ResolveOpts) (*diddoc.Doc, error
 	return doc, nil
 }
 
-func (r *VDRI) getEndpoint(did string) (string, error) {
-	rply, err := r.client.GetEndpoint(did)
+func (r *VDRI) getEndpoint(client indywrapper.IndyVDRClient, did string) (string, error) {
+	rply, err := client.GetEndpoint(did)
 	if err != nil || rply.Data == nil {
 		return "", errors.New("not found")
 	}
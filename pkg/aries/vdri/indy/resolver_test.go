@@ -8,13 +8,14 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/hyperledger/indy-vdr/wrappers/golang/vdr"
+	indywrapper "github.com/scoir/canis/pkg/indy"
 	"github.com/scoir/canis/pkg/mock/vdri/indy"
 )
 
 func TestVDRI_Read(t *testing.T) {
 	type fields struct {
 		methodName string
-		client     indyClient
+		client     indywrapper.IndyVDRClient
 	}
 	type args struct {
 		did  string
@@ -177,4 +178,28 @@ func TestVDRI_Read(t *testing.T) {
 
 	})
 
+	t.Run("did:indy routed by namespace", func(t *testing.T) {
+		sovrin := &indy.MockIndyClient{
+			GetNymErr: errors.New("wrong ledger"),
+		}
+		staging := &indy.MockIndyClient{
+			GetNymValue:    &vdr.ReadReply{Data: `{"dest": "abc123", "verkey": "3mJr7AoUCHxNqd"}`},
+			GetEndpointErr: errors.New("not found"),
+		}
+
+		r, err := New(indywrapper.IndyMethod, WithNamespace("sovrin", sovrin), WithNamespace("sovrin:staging", staging))
+		require.NoError(t, err)
+
+		did := "did:indy:sovrin:staging:abc123"
+		doc, err := r.Read(did)
+		require.NoError(t, err)
+		require.Equal(t, did, doc.ID)
+
+		_, err = r.Read("did:indy:sovrin:abc123")
+		require.Error(t, err)
+
+		_, err = r.Read("did:indy:builder:abc123")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "no indy ledger configured for namespace builder")
+	})
 }
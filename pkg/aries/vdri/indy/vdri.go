@@ -12,26 +12,24 @@ import (
 	"io"
 	"log"
 	"os"
+	"strings"
 
 	"github.com/hyperledger/aries-framework-go/pkg/doc/did"
 	vdriapi "github.com/hyperledger/aries-framework-go/pkg/framework/aries/api/vdri"
 
 	"github.com/hyperledger/indy-vdr/wrappers/golang/vdr"
-)
 
-type indyClient interface {
-	GetNym(did string) (*vdr.ReadReply, error)
-	GetEndpoint(did string) (*vdr.ReadReply, error)
-	GetPoolStatus() (*vdr.PoolStatus, error)
-	RefreshPool() error
-	Close() error
-}
+	indywrapper "github.com/scoir/canis/pkg/indy"
+)
 
 //Implements the VDRI interface for Hyperledger Indy networks
 type VDRI struct {
 	methodName string
 	refresh    bool
-	client     indyClient
+	client     indywrapper.IndyVDRClient
+	namespaces map[string]indywrapper.IndyVDRClient
+	defaultNS  string
+	cache      *indywrapper.LedgerCache
 }
 
 func New(methodName string, opts ...Option) (*VDRI, error) {
@@ -50,9 +48,59 @@ func New(methodName string, opts ...Option) (*VDRI, error) {
 		return nil, fmt.Errorf("refreshing indy pool failed: %w", err)
 	}
 
+	for ns, client := range vdri.namespaces {
+		if client == vdri.client {
+			continue
+		}
+
+		err = client.RefreshPool()
+		if err != nil {
+			return nil, fmt.Errorf("refreshing indy pool for namespace %s failed: %w", ns, err)
+		}
+	}
+
+	if vdri.cache != nil {
+		vdri.useCache()
+	}
+
 	return vdri, nil
 }
 
+// useCache wraps every client so NYM and endpoint reads are answered from the ledger cache
+func (r *VDRI) useCache() {
+	def := r.client
+	r.client = indywrapper.NewCachingClient(def, r.cache, r.defaultNS)
+
+	for ns, client := range r.namespaces {
+		if client == def {
+			r.namespaces[ns] = r.client
+			continue
+		}
+
+		r.namespaces[ns] = indywrapper.NewCachingClient(client, r.cache, ns)
+	}
+}
+
+// resolve returns the client for the ledger a method specific ID is on along with the unqualified ledger
+// identifier.  did:indy IDs are prefixed by the namespace, which may itself contain sub-namespaces
+func (r *VDRI) resolve(methodID string) (indywrapper.IndyVDRClient, string, error) {
+	if r.methodName != indywrapper.IndyMethod {
+		return r.client, methodID, nil
+	}
+
+	i := strings.LastIndex(methodID, ":")
+	if i < 0 {
+		return nil, "", fmt.Errorf("did:indy DID %s is missing a namespace", methodID)
+	}
+
+	client, ok := r.namespaces[methodID[:i]]
+	if !ok {
+		return nil, "", fmt.Errorf("no indy ledger configured for namespace %s", methodID[:i])
+	}
+
+	return client, methodID[i+1:], nil
+}
+
 func (r *VDRI) Store(doc *did.Doc, by *[]vdriapi.ModifiedBy) error {
 	return nil
 }
@@ -62,6 +110,12 @@ func (r *VDRI) Accept(method string) bool {
 }
 
 func (r *VDRI) Close() error {
+	for _, client := range r.namespaces {
+		if client != r.client {
+			_ = client.Close()
+		}
+	}
+
 	return r.client.Close()
 }
 
@@ -75,12 +129,50 @@ func WithRefresh(refresh bool) Option {
 	}
 }
 
-func WithIndyClient(client indyClient) Option {
+// WithLedgerCache caches NYM and endpoint reads of every ledger in cache
+func WithLedgerCache(cache *indywrapper.LedgerCache) Option {
+	return func(opts *VDRI) {
+		opts.cache = cache
+	}
+}
+
+func WithIndyClient(client indywrapper.IndyVDRClient) Option {
 	return func(opts *VDRI) {
 		opts.client = client
 	}
 }
 
+// WithNamespace adds the client for the ledger with a did:indy namespace.  The first namespace added is the
+// default, used for DIDs built by this VDRI
+func WithNamespace(namespace string, client indywrapper.IndyVDRClient) Option {
+	return func(opts *VDRI) {
+		if opts.namespaces == nil {
+			opts.namespaces = map[string]indywrapper.IndyVDRClient{}
+		}
+
+		if opts.client == nil {
+			opts.client = client
+			opts.defaultNS = namespace
+		}
+
+		opts.namespaces[namespace] = client
+	}
+}
+
+// WithNamespaceGenesisReader adds a client connected with genesisData for the ledger with a did:indy namespace
+func WithNamespaceGenesisReader(namespace string, genesisData io.ReadCloser) Option {
+	return func(opts *VDRI) {
+		client, err := vdr.New(genesisData)
+		if err != nil {
+			err = fmt.Errorf("error connecting to indy ledger %s: (%w)", namespace, err)
+			log.Println(err)
+			return
+		}
+
+		WithNamespace(namespace, client)(opts)
+	}
+}
+
 func WithIndyVDRGenesisFile(genesisFile string) Option {
 	return func(opts *VDRI) {
 		gfr, err := os.Open(genesisFile)
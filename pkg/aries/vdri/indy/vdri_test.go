@@ -7,6 +7,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	indywrapper "github.com/scoir/canis/pkg/indy"
 	"github.com/scoir/canis/pkg/mock/vdri/indy"
 )
 
@@ -117,7 +118,7 @@ func TestVDRI_Accept(t *testing.T) {
 
 func TestVDRI_Close(t *testing.T) {
 	type fields struct {
-		client indyClient
+		client indywrapper.IndyVDRClient
 	}
 	tests := []struct {
 		name    string
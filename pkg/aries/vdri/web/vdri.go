@@ -0,0 +1,150 @@
+/*
+Copyright Scoir Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package web
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+
+	diddoc "github.com/hyperledger/aries-framework-go/pkg/doc/did"
+	vdriapi "github.com/hyperledger/aries-framework-go/pkg/framework/aries/api/vdri"
+)
+
+// Method is the did:web DID method
+const Method = "web"
+
+const (
+	wellKnownPath = "/.well-known/did.json"
+	docName       = "did.json"
+)
+
+// VDRI implements the VDRI interface for did:web DIDs, resolved from DID documents served over HTTPS
+type VDRI struct {
+	client *http.Client
+}
+
+func New(opts ...Option) *VDRI {
+	vdri := &VDRI{client: http.DefaultClient}
+
+	for _, opt := range opts {
+		opt(vdri)
+	}
+
+	return vdri
+}
+
+func (r *VDRI) Read(did string, _ ...vdriapi.ResolveOpts) (*diddoc.Doc, error) {
+	parsedDID, err := diddoc.Parse(did)
+	if err != nil {
+		return nil, fmt.Errorf("parsing did failed in web resolver: (%w)", err)
+	}
+
+	if parsedDID.Method != Method {
+		return nil, fmt.Errorf("invalid web method ID: %s", parsedDID.MethodSpecificID)
+	}
+
+	docURL, err := URL(parsedDID.MethodSpecificID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.client.Get(docURL)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve DID document from %s: (%w)", docURL, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, vdriapi.ErrNotFound
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %d retrieving DID document from %s", resp.StatusCode, docURL)
+	}
+
+	d, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read DID document from %s: (%w)", docURL, err)
+	}
+
+	doc, err := diddoc.ParseDocument(d)
+	if err != nil {
+		return nil, fmt.Errorf("invalid DID document from %s: (%w)", docURL, err)
+	}
+
+	if doc.ID != did {
+		return nil, fmt.Errorf("DID document from %s is for %s", docURL, doc.ID)
+	}
+
+	return doc, nil
+}
+
+func (r *VDRI) Build(_ *vdriapi.PubKey, _ ...vdriapi.DocOpts) (*diddoc.Doc, error) {
+	return nil, errors.New("did:web DIDs are published by their web server and can not be built")
+}
+
+func (r *VDRI) Store(_ *diddoc.Doc, _ *[]vdriapi.ModifiedBy) error {
+	return nil
+}
+
+func (r *VDRI) Accept(method string) bool {
+	return method == Method
+}
+
+func (r *VDRI) Close() error {
+	return nil
+}
+
+// DID returns the did:web DID for a document served from domain under path
+func DID(domain string, path ...string) string {
+	return fmt.Sprintf("did:%s:%s", Method, MethodID(domain, path...))
+}
+
+// MethodID returns the method specific ID for a document served from domain under path.  A port in
+// domain is percent encoded as required by the method
+func MethodID(domain string, path ...string) string {
+	parts := append([]string{strings.ReplaceAll(domain, ":", "%3A")}, path...)
+	return strings.Join(parts, ":")
+}
+
+// URL returns the HTTPS URL of the DID document for a did:web method specific ID
+func URL(methodID string) (string, error) {
+	parts := strings.Split(methodID, ":")
+
+	domain, err := url.PathUnescape(parts[0])
+	if err != nil || domain == "" {
+		return "", fmt.Errorf("invalid did:web domain %s", parts[0])
+	}
+
+	if len(parts) == 1 {
+		return "https://" + domain + wellKnownPath, nil
+	}
+
+	for i, p := range parts[1:] {
+		seg, err := url.PathUnescape(p)
+		if err != nil || seg == "" {
+			return "", fmt.Errorf("invalid did:web path %s", methodID)
+		}
+		parts[i+1] = url.PathEscape(seg)
+	}
+
+	return fmt.Sprintf("https://%s/%s/%s", domain, strings.Join(parts[1:], "/"), docName), nil
+}
+
+// Option configures the web vdri
+type Option func(opts *VDRI)
+
+// WithHTTPClient sets the client used to retrieve DID documents
+func WithHTTPClient(client *http.Client) Option {
+	return func(opts *VDRI) {
+		opts.client = client
+	}
+}
@@ -0,0 +1,112 @@
+package web
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDID(t *testing.T) {
+	require.Equal(t, "did:web:example.com", DID("example.com"))
+	require.Equal(t, "did:web:localhost%3A8443:agents:faber", DID("localhost:8443", "agents", "faber"))
+}
+
+func TestURL(t *testing.T) {
+	tests := []struct {
+		methodID string
+		want     string
+		wantErr  bool
+	}{
+		{methodID: "example.com", want: "https://example.com/.well-known/did.json"},
+		{methodID: "example.com:agents:faber", want: "https://example.com/agents/faber/did.json"},
+		{methodID: "localhost%3A8443:agents:faber", want: "https://localhost:8443/agents/faber/did.json"},
+		{methodID: "", wantErr: true},
+		{methodID: "example.com::faber", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.methodID, func(t *testing.T) {
+			got, err := URL(tt.methodID)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestVDRI_Read(t *testing.T) {
+	doc := `{
+  "@context": ["https://w3id.org/did/v1"],
+  "id": "%s",
+  "publicKey": [{
+    "id": "#key-1",
+    "type": "Ed25519VerificationKey2018",
+    "controller": "%s",
+    "publicKeyBase58": "H3C2AVvLMv6gmMNam3uVAjZpfkcJCwDwnZn6z3wXmqPV"
+  }]
+}`
+
+	mux := http.NewServeMux()
+	server := httptest.NewTLSServer(mux)
+	defer server.Close()
+
+	domain := strings.TrimPrefix(server.URL, "https://")
+	did := DID(domain, "agents", "faber")
+	mux.HandleFunc("/agents/faber/did.json", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = fmt.Fprintf(w, doc, did, did)
+	})
+	mux.HandleFunc("/agents/other/did.json", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = fmt.Fprintf(w, doc, "did:web:elsewhere", "did:web:elsewhere")
+	})
+	mux.HandleFunc("/agents/broken/did.json", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("not json"))
+	})
+
+	target := New(WithHTTPClient(server.Client()))
+
+	t.Run("happy", func(t *testing.T) {
+		got, err := target.Read(did)
+		require.NoError(t, err)
+		require.Equal(t, did, got.ID)
+		require.Len(t, got.PublicKey, 1)
+	})
+	t.Run("not found", func(t *testing.T) {
+		got, err := target.Read(DID(domain, "agents", "missing"))
+		require.Error(t, err)
+		require.Nil(t, got)
+	})
+	t.Run("document for another DID", func(t *testing.T) {
+		got, err := target.Read(DID(domain, "agents", "other"))
+		require.Error(t, err)
+		require.Nil(t, got)
+	})
+	t.Run("invalid document", func(t *testing.T) {
+		got, err := target.Read(DID(domain, "agents", "broken"))
+		require.Error(t, err)
+		require.Nil(t, got)
+	})
+	t.Run("wrong method", func(t *testing.T) {
+		got, err := target.Read("did:sov:abc123")
+		require.Error(t, err)
+		require.Nil(t, got)
+	})
+}
+
+func TestVDRI_Accept(t *testing.T) {
+	target := New()
+	require.True(t, target.Accept("web"))
+	require.False(t, target.Accept("sov"))
+}
+
+func TestVDRI_Build(t *testing.T) {
+	target := New()
+	doc, err := target.Build(nil)
+	require.Error(t, err)
+	require.Nil(t, doc)
+}
@@ -0,0 +1,124 @@
+/*
+Copyright Scoir Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package audit
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/scoir/canis/pkg/datastore"
+)
+
+const (
+	OutcomeSuccess = "success"
+	OutcomeFailure = "failure"
+)
+
+// GenesisHash is the previous hash of the first event in the chain
+var GenesisHash = strings.Repeat("0", sha256.Size*2)
+
+type provider interface {
+	Store() datastore.Store
+}
+
+// Recorder appends events to the hash chained audit log
+type Recorder struct {
+	store datastore.Store
+	lock  sync.Mutex
+	last  *datastore.AuditEvent
+}
+
+func New(ctx provider) *Recorder {
+	return &Recorder{
+		store: ctx.Store(),
+	}
+}
+
+// Record links the event to the tail of the chain and persists it
+func (r *Recorder) Record(e *datastore.AuditEvent) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.last == nil {
+		last, err := r.store.GetLastAuditEvent()
+		if err != nil {
+			return errors.Wrap(err, "unable to load the end of the audit log")
+		}
+		r.last = last
+	}
+
+	e.Sequence = 1
+	e.PrevHash = GenesisHash
+	if r.last != nil {
+		e.Sequence = r.last.Sequence + 1
+		e.PrevHash = r.last.Hash
+	}
+
+	// datastores only keep millisecond precision, truncate so the hash survives a round trip
+	e.Timestamp = time.Now().UTC().Truncate(time.Millisecond)
+	e.Hash = Hash(e)
+
+	err := r.store.InsertAuditEvent(e)
+	if err != nil {
+		// another writer may have extended the chain, reload the tail next time
+		r.last = nil
+		return errors.Wrap(err, "unable to append audit event")
+	}
+
+	r.last = e
+	return nil
+}
+
+// Hash calculates the chain hash of the event over all fields except the hash itself.  The fields are
+// encoded as a JSON array so no value can be mistaken for the boundary between two fields
+func Hash(e *datastore.AuditEvent) string {
+	d, _ := json.Marshal([]interface{}{
+		e.Sequence,
+		e.Timestamp.UTC().Format(time.RFC3339Nano),
+		e.Caller,
+		e.RPC,
+		e.AgentName,
+		e.ExternalID,
+		e.Outcome,
+		e.Error,
+		e.PrevHash,
+	})
+
+	h := sha256.Sum256(d)
+	return hex.EncodeToString(h[:])
+}
+
+// Verify checks that each event hashes correctly and links to the event before it.  The first
+// event is trusted to link to whatever precedes it so any contiguous range of the log can be verified
+func Verify(events []*datastore.AuditEvent) error {
+	for i, e := range events {
+		if Hash(e) != e.Hash {
+			return errors.Errorf("audit event %d has been modified", e.Sequence)
+		}
+
+		if i == 0 {
+			continue
+		}
+
+		prev := events[i-1]
+		if e.Sequence != prev.Sequence+1 {
+			return errors.Errorf("audit event %d is missing from the log", prev.Sequence+1)
+		}
+
+		if e.PrevHash != prev.Hash {
+			return errors.Errorf("audit event %d does not link to event %d", e.Sequence, prev.Sequence)
+		}
+	}
+
+	return nil
+}
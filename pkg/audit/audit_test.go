@@ -0,0 +1,145 @@
+/*
+Copyright Scoir Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package audit
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scoir/canis/pkg/datastore"
+	"github.com/scoir/canis/pkg/datastore/mocks"
+)
+
+type testProvider struct {
+	store datastore.Store
+}
+
+func (r *testProvider) Store() datastore.Store {
+	return r.store
+}
+
+func TestRecord(t *testing.T) {
+	t.Run("first event links to genesis", func(t *testing.T) {
+		store := &mocks.Store{}
+		store.On("GetLastAuditEvent").Return(nil, nil)
+		store.On("InsertAuditEvent", mock.AnythingOfType("*datastore.AuditEvent")).Return(nil)
+
+		target := New(&testProvider{store: store})
+
+		e := &datastore.AuditEvent{RPC: "CreateAgent", AgentName: "agent", Outcome: OutcomeSuccess}
+		err := target.Record(e)
+		require.NoError(t, err)
+		require.Equal(t, int64(1), e.Sequence)
+		require.Equal(t, GenesisHash, e.PrevHash)
+		require.Equal(t, Hash(e), e.Hash)
+		require.False(t, e.Timestamp.IsZero())
+	})
+
+	t.Run("events chain from the last stored event", func(t *testing.T) {
+		last := &datastore.AuditEvent{Sequence: 41, Hash: "abc"}
+		store := &mocks.Store{}
+		store.On("GetLastAuditEvent").Return(last, nil).Once()
+		store.On("InsertAuditEvent", mock.AnythingOfType("*datastore.AuditEvent")).Return(nil)
+
+		target := New(&testProvider{store: store})
+
+		e1 := &datastore.AuditEvent{RPC: "IssueCredential"}
+		require.NoError(t, target.Record(e1))
+		e2 := &datastore.AuditEvent{RPC: "DeleteConnection"}
+		require.NoError(t, target.Record(e2))
+
+		require.Equal(t, int64(42), e1.Sequence)
+		require.Equal(t, "abc", e1.PrevHash)
+		require.Equal(t, int64(43), e2.Sequence)
+		require.Equal(t, e1.Hash, e2.PrevHash)
+		require.NoError(t, Verify([]*datastore.AuditEvent{e1, e2}))
+	})
+
+	t.Run("insert failure reloads the tail", func(t *testing.T) {
+		store := &mocks.Store{}
+		store.On("GetLastAuditEvent").Return(&datastore.AuditEvent{Sequence: 1, Hash: "abc"}, nil)
+		store.On("InsertAuditEvent", mock.AnythingOfType("*datastore.AuditEvent")).Return(errors.New("duplicate key")).Once()
+		store.On("InsertAuditEvent", mock.AnythingOfType("*datastore.AuditEvent")).Return(nil)
+
+		target := New(&testProvider{store: store})
+
+		err := target.Record(&datastore.AuditEvent{RPC: "CreateAgent"})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "duplicate key")
+
+		err = target.Record(&datastore.AuditEvent{RPC: "CreateAgent"})
+		require.NoError(t, err)
+		store.AssertNumberOfCalls(t, "GetLastAuditEvent", 2)
+	})
+
+	t.Run("load failure does not restart the chain", func(t *testing.T) {
+		store := &mocks.Store{}
+		store.On("GetLastAuditEvent").Return(nil, errors.New("connection refused"))
+
+		target := New(&testProvider{store: store})
+
+		err := target.Record(&datastore.AuditEvent{RPC: "CreateAgent"})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "connection refused")
+		store.AssertNotCalled(t, "InsertAuditEvent", mock.Anything)
+	})
+}
+
+func TestHash(t *testing.T) {
+	e1 := &datastore.AuditEvent{Sequence: 1, AgentName: "a\nb", ExternalID: "c"}
+	e2 := &datastore.AuditEvent{Sequence: 1, AgentName: "a", ExternalID: "b\nc"}
+	require.NotEqual(t, Hash(e1), Hash(e2))
+	require.Equal(t, Hash(e1), Hash(&datastore.AuditEvent{Sequence: 1, AgentName: "a\nb", ExternalID: "c"}))
+}
+
+func TestVerify(t *testing.T) {
+	chain := func() []*datastore.AuditEvent {
+		store := &mocks.Store{}
+		store.On("GetLastAuditEvent").Return(nil, nil)
+		store.On("InsertAuditEvent", mock.AnythingOfType("*datastore.AuditEvent")).Return(nil)
+		target := New(&testProvider{store: store})
+
+		var out []*datastore.AuditEvent
+		for _, rpc := range []string{"SeedPublicDID", "CreateAgent", "IssueCredential"} {
+			e := &datastore.AuditEvent{RPC: rpc, Outcome: OutcomeSuccess}
+			require.NoError(t, target.Record(e))
+			out = append(out, e)
+		}
+		return out
+	}
+
+	t.Run("valid chain", func(t *testing.T) {
+		require.NoError(t, Verify(chain()))
+	})
+
+	t.Run("modified event", func(t *testing.T) {
+		events := chain()
+		events[1].AgentName = "someone else"
+		err := Verify(events)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "audit event 2 has been modified")
+	})
+
+	t.Run("removed event", func(t *testing.T) {
+		events := chain()
+		err := Verify([]*datastore.AuditEvent{events[0], events[2]})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "audit event 2 is missing")
+	})
+
+	t.Run("rehashed event", func(t *testing.T) {
+		events := chain()
+		events[1].Outcome = OutcomeFailure
+		events[1].Hash = Hash(events[1])
+		err := Verify(events)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "audit event 3 does not link to event 2")
+	})
+}
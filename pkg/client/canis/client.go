@@ -8,6 +8,7 @@ import (
 
 	api "github.com/scoir/canis/pkg/apiserver/api/protogen"
 	"github.com/scoir/canis/pkg/client/informer"
+	"github.com/scoir/canis/pkg/framework"
 )
 
 type Client struct {
@@ -16,8 +17,13 @@ type Client struct {
 	agentInformer *informer.SharedResourceInformer
 }
 
-func New(endpoint string) *Client {
-	cc, err := grpc.Dial(endpoint, grpc.WithInsecure())
+func New(endpoint string, tlsConf *framework.TLSConfig) *Client {
+	creds, err := tlsConf.DialOption()
+	if err != nil {
+		log.Fatalln("invalid tls config", err)
+	}
+
+	cc, err := grpc.Dial(endpoint, creds)
 	if err != nil {
 		log.Fatalln("can't connect", err)
 	}
@@ -35,4 +35,12 @@ type Config interface {
 
 	WithIndyRegistry(opts ...Option) Config
 	IndyRegistry() string
+
+	TLS() (*framework.TLSConfig, error)
+	Tracing() (*framework.TracingConfig, error)
+	Ledgers() ([]*framework.LedgerConfig, error)
+	LedgerCache() (*framework.LedgerCacheConfig, error)
+	PoolRefresh() (*framework.PoolRefreshConfig, error)
+	Mailbox() (*framework.MailboxConfig, error)
+	Push() (*framework.PushConfig, error)
 }
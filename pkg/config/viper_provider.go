@@ -245,3 +245,119 @@ func (r *vpr) WithIndyRegistry(opts ...Option) Config {
 func (r *vpr) IndyRegistry() string {
 	return r.GetString("registry.indy.genesis")
 }
+
+// TLS returns the mutual TLS settings for internal GRPC connections, nil if none are configured
+func (r *vpr) TLS() (*framework.TLSConfig, error) {
+	if !r.IsSet("tls") {
+		return nil, nil
+	}
+
+	tc := &framework.TLSConfig{}
+	err := r.UnmarshalKey("tls", tc)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load key tls")
+	}
+
+	return tc, nil
+}
+
+// Tracing returns the OpenTelemetry exporter settings, nil if none are configured
+func (r *vpr) Tracing() (*framework.TracingConfig, error) {
+	if !r.IsSet("tracing") {
+		return nil, nil
+	}
+
+	tc := &framework.TracingConfig{}
+	err := r.UnmarshalKey("tracing", tc)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load key tracing")
+	}
+
+	return tc, nil
+}
+
+// Ledgers returns the named Indy networks, the first is the default.  Nil if none are configured
+func (r *vpr) Ledgers() ([]*framework.LedgerConfig, error) {
+	if !r.IsSet("ledgers") {
+		return nil, nil
+	}
+
+	var lc []*framework.LedgerConfig
+	err := r.UnmarshalKey("ledgers", &lc)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load key ledgers")
+	}
+
+	seen := map[string]bool{}
+	for _, l := range lc {
+		if l.Namespace == "" || l.GenesisFile == "" {
+			return nil, errors.New("each ledger requires a namespace and genesisFile")
+		}
+		if seen[l.Namespace] {
+			return nil, errors.Errorf("duplicate ledger namespace %s", l.Namespace)
+		}
+		seen[l.Namespace] = true
+	}
+
+	return lc, nil
+}
+
+// LedgerCache returns the ledger read cache configuration, nil to use the defaults
+func (r *vpr) LedgerCache() (*framework.LedgerCacheConfig, error) {
+	if !r.IsSet("ledgerCache") {
+		return nil, nil
+	}
+
+	lc := &framework.LedgerCacheConfig{}
+	err := r.UnmarshalKey("ledgerCache", lc)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load key ledgerCache")
+	}
+
+	return lc, nil
+}
+
+// PoolRefresh returns the ledger pool refresh schedule, nil to use the defaults
+func (r *vpr) PoolRefresh() (*framework.PoolRefreshConfig, error) {
+	if !r.IsSet("poolRefresh") {
+		return nil, nil
+	}
+
+	pc := &framework.PoolRefreshConfig{}
+	err := r.UnmarshalKey("poolRefresh", pc)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load key poolRefresh")
+	}
+
+	return pc, nil
+}
+
+// Mailbox returns the mediator mailbox retention limits, nil to use the defaults
+func (r *vpr) Mailbox() (*framework.MailboxConfig, error) {
+	if !r.IsSet("mediator.mailbox") {
+		return nil, nil
+	}
+
+	mc := &framework.MailboxConfig{}
+	err := r.UnmarshalKey("mediator.mailbox", mc)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load key mediator.mailbox")
+	}
+
+	return mc, nil
+}
+
+// Push returns the push notification webhook, nil when push notifications are disabled
+func (r *vpr) Push() (*framework.PushConfig, error) {
+	if !r.IsSet("push") {
+		return nil, nil
+	}
+
+	pc := &framework.PushConfig{}
+	err := r.UnmarshalKey("push", pc)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load key push")
+	}
+
+	return pc, nil
+}
@@ -2,6 +2,7 @@ package config
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -175,3 +176,114 @@ func TestVpr_Endpoint(t *testing.T) {
 		require.Equal(t, 0, ls.Port)
 	})
 }
+
+func TestVpr_Ledgers(t *testing.T) {
+	t.Run("happy path", func(t *testing.T) {
+		vp := &ViperConfigProvider{}
+		conf := vp.Load("./tests/test-ledgers.yaml")
+
+		ledgers, err := conf.Ledgers()
+		require.NoError(t, err)
+		require.Len(t, ledgers, 2)
+		require.Equal(t, "sovrin", ledgers[0].Namespace)
+		require.Equal(t, "sovrin:staging", ledgers[1].Namespace)
+		require.Equal(t, "staging-genesis\n", ledgers[1].GenesisFile)
+	})
+
+	t.Run("not configured", func(t *testing.T) {
+		vp := &ViperConfigProvider{}
+		conf := vp.Load("./tests/test-config.yaml")
+
+		ledgers, err := conf.Ledgers()
+		require.NoError(t, err)
+		require.Nil(t, ledgers)
+	})
+}
+
+func TestVpr_LedgerCache(t *testing.T) {
+	t.Run("happy path", func(t *testing.T) {
+		vp := &ViperConfigProvider{}
+		conf := vp.Load("./tests/test-ledger-cache.yaml")
+
+		lc, err := conf.LedgerCache()
+		require.NoError(t, err)
+		require.Equal(t, 500, lc.Size)
+		require.Equal(t, 30*time.Second, lc.NymTTL)
+		require.Equal(t, time.Minute, lc.AttribTTL)
+		require.Equal(t, 24*time.Hour, lc.CredDefTTL)
+	})
+
+	t.Run("not configured", func(t *testing.T) {
+		vp := &ViperConfigProvider{}
+		conf := vp.Load("./tests/test-config.yaml")
+
+		lc, err := conf.LedgerCache()
+		require.NoError(t, err)
+		require.Nil(t, lc)
+	})
+}
+
+func TestVpr_PoolRefresh(t *testing.T) {
+	t.Run("happy path", func(t *testing.T) {
+		vp := &ViperConfigProvider{}
+		conf := vp.Load("./tests/test-pool-refresh.yaml")
+
+		pc, err := conf.PoolRefresh()
+		require.NoError(t, err)
+		require.Equal(t, 5*time.Minute, pc.Interval)
+		require.Equal(t, 30*time.Second, pc.Jitter)
+	})
+
+	t.Run("not configured", func(t *testing.T) {
+		vp := &ViperConfigProvider{}
+		conf := vp.Load("./tests/test-config.yaml")
+
+		pc, err := conf.PoolRefresh()
+		require.NoError(t, err)
+		require.Nil(t, pc)
+	})
+}
+
+func TestVpr_Mailbox(t *testing.T) {
+	t.Run("happy path", func(t *testing.T) {
+		vp := &ViperConfigProvider{}
+		conf := vp.Load("./tests/test-mailbox.yaml")
+
+		mc, err := conf.Mailbox()
+		require.NoError(t, err)
+		require.Equal(t, 500, mc.MaxMessages)
+		require.Equal(t, 72*time.Hour, mc.MaxAge)
+		require.Equal(t, 65536, mc.MaxMessageSize)
+	})
+
+	t.Run("not configured", func(t *testing.T) {
+		vp := &ViperConfigProvider{}
+		conf := vp.Load("./tests/test-config.yaml")
+
+		mc, err := conf.Mailbox()
+		require.NoError(t, err)
+		require.Nil(t, mc)
+	})
+}
+
+func TestVpr_Push(t *testing.T) {
+	t.Run("happy path", func(t *testing.T) {
+		vp := &ViperConfigProvider{}
+		conf := vp.Load("./tests/test-push.yaml")
+
+		pc, err := conf.Push()
+		require.NoError(t, err)
+		require.Equal(t, "https://push.example.com/wake", pc.WebhookURL)
+		require.Equal(t, "hook secret", pc.Secret)
+		require.Equal(t, 5*time.Second, pc.Timeout)
+	})
+
+	t.Run("not configured", func(t *testing.T) {
+		vp := &ViperConfigProvider{}
+		conf := vp.Load("./tests/test-config.yaml")
+
+		pc, err := conf.Push()
+		require.NoError(t, err)
+		require.Nil(t, pc)
+	})
+}
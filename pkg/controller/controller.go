@@ -9,10 +9,12 @@ package controller
 import (
 	"crypto/sha256"
 	"crypto/subtle"
+	"expvar"
 	"fmt"
 	"log"
 	"net"
 	"net/http"
+	"strings"
 	"sync"
 
 	"github.com/goji/httpauth"
@@ -20,13 +22,16 @@ import (
 	"github.com/pkg/errors"
 	"github.com/rs/cors"
 	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 
 	"github.com/scoir/canis/pkg/framework"
+	"github.com/scoir/canis/pkg/tracing"
 )
 
 const (
 	SecurityRealm    = "Restricted"
 	APIKeyHeaderName = "X-API-Key"
+	DIDDocumentName  = "did.json"
 )
 
 type AgentController interface {
@@ -35,6 +40,11 @@ type AgentController interface {
 	APISpec() (http.HandlerFunc, error)
 }
 
+// InterceptingController is implemented by controllers that need to see every unary call made to them
+type InterceptingController interface {
+	UnaryServerInterceptors() []grpc.UnaryServerInterceptor
+}
+
 type Runner struct {
 	ac                       AgentController
 	grpcBridgeHost, grpcHost string
@@ -42,12 +52,15 @@ type Runner struct {
 	swaggerUsername          string
 	swaggerPassword          string
 	apiToken                 string
+	tls                      *framework.TLSConfig
+	health                   *healthServer
 	debug                    bool
 }
 
 type provider interface {
 	GetGRPCEndpoint() (*framework.Endpoint, error)
 	GetBridgeEndpoint() (*framework.Endpoint, error)
+	GetTLSConfig() (*framework.TLSConfig, error)
 }
 
 func New(ctx provider, ac AgentController) (*Runner, error) {
@@ -67,6 +80,11 @@ func New(ctx provider, ac AgentController) (*Runner, error) {
 		apiToken = bridge.Token
 	}
 
+	tlsConf, err := ctx.GetTLSConfig()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to load tls config")
+	}
+
 	r := &Runner{
 		ac:              ac,
 		grpcHost:        grpce.Host,
@@ -76,10 +94,16 @@ func New(ctx provider, ac AgentController) (*Runner, error) {
 		swaggerUsername: swaggerUserName,
 		swaggerPassword: swaggerPassword,
 		apiToken:        apiToken,
+		tls:             tlsConf,
+		health:          &healthServer{},
 
 		debug: false,
 	}
 
+	if rc, ok := ctx.(ReadinessChecker); ok {
+		r.health.ready = rc.Ready
+	}
+
 	return r, nil
 }
 
@@ -116,14 +140,33 @@ func (r *Runner) launchGRPC() error {
 		log.Fatalf("failed to listen: %v", err)
 	}
 
-	grpcServer := grpc.NewServer()
+	opts, err := r.tls.ServerOptions()
+	if err != nil {
+		return errors.Wrap(err, "unable to configure grpc tls")
+	}
+
+	interceptors := []grpc.UnaryServerInterceptor{tracing.UnaryServerInterceptor()}
+	if ic, ok := r.ac.(InterceptingController); ok {
+		interceptors = append(interceptors, ic.UnaryServerInterceptors()...)
+	}
+	opts = append(opts, grpc.ChainUnaryInterceptor(interceptors...))
+
+	grpcServer := grpc.NewServer(opts...)
 	r.ac.RegisterGRPCHandler(grpcServer)
+	healthpb.RegisterHealthServer(grpcServer, r.health)
 	log.Println("GRPC Listening on ", addr)
 	return grpcServer.Serve(lis)
 }
 
 func (r *Runner) launchWebBridge() error {
 	rmux := runtime.NewServeMux(
+		runtime.WithIncomingHeaderMatcher(func(h string) (string, bool) {
+			if strings.HasPrefix(h, "X-Canis") {
+				return h, true
+			}
+
+			return runtime.DefaultHeaderMatcher(h)
+		}),
 		runtime.WithMarshalerOption("image/png", &runtime.HTTPBodyMarshaler{
 			Marshaler: &runtime.JSONPb{OrigName: true},
 		}))
@@ -133,9 +176,12 @@ func (r *Runner) launchWebBridge() error {
 	}
 
 	endpoint := fmt.Sprintf("%s:%d", r.grpcHost, r.grpcPort)
-	opts := []grpc.DialOption{grpc.WithInsecure()}
+	creds, err := r.tls.DialOption()
+	if err != nil {
+		return errors.Wrap(err, "unable to configure grpc gateway tls")
+	}
 
-	r.ac.RegisterGRPCGateway(rmux, endpoint, opts...)
+	r.ac.RegisterGRPCGateway(rmux, endpoint, creds)
 
 	fs := http.FileServer(http.Dir("./static/swaggerui"))
 
@@ -153,6 +199,9 @@ func (r *Runner) launchWebBridge() error {
 	basicAuth := httpauth.BasicAuth(basicOpts)
 
 	mux.Handle("/swaggerui/", basicAuth(http.StripPrefix("/swaggerui/", fs)))
+	mux.Handle("/debug/vars", basicAuth(expvar.Handler()))
+	mux.HandleFunc(LivenessPath, live)
+	mux.HandleFunc(ReadinessPath, r.health.readyHandler)
 
 	var h http.Handler = rmux
 	if r.apiToken != "" {
@@ -166,6 +215,12 @@ func (r *Runner) launchWebBridge() error {
 
 func (r *Runner) basicTokenAuth(h http.Handler) http.HandlerFunc {
 	return func(w http.ResponseWriter, req *http.Request) {
+		// did:web documents must be resolvable by anyone
+		if req.Method == http.MethodGet && isDIDDocumentPath(req.URL.Path) {
+			h.ServeHTTP(w, req)
+			return
+		}
+
 		authHeader := req.Header.Get(APIKeyHeaderName)
 		if authHeader == "" {
 			http.Error(w, "Not authorized", 401)
@@ -184,6 +239,12 @@ func (r *Runner) basicTokenAuth(h http.Handler) http.HandlerFunc {
 	}
 }
 
+// isDIDDocumentPath matches only the agent DID document route, /agents/{id}/did.json
+func isDIDDocumentPath(p string) bool {
+	parts := strings.Split(p, "/")
+	return len(parts) == 4 && parts[0] == "" && parts[1] == "agents" && parts[2] != "" && parts[3] == DIDDocumentName
+}
+
 func CorsHandler() func(h http.Handler) http.Handler {
 	c := cors.New(cors.Options{
 		AllowedOrigins: []string{"*"},
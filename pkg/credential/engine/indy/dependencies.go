@@ -9,6 +9,7 @@ import (
 //go:generate mockery -inpkg -name=Provider
 type Provider interface {
 	IndyVDR() (VDRClient, error)
+	IndyLedger(namespace string) (VDRClient, error)
 	KMS() kms.KeyManager
 	StorageProvider() storage.Provider
 	Oracle() Oracle
@@ -28,4 +29,7 @@ type VDRClient interface {
 	GetSchema(schemaID string) (*vdr.ReadReply, error)
 	CreateNym(did, verkey, role, from string, signer vdr.Signer) error
 	GetNym(did string) (*vdr.ReadReply, error)
+	GetTxnAuthorAgreement() (*vdr.ReadReply, error)
+	GetAcceptanceMethodList() (*vdr.ReadReply, error)
+	Submit(request []byte) (*vdr.ReadReply, error)
 }
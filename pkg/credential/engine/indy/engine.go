@@ -2,8 +2,10 @@ package indy
 
 import "C"
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"log"
 
 	"github.com/google/tink/go/keyset"
 	"github.com/google/tink/go/signature/subtle"
@@ -15,6 +17,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/scoir/canis/pkg/datastore"
+	indywrapper "github.com/scoir/canis/pkg/indy"
 	"github.com/scoir/canis/pkg/schema"
 	cursa "github.com/scoir/canis/pkg/ursa"
 )
@@ -27,10 +30,11 @@ type creddefWalletRecord struct {
 }
 
 type CredentialEngine struct {
-	client VDRClient
-	kms    kms.KeyManager
-	store  storage.Store
-	oracle Oracle
+	client  VDRClient
+	ledgers func(namespace string) (VDRClient, error)
+	kms     kms.KeyManager
+	store   storage.Store
+	oracle  Oracle
 }
 
 func New(prov Provider) (*CredentialEngine, error) {
@@ -47,6 +51,7 @@ func New(prov Provider) (*CredentialEngine, error) {
 		return nil, errors.Wrap(err, "unable to get indy vdr for indy credential engine")
 	}
 
+	eng.ledgers = prov.IndyLedger
 	eng.kms = prov.KMS()
 	eng.oracle = prov.Oracle()
 
@@ -57,9 +62,46 @@ func (r *CredentialEngine) Accept(format string) bool {
 	return format == Indy
 }
 
-func (r *CredentialEngine) CreateSchema(issuer *datastore.DID, s *datastore.Schema) (string, error) {
-	extId := fmt.Sprintf("%s:2:%s:%s", issuer.DID.MethodID(), s.Name, s.Version)
-	rply, err := r.client.GetSchema(extId)
+// ledger returns the client for the ledger with namespace, the default ledger if namespace is empty, bound to ctx
+func (r *CredentialEngine) ledger(ctx context.Context, namespace string) (VDRClient, error) {
+	cl := r.client
+	if namespace != "" {
+		var err error
+		cl, err = r.ledgers(namespace)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to get client for ledger %s", namespace)
+		}
+	}
+
+	if cc, ok := cl.(indywrapper.ContextClient); ok {
+		return cc.WithContext(ctx), nil
+	}
+
+	return cl, nil
+}
+
+// schemaLedger returns the namespace of the ledger holding the schema along with its unqualified ledger ID
+func schemaLedger(s *datastore.Schema) (string, string, error) {
+	namespace, schemaID, err := indywrapper.LedgerID(s.ExternalSchemaID)
+	if err != nil {
+		return "", "", errors.Wrap(err, "invalid schema ID")
+	}
+
+	if namespace == "" {
+		namespace = s.Ledger
+	}
+
+	return namespace, schemaID, nil
+}
+
+func (r *CredentialEngine) CreateSchema(ctx context.Context, issuer *datastore.DID, s *datastore.Schema) (string, error) {
+	client, err := r.ledger(ctx, s.Ledger)
+	if err != nil {
+		return "", err
+	}
+
+	extId := indywrapper.SchemaID(s.Ledger, issuer.DID.MethodID(), s.Name, s.Version)
+	rply, err := client.GetSchema(indywrapper.SchemaID("", issuer.DID.MethodID(), s.Name, s.Version))
 	if err == nil && rply.SeqNo > 0 {
 		return extId, nil
 	}
@@ -80,16 +122,30 @@ func (r *CredentialEngine) CreateSchema(issuer *datastore.DID, s *datastore.Sche
 	}
 	mysig := prim.Primary.Primitive.(*subtle.ED25519Signer)
 
-	ischema, err := r.client.CreateSchema(issuer.DID.MethodID(), s.Name, s.Version, attr, mysig)
+	ischema, err := client.CreateSchema(issuer.DID.MethodID(), s.Name, s.Version, attr, mysig)
 	if err != nil {
 		return "", errors.Wrap(err, "indy vdr client unable to create schema")
 	}
 
+	if s.Ledger != "" {
+		return extId, nil
+	}
+
 	return ischema, nil
 }
 
-func (r *CredentialEngine) RegisterSchema(registrant *datastore.DID, s *datastore.Schema) error {
-	reply, err := r.client.GetSchema(s.ExternalSchemaID)
+func (r *CredentialEngine) RegisterSchema(ctx context.Context, registrant *datastore.DID, s *datastore.Schema) error {
+	namespace, schemaID, err := schemaLedger(s)
+	if err != nil {
+		return err
+	}
+
+	client, err := r.ledger(ctx, namespace)
+	if err != nil {
+		return err
+	}
+
+	reply, err := client.GetSchema(schemaID)
 	if err != nil {
 		return errors.Wrap(err, "unable to find schema on ledger to create cred def")
 	}
@@ -124,8 +180,13 @@ func (r *CredentialEngine) RegisterSchema(registrant *datastore.DID, s *datastor
 	pubKeyDef, _ := indycd.PublicKey()
 	pubKey, _ := pubKeyDef["p_key"].(map[string]interface{})
 
-	credDefId, err := r.client.CreateClaimDef(registrant.DID.MethodID(), reply.SeqNo, pubKey, nil, mysig)
-	if err != nil {
+	credDefId, err := client.CreateClaimDef(registrant.DID.MethodID(), reply.SeqNo, pubKey, nil, mysig)
+	if namespace != "" {
+		credDefId = indywrapper.CredDefID(namespace, registrant.DID.MethodID(), reply.SeqNo, DefaultTag)
+	}
+	if errors.Is(err, indywrapper.ErrEndorsementPending) {
+		log.Printf("cred def %s waiting on endorsement: %v\n", credDefId, err)
+	} else if err != nil {
 		return errors.Wrap(err, "unable to create claim def")
 	}
 
@@ -150,13 +211,26 @@ const (
 	DefaultTag      = "default"
 )
 
-func (r *CredentialEngine) CreateCredentialOffer(issuer *datastore.DID, _ string, s *datastore.Schema, _ []byte) (string, *decorator.AttachmentData, error) {
-	indySchema, err := r.client.GetSchema(s.ExternalSchemaID)
+func (r *CredentialEngine) CreateCredentialOffer(ctx context.Context, issuer *datastore.DID, _ string, s *datastore.Schema, _ []byte) (string, *decorator.AttachmentData, error) {
+	namespace, schemaID, err := schemaLedger(s)
+	if err != nil {
+		return "", nil, err
+	}
+
+	client, err := r.ledger(ctx, namespace)
+	if err != nil {
+		return "", nil, err
+	}
+
+	indySchema, err := client.GetSchema(schemaID)
 	if err != nil {
 		return "", nil, errors.Wrap(err, "unable to find schema on ledger to create cred def")
 	}
 
 	credDefID := cursa.CredentialDefinitionID(issuer.DID.MethodID(), indySchema.SeqNo, CLSignatureType, DefaultTag)
+	if namespace != "" {
+		credDefID = indywrapper.CredDefID(namespace, issuer.DID.MethodID(), indySchema.SeqNo, DefaultTag)
+	}
 
 	rec, err := r.getCredDefRecord(credDefID)
 	if err != nil {
@@ -168,16 +242,31 @@ func (r *CredentialEngine) CreateCredentialOffer(issuer *datastore.DID, _ string
 		return "", nil, errors.Wrap(err, "unable to create ursa issuer")
 	}
 
-	offerID := uuid.New().URN()
-	err = r.store.Put(offerID, []byte(offer.Base64))
+	offerID, err := r.saveOffer(offer)
 	if err != nil {
-		return "", nil, errors.Wrap(err, "unexpected error saving offer")
+		return "", nil, err
 	}
 
 	return offerID, offer, nil
 
 }
 
+// saveOffer stores the contents of an offer attachment for IssueCredential under a new offer ID
+func (r *CredentialEngine) saveOffer(offer *decorator.AttachmentData) (string, error) {
+	d, err := offer.Fetch()
+	if err != nil {
+		return "", errors.Wrap(err, "unable to read credential offer attachment")
+	}
+
+	offerID := uuid.New().URN()
+	err = r.store.Put(offerID, d)
+	if err != nil {
+		return "", errors.Wrap(err, "unexpected error saving offer")
+	}
+
+	return offerID, nil
+}
+
 func (r *CredentialEngine) getCredDefRecord(credDefID string) (*creddefWalletRecord, error) {
 	d, err := r.store.Get(credDefID)
 	if err != nil {
@@ -189,7 +278,7 @@ func (r *CredentialEngine) getCredDefRecord(credDefID string) (*creddefWalletRec
 	return rec, nil
 }
 
-func (r *CredentialEngine) IssueCredential(issuerDID *datastore.DID, s *datastore.Schema, offerID string,
+func (r *CredentialEngine) IssueCredential(ctx context.Context, issuerDID *datastore.DID, s *datastore.Schema, offerID string,
 	requestAttachment decorator.AttachmentData, values map[string]interface{}) (*decorator.AttachmentData, error) {
 
 	request := datastore.CredentialRequest{}
@@ -215,7 +304,17 @@ func (r *CredentialEngine) IssueCredential(issuerDID *datastore.DID, s *datastor
 		return nil, errors.Wrap(err, "unexpected error decoding indy stored offer")
 	}
 
-	rply, err := r.client.GetCredDef(offer.CredDefID)
+	namespace, credDefID, err := indywrapper.LedgerID(offer.CredDefID)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid cred def ID in indy stored offer")
+	}
+
+	client, err := r.ledger(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	rply, err := client.GetCredDef(credDefID)
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to retrieve cred def ledger record")
 	}
@@ -247,7 +346,7 @@ func (r *CredentialEngine) IssueCredential(issuerDID *datastore.DID, s *datastor
 
 }
 
-func (r *CredentialEngine) GetSchemaForProposal(proposal []byte) (string, error) {
+func (r *CredentialEngine) GetSchemaForProposal(_ context.Context, proposal []byte) (string, error) {
 	cp := &CredentialProposal{}
 	err := json.Unmarshal(proposal, cp)
 	if err != nil {
@@ -1,15 +1,22 @@
 package indy
 
 import (
+	"context"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"strings"
 	"testing"
 
+	"github.com/btcsuite/btcutil/base58"
+	"github.com/golang/protobuf/proto"
+	"github.com/google/tink/go/insecurecleartextkeyset"
+	"github.com/google/tink/go/keyset"
+	ed25519pb "github.com/google/tink/go/proto/ed25519_go_proto"
 	"github.com/google/tink/go/signature/subtle"
 	"github.com/hyperledger/aries-framework-go/pkg/didcomm/protocol/decorator"
 	kmsMock "github.com/hyperledger/aries-framework-go/pkg/mock/kms"
+	"github.com/hyperledger/aries-framework-go/pkg/storage/mem"
 	"github.com/hyperledger/ursa-wrapper-go/pkg/libursa/ursa"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/mock"
@@ -20,8 +27,10 @@ import (
 
 	"github.com/scoir/canis/pkg/credential/engine/indy/mocks"
 	"github.com/scoir/canis/pkg/datastore"
+	"github.com/scoir/canis/pkg/indy/sim"
 	gmock "github.com/scoir/canis/pkg/mock"
 	"github.com/scoir/canis/pkg/schema"
+	cursa "github.com/scoir/canis/pkg/ursa"
 )
 
 func TestIssuerCredential(t *testing.T) {
@@ -135,7 +144,7 @@ func TestIssuerCredential(t *testing.T) {
 			"primary": pubKey["p_key"],
 		}}, nil)
 
-		attachment, err := engine.IssueCredential(issuerDID, s, offerID, requestAttachment, values)
+		attachment, err := engine.IssueCredential(context.Background(), issuerDID, s, offerID, requestAttachment, values)
 		require.NoError(t, err)
 		require.NotNil(t, attachment)
 	})
@@ -151,7 +160,7 @@ func TestIssuerCredential(t *testing.T) {
 		s := &datastore.Schema{}
 		requestAttachment := decorator.AttachmentData{}
 
-		attachment, err := engine.IssueCredential(issuerDID, s, offerID, requestAttachment, values)
+		attachment, err := engine.IssueCredential(context.Background(), issuerDID, s, offerID, requestAttachment, values)
 		require.Nil(t, attachment)
 		require.Error(t, err)
 	})
@@ -170,7 +179,7 @@ func TestIssuerCredential(t *testing.T) {
 		s := &datastore.Schema{}
 		requestAttachment := decorator.AttachmentData{JSON: &schema.IndyCredentialOffer{}}
 
-		attachment, err := engine.IssueCredential(issuerDID, s, offerID, requestAttachment, values)
+		attachment, err := engine.IssueCredential(context.Background(), issuerDID, s, offerID, requestAttachment, values)
 		require.Nil(t, attachment)
 		require.Error(t, err)
 	})
@@ -193,7 +202,7 @@ func TestIssuerCredential(t *testing.T) {
 		s := &datastore.Schema{}
 		requestAttachment := decorator.AttachmentData{JSON: &schema.IndyCredentialOffer{}}
 
-		attachment, err := engine.IssueCredential(issuerDID, s, offerID, requestAttachment, values)
+		attachment, err := engine.IssueCredential(context.Background(), issuerDID, s, offerID, requestAttachment, values)
 		require.Nil(t, attachment)
 		require.Error(t, err)
 	})
@@ -227,7 +236,7 @@ func TestRegisterSchema(t *testing.T) {
 
 		prov.vdr.On("GetSchema", "schema-external-id").Return(nil, errors.New("not found"))
 
-		err = engine.RegisterSchema(registrantDID, s)
+		err = engine.RegisterSchema(context.Background(), registrantDID, s)
 		require.Error(t, err)
 	})
 	t.Run("happy path", func(t *testing.T) {
@@ -271,7 +280,7 @@ func TestRegisterSchema(t *testing.T) {
 
 		prov.vdr.On("CreateClaimDef", "123456789", uint32(23), mock.AnythingOfType("map[string]interface {}"), map[string]interface{}(nil), mysig).Return("cred-def-id", nil)
 		prov.store.On("Put", "cred-def-id", mock.AnythingOfType("[]uint8")).Return(nil)
-		err = engine.RegisterSchema(registrantDID, s)
+		err = engine.RegisterSchema(context.Background(), registrantDID, s)
 		require.NoError(t, err)
 	})
 }
@@ -336,7 +345,7 @@ func TestCreateSchema(t *testing.T) {
 		prov.vdr.On("GetSchema", "123456789:2:schema-name:1.2").Return(nil, errors.New("not found"))
 		prov.vdr.On("CreateSchema", issuer.DID.MethodID(), "schema-name", "1.2", []string{"field1", "field2"}, mysig).Return("test-schema-id", nil)
 
-		sid, err := engine.CreateSchema(issuer, s)
+		sid, err := engine.CreateSchema(context.Background(), issuer, s)
 		require.NoError(t, err)
 		require.Equal(t, "test-schema-id", sid)
 
@@ -362,7 +371,7 @@ func TestCreateSchema(t *testing.T) {
 
 		prov.vdr.On("GetSchema", "123456789:2::").Return(&vdr.ReadReply{SeqNo: 1234}, nil)
 
-		sid, err := engine.CreateSchema(issuer, s)
+		sid, err := engine.CreateSchema(context.Background(), issuer, s)
 		require.NoError(t, err)
 		require.Equal(t, "123456789:2::", sid)
 
@@ -390,7 +399,7 @@ func TestCreateSchema(t *testing.T) {
 
 		prov.kms.GetKeyErr = errors.New("not found")
 
-		sid, err := engine.CreateSchema(issuer, s)
+		sid, err := engine.CreateSchema(context.Background(), issuer, s)
 		require.Error(t, err)
 		require.Empty(t, sid)
 
@@ -437,7 +446,7 @@ func TestCreateSchema(t *testing.T) {
 		prov.vdr.On("GetSchema", "123456789:2:schema-name:1.2").Return(nil, errors.New("not found"))
 		prov.vdr.On("CreateSchema", issuer.DID.MethodID(), "schema-name", "1.2", []string{"field1", "field2"}, mysig).Return("", errors.New("BOOM"))
 
-		sid, err := engine.CreateSchema(issuer, s)
+		sid, err := engine.CreateSchema(context.Background(), issuer, s)
 		require.Error(t, err)
 		require.Empty(t, sid)
 
@@ -452,7 +461,7 @@ func TestGetSchemaForProposal(t *testing.T) {
 		require.NoError(t, err)
 
 		proposal := []byte(`{"schema_id": "123"}`)
-		schemaID, err := engine.GetSchemaForProposal(proposal)
+		schemaID, err := engine.GetSchemaForProposal(context.Background(), proposal)
 		require.NoError(t, err)
 		require.Equal(t, "123", schemaID)
 	})
@@ -463,7 +472,7 @@ func TestGetSchemaForProposal(t *testing.T) {
 		require.NoError(t, err)
 
 		proposal := []byte(`{"schema_id": "`)
-		schemaID, err := engine.GetSchemaForProposal(proposal)
+		schemaID, err := engine.GetSchemaForProposal(context.Background(), proposal)
 		require.Error(t, err)
 		require.Equal(t, "", schemaID)
 	})
@@ -498,7 +507,7 @@ func TestCreateCredentialOffer(t *testing.T) {
 
 		prov.vdr.On("GetSchema", "abc").Return(nil, errors.New("no schema"))
 
-		offerID, offer, err := engine.CreateCredentialOffer(issuerDID, "", s, nil)
+		offerID, offer, err := engine.CreateCredentialOffer(context.Background(), issuerDID, "", s, nil)
 		require.Error(t, err)
 		require.Empty(t, offerID)
 		require.Nil(t, offer)
@@ -533,7 +542,7 @@ func TestCreateCredentialOffer(t *testing.T) {
 		prov.vdr.On("GetSchema", "abc").Return(indySchema, nil)
 		prov.store.On("Get", "123456789:3:CL:1:default").Return(nil, errors.New("wallet not found"))
 
-		offerID, offer, err := engine.CreateCredentialOffer(issuerDID, "", s, nil)
+		offerID, offer, err := engine.CreateCredentialOffer(context.Background(), issuerDID, "", s, nil)
 		require.Error(t, err)
 		require.Empty(t, offerID)
 		require.Nil(t, offer)
@@ -577,7 +586,7 @@ func TestCreateCredentialOffer(t *testing.T) {
 		prov.store.On("Get", "123456789:3:CL:1:default").Return(recData, nil)
 		prov.oracle.On("NewNonce").Return("", errors.New("no nonce for you"))
 
-		offerID, offer, err := engine.CreateCredentialOffer(issuerDID, "", s, nil)
+		offerID, offer, err := engine.CreateCredentialOffer(context.Background(), issuerDID, "", s, nil)
 		require.Error(t, err)
 		require.Empty(t, offerID)
 		require.Nil(t, offer)
@@ -622,7 +631,7 @@ func TestCreateCredentialOffer(t *testing.T) {
 		prov.oracle.On("NewNonce").Return("0987654321234567890", nil)
 		prov.store.On("Put", mock.AnythingOfType("string"), mock.AnythingOfType("[]uint8")).Return(errors.New("can't save"))
 
-		offerID, offer, err := engine.CreateCredentialOffer(issuerDID, "", s, nil)
+		offerID, offer, err := engine.CreateCredentialOffer(context.Background(), issuerDID, "", s, nil)
 		require.Error(t, err)
 		require.Empty(t, offerID)
 		require.Nil(t, offer)
@@ -667,7 +676,7 @@ func TestCreateCredentialOffer(t *testing.T) {
 		prov.oracle.On("NewNonce").Return("0987654321234567890", nil)
 		prov.store.On("Put", mock.AnythingOfType("string"), mock.AnythingOfType("[]uint8")).Return(nil)
 
-		offerID, offer, err := engine.CreateCredentialOffer(issuerDID, "", s, nil)
+		offerID, offer, err := engine.CreateCredentialOffer(context.Background(), issuerDID, "", s, nil)
 		require.NoError(t, err)
 		require.NotEmpty(t, offerID)
 
@@ -687,6 +696,19 @@ func TestCreateCredentialOffer(t *testing.T) {
 		require.True(t, ok)
 		require.Equal(t, "0987654321234567890", v)
 	})
+	t.Run("unreadable offer attachment", func(t *testing.T) {
+		prov := newProvider()
+		defer prov.Asserts(t)
+
+		engine, err := New(prov.provider)
+		require.NoError(t, err)
+
+		offerID, err := engine.saveOffer(&decorator.AttachmentData{Base64: "not base64!"})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "unable to read credential offer attachment")
+		require.Empty(t, offerID)
+		prov.store.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+	})
 }
 
 type provider struct {
@@ -732,3 +754,110 @@ func getKeyCorrectnessProof(t *testing.T) map[string]interface{} {
 	require.NoError(t, err)
 	return out
 }
+
+func TestIssuanceRoundTrip(t *testing.T) {
+	trustee := sim.NewIdentity("000000000000000000000000Trustee1")
+	ledger := sim.New(sim.WithTrustee(trustee))
+
+	kh, err := kmsMock.CreateMockED25519KeyHandle()
+	require.NoError(t, err)
+	issuerDID := ledgerDID(t, ledger, trustee, kh)
+
+	prov := &MockProvider{}
+	prov.On("IndyVDR").Return(ledger, nil)
+	prov.On("KMS").Return(&kmsMock.KeyManager{GetKeyValue: kh})
+	prov.On("StorageProvider").Return(mem.NewProvider())
+	prov.On("Oracle").Return(&cursa.CryptoOracle{})
+
+	engine, err := New(prov)
+	require.NoError(t, err)
+
+	s := &datastore.Schema{
+		Name:    "degree",
+		Version: "1.0",
+		Attributes: []*datastore.Attribute{
+			{Name: "name"},
+			{Name: "gpa"},
+		},
+	}
+	s.ExternalSchemaID, err = engine.CreateSchema(context.Background(), issuerDID, s)
+	require.NoError(t, err)
+	require.NoError(t, engine.RegisterSchema(context.Background(), issuerDID, s))
+
+	offerID, offerAttachment, err := engine.CreateCredentialOffer(context.Background(), issuerDID, "", s, nil)
+	require.NoError(t, err)
+
+	d, err := offerAttachment.Fetch()
+	require.NoError(t, err)
+	offer := &schema.IndyCredentialOffer{}
+	require.NoError(t, json.Unmarshal(d, offer))
+	require.Equal(t, s.ExternalSchemaID, offer.SchemaID)
+
+	rply, err := ledger.GetCredDef(offer.CredDefID)
+	require.NoError(t, err)
+	credDef := &vdr.ClaimDefData{ID: offer.CredDefID}
+	require.NoError(t, credDef.UnmarshalReadReply(rply))
+
+	holderProv := &MockProvider{}
+	holderProv.On("StorageProvider").Return(mem.NewProvider())
+	holder, err := cursa.NewProver(holderProv)
+	require.NoError(t, err)
+
+	ms, err := holder.CreateMasterSecret("holder")
+	require.NoError(t, err)
+	credRequest, credRequestMetadata, err := holder.CreateCredentialRequest("did:sov:holder", credDef, offer, ms)
+	require.NoError(t, err)
+
+	values := map[string]interface{}{"name": "Alice", "gpa": "3.9"}
+	credAttachment, err := engine.IssueCredential(context.Background(), issuerDID, s, offerID, decorator.AttachmentData{JSON: credRequest}, values)
+	require.NoError(t, err)
+
+	d, err = credAttachment.Fetch()
+	require.NoError(t, err)
+	cred := &schema.IndyCredential{}
+	require.NoError(t, json.Unmarshal(d, cred))
+	require.Equal(t, offer.CredDefID, cred.CredDefID)
+	require.Equal(t, "Alice", cred.Values["name"].Raw)
+
+	t.Run("holder accepts signature", func(t *testing.T) {
+		_, err := holder.ProcessCredentialSignature(cred, credRequest, ms, credRequestMetadata.MasterSecretBlindingData, credDef.PKey())
+		require.NoError(t, err)
+	})
+	t.Run("tampered value", func(t *testing.T) {
+		raw, enc := ursa.EncodeValue("4.0")
+		tampered := *cred
+		tampered.Values = schema.IndyCredentialValues{
+			"name": cred.Values["name"],
+			"gpa":  {Raw: raw, Encoded: enc},
+		}
+
+		_, err := holder.ProcessCredentialSignature(&tampered, credRequest, ms, credRequestMetadata.MasterSecretBlindingData, credDef.PKey())
+		require.Error(t, err)
+	})
+	t.Run("schema already on ledger", func(t *testing.T) {
+		schemaID, err := engine.CreateSchema(context.Background(), issuerDID, s)
+		require.NoError(t, err)
+		require.Equal(t, s.ExternalSchemaID, schemaID)
+	})
+}
+
+// ledgerDID writes a NYM for the ED25519 key in kh to ledger so the engine can sign writes with it
+func ledgerDID(t *testing.T, ledger *sim.Ledger, trustee *sim.Identity, kh *keyset.Handle) *datastore.DID {
+	ks := insecurecleartextkeyset.KeysetMaterial(kh)
+	priv := &ed25519pb.Ed25519PrivateKey{}
+	require.NoError(t, proto.Unmarshal(ks.Key[0].KeyData.Value, priv))
+
+	pub := priv.PublicKey.KeyValue
+	did := base58.Encode(pub[:16])
+	require.NoError(t, ledger.CreateNym(did, base58.Encode(pub), vdr.EndorserRole, trustee.DID, trustee))
+
+	return &datastore.DID{
+		DID: &identifiers.DID{
+			DIDVal: identifiers.DIDValue{
+				MethodSpecificID: did,
+				Method:           "sov",
+			},
+		},
+		KeyPair: &datastore.KeyPair{ID: "issuer-key"},
+	}
+}
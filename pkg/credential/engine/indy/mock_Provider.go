@@ -14,6 +14,29 @@ type MockProvider struct {
 	mock.Mock
 }
 
+// IndyLedger provides a mock function with given fields: namespace
+func (_m *MockProvider) IndyLedger(namespace string) (VDRClient, error) {
+	ret := _m.Called(namespace)
+
+	var r0 VDRClient
+	if rf, ok := ret.Get(0).(func(string) VDRClient); ok {
+		r0 = rf(namespace)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(VDRClient)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(namespace)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // IndyVDR provides a mock function with given fields:
 func (_m *MockProvider) IndyVDR() (VDRClient, error) {
 	ret := _m.Called()
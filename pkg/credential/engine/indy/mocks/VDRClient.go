@@ -68,6 +68,29 @@ func (_m *VDRClient) CreateSchema(issuerDID string, name string, version string,
 	return r0, r1
 }
 
+// GetAcceptanceMethodList provides a mock function with given fields:
+func (_m *VDRClient) GetAcceptanceMethodList() (*vdr.ReadReply, error) {
+	ret := _m.Called()
+
+	var r0 *vdr.ReadReply
+	if rf, ok := ret.Get(0).(func() *vdr.ReadReply); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*vdr.ReadReply)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetCredDef provides a mock function with given fields: credDefID
 func (_m *VDRClient) GetCredDef(credDefID string) (*vdr.ReadReply, error) {
 	ret := _m.Called(credDefID)
@@ -137,6 +160,29 @@ func (_m *VDRClient) GetSchema(schemaID string) (*vdr.ReadReply, error) {
 	return r0, r1
 }
 
+// GetTxnAuthorAgreement provides a mock function with given fields:
+func (_m *VDRClient) GetTxnAuthorAgreement() (*vdr.ReadReply, error) {
+	ret := _m.Called()
+
+	var r0 *vdr.ReadReply
+	if rf, ok := ret.Get(0).(func() *vdr.ReadReply); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*vdr.ReadReply)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SetEndpoint provides a mock function with given fields: did, from, ep, signer
 func (_m *VDRClient) SetEndpoint(did string, from string, ep string, signer vdr.Signer) error {
 	ret := _m.Called(did, from, ep, signer)
@@ -150,3 +196,26 @@ func (_m *VDRClient) SetEndpoint(did string, from string, ep string, signer vdr.
 
 	return r0
 }
+
+// Submit provides a mock function with given fields: request
+func (_m *VDRClient) Submit(request []byte) (*vdr.ReadReply, error) {
+	ret := _m.Called(request)
+
+	var r0 *vdr.ReadReply
+	if rf, ok := ret.Get(0).(func([]byte) *vdr.ReadReply); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*vdr.ReadReply)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]byte) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
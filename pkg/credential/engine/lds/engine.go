@@ -1,6 +1,7 @@
 package lds
 
 import (
+	"context"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
@@ -68,17 +69,17 @@ func (r *CredentialEngine) Accept(format string) bool {
 	return format == LinkedDataSignature
 }
 
-func (r *CredentialEngine) CreateSchema(_ *datastore.DID, _ *datastore.Schema) (string, error) {
+func (r *CredentialEngine) CreateSchema(_ context.Context, _ *datastore.DID, _ *datastore.Schema) (string, error) {
 	//NO-OP
 	return "", nil
 }
 
-func (r *CredentialEngine) RegisterSchema(_ *datastore.DID, _ *datastore.Schema) error {
+func (r *CredentialEngine) RegisterSchema(_ context.Context, _ *datastore.DID, _ *datastore.Schema) error {
 	// NO-OP
 	return nil
 }
 
-func (r *CredentialEngine) CreateCredentialOffer(_ *datastore.DID, subjectDID string, s *datastore.Schema,
+func (r *CredentialEngine) CreateCredentialOffer(_ context.Context, _ *datastore.DID, subjectDID string, s *datastore.Schema,
 	values []byte) (string, *decorator.AttachmentData, error) {
 
 	out := map[string]interface{}{}
@@ -112,7 +113,7 @@ func (r *CredentialEngine) CreateCredentialOffer(_ *datastore.DID, subjectDID st
 
 }
 
-func (r *CredentialEngine) IssueCredential(issuerDID *datastore.DID, s *datastore.Schema, offerID string,
+func (r *CredentialEngine) IssueCredential(_ context.Context, issuerDID *datastore.DID, s *datastore.Schema, offerID string,
 	request decorator.AttachmentData, values map[string]interface{}) (*decorator.AttachmentData, error) {
 
 	offer := &credOffer{}
@@ -215,6 +216,6 @@ func (r *CredentialEngine) newCryptoSigner(kid string) (*subtle.ED25519Signer, e
 
 }
 
-func (r *CredentialEngine) GetSchemaForProposal(proposal []byte) (string, error) {
+func (r *CredentialEngine) GetSchemaForProposal(_ context.Context, proposal []byte) (string, error) {
 	panic("implement me")
 }
@@ -1,6 +1,7 @@
 package lds
 
 import (
+	"context"
 	"encoding/json"
 	"testing"
 
@@ -86,7 +87,7 @@ func TestIssueCredential(t *testing.T) {
 		prov.registry.ResolveValue = doc
 		prov.kms.GetKeyValue = kh
 
-		res, err := engine.IssueCredential(issuerDID, s, offerID, req, vals)
+		res, err := engine.IssueCredential(context.Background(), issuerDID, s, offerID, req, vals)
 		require.NoError(t, err)
 		require.NotNil(t, res)
 
@@ -108,7 +109,7 @@ func TestIssueCredential(t *testing.T) {
 		offerID := "test-offer-id"
 		prov.store.Store.Store["test-offer-id"] = d
 
-		res, err := engine.IssueCredential(issuerDID, s, offerID, req, vals)
+		res, err := engine.IssueCredential(context.Background(), issuerDID, s, offerID, req, vals)
 		require.Nil(t, res)
 		require.Error(t, err)
 
@@ -130,7 +131,7 @@ func TestIssueCredential(t *testing.T) {
 		offerID := "test-offer-id"
 		prov.store.Store.Store["test-offer-id"] = []byte(`{}`)
 
-		res, err := engine.IssueCredential(issuerDID, s, offerID, req, vals)
+		res, err := engine.IssueCredential(context.Background(), issuerDID, s, offerID, req, vals)
 		require.Nil(t, res)
 		require.Error(t, err)
 
@@ -151,7 +152,7 @@ func TestIssueCredential(t *testing.T) {
 		s := &datastore.Schema{}
 		offerID := "test-offer-id"
 
-		res, err := engine.IssueCredential(issuerDID, s, offerID, req, vals)
+		res, err := engine.IssueCredential(context.Background(), issuerDID, s, offerID, req, vals)
 		require.Nil(t, res)
 		require.Error(t, err)
 
@@ -172,7 +173,7 @@ func TestOfferCredential(t *testing.T) {
 		subjectDID := "did:scr:S1uRyT6S3GyYCC4Q4ryirH"
 		s := &datastore.Schema{}
 
-		offerID, attach, err := engine.CreateCredentialOffer(nil, subjectDID, s, []byte(vals))
+		offerID, attach, err := engine.CreateCredentialOffer(context.Background(), nil, subjectDID, s, []byte(vals))
 		require.NoError(t, err)
 		require.NotEmpty(t, offerID)
 		require.Equal(t, "eyJAY29udGV4dCI6bnVsbCwiQHR5cGUiOlsiIl0sImZpcnN0TmFtZSI6IkJpbGJvIiwibGFzdE5hbWUiOiJCYWdnaW5zIn0=", attach.Base64)
@@ -1,6 +1,8 @@
 package mocks
 
 import (
+	"context"
+
 	decorator "github.com/hyperledger/aries-framework-go/pkg/didcomm/protocol/decorator"
 	datastore "github.com/scoir/canis/pkg/datastore"
 )
@@ -20,12 +22,12 @@ type CredentialEngine struct {
 	SchemaForProposalErr            error
 }
 
-func (r *CredentialEngine) GetSchemaForProposal(proposal []byte) (string, error) {
+func (r *CredentialEngine) GetSchemaForProposal(ctx context.Context, proposal []byte) (string, error) {
 	return r.SchemaIDForProposal, r.SchemaForProposalErr
 }
 
-// CreateCredentialOffer provides a mock function with given fields: issuer, s
-func (r *CredentialEngine) CreateCredentialOffer(issuer *datastore.DID, subjectDID string, s *datastore.Schema, value []byte) (string, *decorator.AttachmentData, error) {
+// CreateCredentialOffer provides a mock function with given fields: ctx, issuer, s
+func (r *CredentialEngine) CreateCredentialOffer(ctx context.Context, issuer *datastore.DID, subjectDID string, s *datastore.Schema, value []byte) (string, *decorator.AttachmentData, error) {
 	return r.CredentialOfferID, r.CreateCredentialOfferAttachment, r.CreateCredentialOfferError
 }
 
@@ -34,17 +36,17 @@ func (r *CredentialEngine) Accept(typ string) bool {
 	return r.Accep
 }
 
-// CreateSchema provides a mock function with given fields: issuer, s
-func (r *CredentialEngine) CreateSchema(issuer *datastore.DID, s *datastore.Schema) (string, error) {
+// CreateSchema provides a mock function with given fields: ctx, issuer, s
+func (r *CredentialEngine) CreateSchema(ctx context.Context, issuer *datastore.DID, s *datastore.Schema) (string, error) {
 	return r.SchemaID, r.CreateSchemaError
 }
 
-// IssueCredential provides a mock function with given fields: issuerDID, s, offerID, requestAttachment, values
-func (r *CredentialEngine) IssueCredential(issuerDID *datastore.DID, s *datastore.Schema, offerID string, requestAttachment decorator.AttachmentData, values map[string]interface{}) (*decorator.AttachmentData, error) {
+// IssueCredential provides a mock function with given fields: ctx, issuerDID, s, offerID, requestAttachment, values
+func (r *CredentialEngine) IssueCredential(ctx context.Context, issuerDID *datastore.DID, s *datastore.Schema, offerID string, requestAttachment decorator.AttachmentData, values map[string]interface{}) (*decorator.AttachmentData, error) {
 	return r.IssueCredentialAttachment, r.IssueCredentialError
 }
 
-// RegisterSchema provides a mock function with given fields: registrant, s
-func (r *CredentialEngine) RegisterSchema(registrant *datastore.DID, s *datastore.Schema) error {
+// RegisterSchema provides a mock function with given fields: ctx, registrant, s
+func (r *CredentialEngine) RegisterSchema(ctx context.Context, registrant *datastore.DID, s *datastore.Schema) error {
 	return r.RegisterError
 }
@@ -3,6 +3,8 @@
 package mocks
 
 import (
+	context "context"
+
 	decorator "github.com/hyperledger/aries-framework-go/pkg/didcomm/protocol/decorator"
 	datastore "github.com/scoir/canis/pkg/datastore"
 
@@ -14,20 +16,20 @@ type CredentialRegistry struct {
 	mock.Mock
 }
 
-// CreateCredentialOffer provides a mock function with given fields: issuer, subjectDID, s, value
-func (_m *CredentialRegistry) CreateCredentialOffer(issuer *datastore.DID, subjectDID string, s *datastore.Schema, value []byte) (string, *decorator.AttachmentData, error) {
-	ret := _m.Called(issuer, subjectDID, s, value)
+// CreateCredentialOffer provides a mock function with given fields: ctx, issuer, subjectDID, s, value
+func (_m *CredentialRegistry) CreateCredentialOffer(ctx context.Context, issuer *datastore.DID, subjectDID string, s *datastore.Schema, value []byte) (string, *decorator.AttachmentData, error) {
+	ret := _m.Called(ctx, issuer, subjectDID, s, value)
 
 	var r0 string
-	if rf, ok := ret.Get(0).(func(*datastore.DID, string, *datastore.Schema, []byte) string); ok {
-		r0 = rf(issuer, subjectDID, s, value)
+	if rf, ok := ret.Get(0).(func(context.Context, *datastore.DID, string, *datastore.Schema, []byte) string); ok {
+		r0 = rf(ctx, issuer, subjectDID, s, value)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
 	var r1 *decorator.AttachmentData
-	if rf, ok := ret.Get(1).(func(*datastore.DID, string, *datastore.Schema, []byte) *decorator.AttachmentData); ok {
-		r1 = rf(issuer, subjectDID, s, value)
+	if rf, ok := ret.Get(1).(func(context.Context, *datastore.DID, string, *datastore.Schema, []byte) *decorator.AttachmentData); ok {
+		r1 = rf(ctx, issuer, subjectDID, s, value)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(*decorator.AttachmentData)
@@ -35,8 +37,8 @@ func (_m *CredentialRegistry) CreateCredentialOffer(issuer *datastore.DID, subje
 	}
 
 	var r2 error
-	if rf, ok := ret.Get(2).(func(*datastore.DID, string, *datastore.Schema, []byte) error); ok {
-		r2 = rf(issuer, subjectDID, s, value)
+	if rf, ok := ret.Get(2).(func(context.Context, *datastore.DID, string, *datastore.Schema, []byte) error); ok {
+		r2 = rf(ctx, issuer, subjectDID, s, value)
 	} else {
 		r2 = ret.Error(2)
 	}
@@ -44,20 +46,20 @@ func (_m *CredentialRegistry) CreateCredentialOffer(issuer *datastore.DID, subje
 	return r0, r1, r2
 }
 
-// CreateSchema provides a mock function with given fields: s
-func (_m *CredentialRegistry) CreateSchema(s *datastore.Schema) (string, error) {
-	ret := _m.Called(s)
+// CreateSchema provides a mock function with given fields: ctx, s
+func (_m *CredentialRegistry) CreateSchema(ctx context.Context, s *datastore.Schema) (string, error) {
+	ret := _m.Called(ctx, s)
 
 	var r0 string
-	if rf, ok := ret.Get(0).(func(*datastore.Schema) string); ok {
-		r0 = rf(s)
+	if rf, ok := ret.Get(0).(func(context.Context, *datastore.Schema) string); ok {
+		r0 = rf(ctx, s)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*datastore.Schema) error); ok {
-		r1 = rf(s)
+	if rf, ok := ret.Get(1).(func(context.Context, *datastore.Schema) error); ok {
+		r1 = rf(ctx, s)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -65,20 +67,20 @@ func (_m *CredentialRegistry) CreateSchema(s *datastore.Schema) (string, error)
 	return r0, r1
 }
 
-// GetSchemaForProposal provides a mock function with given fields: format, data
-func (_m *CredentialRegistry) GetSchemaForProposal(format string, data []byte) (string, error) {
-	ret := _m.Called(format, data)
+// GetSchemaForProposal provides a mock function with given fields: ctx, format, data
+func (_m *CredentialRegistry) GetSchemaForProposal(ctx context.Context, format string, data []byte) (string, error) {
+	ret := _m.Called(ctx, format, data)
 
 	var r0 string
-	if rf, ok := ret.Get(0).(func(string, []byte) string); ok {
-		r0 = rf(format, data)
+	if rf, ok := ret.Get(0).(func(context.Context, string, []byte) string); ok {
+		r0 = rf(ctx, format, data)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, []byte) error); ok {
-		r1 = rf(format, data)
+	if rf, ok := ret.Get(1).(func(context.Context, string, []byte) error); ok {
+		r1 = rf(ctx, format, data)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -86,13 +88,13 @@ func (_m *CredentialRegistry) GetSchemaForProposal(format string, data []byte) (
 	return r0, r1
 }
 
-// IssueCredential provides a mock function with given fields: issuer, s, offerID, requestAttachment, values
-func (_m *CredentialRegistry) IssueCredential(issuer *datastore.DID, s *datastore.Schema, offerID string, requestAttachment decorator.AttachmentData, values map[string]interface{}) (*decorator.AttachmentData, error) {
-	ret := _m.Called(issuer, s, offerID, requestAttachment, values)
+// IssueCredential provides a mock function with given fields: ctx, issuer, s, offerID, requestAttachment, values
+func (_m *CredentialRegistry) IssueCredential(ctx context.Context, issuer *datastore.DID, s *datastore.Schema, offerID string, requestAttachment decorator.AttachmentData, values map[string]interface{}) (*decorator.AttachmentData, error) {
+	ret := _m.Called(ctx, issuer, s, offerID, requestAttachment, values)
 
 	var r0 *decorator.AttachmentData
-	if rf, ok := ret.Get(0).(func(*datastore.DID, *datastore.Schema, string, decorator.AttachmentData, map[string]interface{}) *decorator.AttachmentData); ok {
-		r0 = rf(issuer, s, offerID, requestAttachment, values)
+	if rf, ok := ret.Get(0).(func(context.Context, *datastore.DID, *datastore.Schema, string, decorator.AttachmentData, map[string]interface{}) *decorator.AttachmentData); ok {
+		r0 = rf(ctx, issuer, s, offerID, requestAttachment, values)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*decorator.AttachmentData)
@@ -100,8 +102,8 @@ func (_m *CredentialRegistry) IssueCredential(issuer *datastore.DID, s *datastor
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*datastore.DID, *datastore.Schema, string, decorator.AttachmentData, map[string]interface{}) error); ok {
-		r1 = rf(issuer, s, offerID, requestAttachment, values)
+	if rf, ok := ret.Get(1).(func(context.Context, *datastore.DID, *datastore.Schema, string, decorator.AttachmentData, map[string]interface{}) error); ok {
+		r1 = rf(ctx, issuer, s, offerID, requestAttachment, values)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -109,13 +111,13 @@ func (_m *CredentialRegistry) IssueCredential(issuer *datastore.DID, s *datastor
 	return r0, r1
 }
 
-// RegisterSchema provides a mock function with given fields: registrant, s
-func (_m *CredentialRegistry) RegisterSchema(registrant *datastore.DID, s *datastore.Schema) error {
-	ret := _m.Called(registrant, s)
+// RegisterSchema provides a mock function with given fields: ctx, registrant, s
+func (_m *CredentialRegistry) RegisterSchema(ctx context.Context, registrant *datastore.DID, s *datastore.Schema) error {
+	ret := _m.Called(ctx, registrant, s)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*datastore.DID, *datastore.Schema) error); ok {
-		r0 = rf(registrant, s)
+	if rf, ok := ret.Get(0).(func(context.Context, *datastore.DID, *datastore.Schema) error); ok {
+		r0 = rf(ctx, registrant, s)
 	} else {
 		r0 = ret.Error(0)
 	}
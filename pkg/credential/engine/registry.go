@@ -1,32 +1,36 @@
 package engine
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/hyperledger/aries-framework-go/pkg/didcomm/protocol/decorator"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/scoir/canis/pkg/datastore"
+	"github.com/scoir/canis/pkg/tracing"
 )
 
 type CredentialEngine interface {
 	Accept(format string) bool
-	CreateSchema(issuer *datastore.DID, s *datastore.Schema) (string, error)
-	RegisterSchema(registrant *datastore.DID, s *datastore.Schema) error
-	CreateCredentialOffer(issuer *datastore.DID, subjectDID string, s *datastore.Schema, value []byte) (string, *decorator.AttachmentData, error)
-	IssueCredential(issuerDID *datastore.DID, s *datastore.Schema, offerID string,
+	CreateSchema(ctx context.Context, issuer *datastore.DID, s *datastore.Schema) (string, error)
+	RegisterSchema(ctx context.Context, registrant *datastore.DID, s *datastore.Schema) error
+	CreateCredentialOffer(ctx context.Context, issuer *datastore.DID, subjectDID string, s *datastore.Schema, value []byte) (string, *decorator.AttachmentData, error)
+	IssueCredential(ctx context.Context, issuerDID *datastore.DID, s *datastore.Schema, offerID string,
 		requestAttachment decorator.AttachmentData, values map[string]interface{}) (*decorator.AttachmentData, error)
-	GetSchemaForProposal(proposal []byte) (string, error)
+	GetSchemaForProposal(ctx context.Context, proposal []byte) (string, error)
 }
 
 //go:generate mockery -name=CredentialRegistry
 type CredentialRegistry interface {
-	CreateSchema(s *datastore.Schema) (string, error)
-	RegisterSchema(registrant *datastore.DID, s *datastore.Schema) error
-	CreateCredentialOffer(issuer *datastore.DID, subjectDID string, s *datastore.Schema, value []byte) (string, *decorator.AttachmentData, error)
-	IssueCredential(issuer *datastore.DID, s *datastore.Schema, offerID string,
+	CreateSchema(ctx context.Context, s *datastore.Schema) (string, error)
+	RegisterSchema(ctx context.Context, registrant *datastore.DID, s *datastore.Schema) error
+	CreateCredentialOffer(ctx context.Context, issuer *datastore.DID, subjectDID string, s *datastore.Schema, value []byte) (string, *decorator.AttachmentData, error)
+	IssueCredential(ctx context.Context, issuer *datastore.DID, s *datastore.Schema, offerID string,
 		requestAttachment decorator.AttachmentData, values map[string]interface{}) (*decorator.AttachmentData, error)
-	GetSchemaForProposal(format string, data []byte) (string, error)
+	GetSchemaForProposal(ctx context.Context, format string, data []byte) (string, error)
 }
 
 type Option func(opts *Registry)
@@ -50,58 +54,79 @@ func New(prov provider, opts ...Option) *Registry {
 	return reg
 }
 
-func (r *Registry) CreateSchema(s *datastore.Schema) (string, error) {
+func (r *Registry) CreateSchema(ctx context.Context, s *datastore.Schema) (_ string, err error) {
+	ctx, span := startSpan(ctx, "CreateSchema", s.Format)
+	defer func() { tracing.End(span, err) }()
+
 	e, err := r.resolveEngine(s.Format)
 	if err != nil {
 		return "", err
 	}
 
-	issuer, err := r.didStore.GetPublicDID()
+	issuer, err := r.didStore.GetLedgerPublicDID(s.Ledger)
 	if err != nil {
 		return "", errors.Wrap(err, "error getting public did to create schema")
 	}
 
-	id, err := e.CreateSchema(issuer, s)
+	id, err := e.CreateSchema(ctx, issuer, s)
 	return id, errors.Wrap(err, "error from credential engine")
 }
 
-func (r *Registry) RegisterSchema(registrant *datastore.DID, s *datastore.Schema) error {
+func (r *Registry) RegisterSchema(ctx context.Context, registrant *datastore.DID, s *datastore.Schema) (err error) {
+	ctx, span := startSpan(ctx, "RegisterSchema", s.Format)
+	defer func() { tracing.End(span, err) }()
+
 	e, err := r.resolveEngine(s.Format)
 	if err != nil {
 		return err
 	}
 
-	err = e.RegisterSchema(registrant, s)
+	err = e.RegisterSchema(ctx, registrant, s)
 	return errors.Wrap(err, "error from credential engine")
 
 }
 
-func (r *Registry) CreateCredentialOffer(issuer *datastore.DID, subjectDID string, s *datastore.Schema, value []byte) (string, *decorator.AttachmentData, error) {
+func (r *Registry) CreateCredentialOffer(ctx context.Context, issuer *datastore.DID, subjectDID string, s *datastore.Schema, value []byte) (_ string, _ *decorator.AttachmentData, err error) {
+	ctx, span := startSpan(ctx, "CreateCredentialOffer", s.Format)
+	defer func() { tracing.End(span, err) }()
+
 	e, err := r.resolveEngine(s.Format)
 	if err != nil {
 		return "", nil, err
 	}
 
-	return e.CreateCredentialOffer(issuer, subjectDID, s, value)
+	return e.CreateCredentialOffer(ctx, issuer, subjectDID, s, value)
 }
 
-func (r *Registry) IssueCredential(issuer *datastore.DID, s *datastore.Schema, offerID string, requestAttachment decorator.AttachmentData,
-	values map[string]interface{}) (*decorator.AttachmentData, error) {
+func (r *Registry) IssueCredential(ctx context.Context, issuer *datastore.DID, s *datastore.Schema, offerID string, requestAttachment decorator.AttachmentData,
+	values map[string]interface{}) (_ *decorator.AttachmentData, err error) {
+	ctx, span := startSpan(ctx, "IssueCredential", s.Format)
+	defer func() { tracing.End(span, err) }()
+
 	e, err := r.resolveEngine(s.Format)
 	if err != nil {
 		return nil, err
 	}
 
-	return e.IssueCredential(issuer, s, offerID, requestAttachment, values)
+	return e.IssueCredential(ctx, issuer, s, offerID, requestAttachment, values)
 }
 
-func (r *Registry) GetSchemaForProposal(format string, data []byte) (string, error) {
+func (r *Registry) GetSchemaForProposal(ctx context.Context, format string, data []byte) (_ string, err error) {
+	ctx, span := startSpan(ctx, "GetSchemaForProposal", format)
+	defer func() { tracing.End(span, err) }()
+
 	e, err := r.resolveEngine(format)
 	if err != nil {
 		return "", err
 	}
 
-	return e.GetSchemaForProposal(data)
+	return e.GetSchemaForProposal(ctx, data)
+}
+
+// startSpan traces a credential engine call as part of the trace of ctx and returns the context for the engine
+func startSpan(ctx context.Context, op, format string) (context.Context, trace.Span) {
+	return tracing.Start(ctx, "credential.engine "+op,
+		trace.WithAttributes(attribute.String("credential.format", format)))
 }
 
 func (r *Registry) resolveEngine(format string) (CredentialEngine, error) {
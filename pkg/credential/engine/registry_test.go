@@ -1,6 +1,7 @@
 package engine
 
 import (
+	"context"
 	"errors"
 	"testing"
 
@@ -36,7 +37,7 @@ func TestCreateCredentialOffer(t *testing.T) {
 
 	did := &datastore.DID{}
 	s := &datastore.Schema{Format: "indy"}
-	id, attach, err := reg.CreateCredentialOffer(did, "", s, nil)
+	id, attach, err := reg.CreateCredentialOffer(context.Background(), did, "", s, nil)
 	require.Equal(t, id, eng.CredentialOfferID)
 	require.Equal(t, attach, eng.CreateCredentialOfferAttachment)
 	require.NoError(t, err)
@@ -44,7 +45,7 @@ func TestCreateCredentialOffer(t *testing.T) {
 	eng.CredentialOfferID = ""
 	eng.CreateCredentialOfferAttachment = nil
 	eng.CreateCredentialOfferError = errors.New("BOOM")
-	id, attach, err = reg.CreateCredentialOffer(did, "", s, nil)
+	id, attach, err = reg.CreateCredentialOffer(context.Background(), did, "", s, nil)
 	require.Empty(t, id)
 	require.Nil(t, attach)
 	require.Error(t, err)
@@ -63,13 +64,13 @@ func TestIssueCredential(t *testing.T) {
 
 	did := &datastore.DID{}
 	s := &datastore.Schema{Format: "indy"}
-	attach, err := reg.IssueCredential(did, s, "test", decorator.AttachmentData{}, map[string]interface{}{})
+	attach, err := reg.IssueCredential(context.Background(), did, s, "test", decorator.AttachmentData{}, map[string]interface{}{})
 	require.Equal(t, attach, eng.IssueCredentialAttachment)
 	require.NoError(t, err)
 
 	eng.IssueCredentialAttachment = nil
 	eng.IssueCredentialError = errors.New("BOOM")
-	attach, err = reg.IssueCredential(did, s, "test", decorator.AttachmentData{}, map[string]interface{}{})
+	attach, err = reg.IssueCredential(context.Background(), did, s, "test", decorator.AttachmentData{}, map[string]interface{}{})
 	require.Nil(t, attach)
 	require.Error(t, err)
 	require.Equal(t, err.Error(), "BOOM")
@@ -86,13 +87,13 @@ func TestGetSchemaForProposal(t *testing.T) {
 	reg := New(prov, WithEngine(eng))
 
 	proposal := []byte(`{"schema_id": "123"}`)
-	schemaID, err := reg.GetSchemaForProposal("hlindy-zkp-v1.0", proposal)
+	schemaID, err := reg.GetSchemaForProposal(context.Background(), "hlindy-zkp-v1.0", proposal)
 	require.NoError(t, err)
 	require.Equal(t, schemaID, eng.SchemaIDForProposal)
 
 	eng.SchemaIDForProposal = ""
 	eng.SchemaForProposalErr = errors.New("BOOM")
-	schemaID, err = reg.GetSchemaForProposal("hlindy-zkp-v1.0", proposal)
+	schemaID, err = reg.GetSchemaForProposal(context.Background(), "hlindy-zkp-v1.0", proposal)
 	require.Empty(t, schemaID)
 	require.Error(t, err)
 	require.Equal(t, err.Error(), "BOOM")
@@ -108,11 +109,11 @@ func TestRegisterSchema(t *testing.T) {
 
 	did := &datastore.DID{}
 	s := &datastore.Schema{Format: "indy"}
-	err := reg.RegisterSchema(did, s)
+	err := reg.RegisterSchema(context.Background(), did, s)
 	require.NoError(t, err)
 
 	eng.RegisterError = errors.New("BOOM")
-	err = reg.RegisterSchema(did, s)
+	err = reg.RegisterSchema(context.Background(), did, s)
 	require.Error(t, err)
 	require.Equal(t, err.Error(), "error from credential engine: BOOM")
 
@@ -128,22 +129,22 @@ func TestCreateSchema(t *testing.T) {
 	reg := New(prov, WithEngine(eng))
 
 	did := &datastore.DID{}
-	prov.store.On("GetPublicDID").Return(did, nil).Once()
+	prov.store.On("GetLedgerPublicDID", "").Return(did, nil).Once()
 	s := &datastore.Schema{Format: "indy"}
-	id, err := reg.CreateSchema(s)
+	id, err := reg.CreateSchema(context.Background(), s)
 	require.Equal(t, id, eng.SchemaID)
 	require.NoError(t, err)
 
-	prov.store.On("GetPublicDID").Return(did, nil).Once()
+	prov.store.On("GetLedgerPublicDID", "").Return(did, nil).Once()
 	eng.SchemaID = ""
 	eng.CreateSchemaError = errors.New("BOOM")
-	id, err = reg.CreateSchema(s)
+	id, err = reg.CreateSchema(context.Background(), s)
 	require.Empty(t, id)
 	require.Error(t, err)
 	require.Equal(t, err.Error(), "error from credential engine: BOOM")
 
-	prov.store.On("GetPublicDID").Return(nil, errors.New("NO DID")).Once()
-	id, err = reg.CreateSchema(s)
+	prov.store.On("GetLedgerPublicDID", "").Return(nil, errors.New("NO DID")).Once()
+	id, err = reg.CreateSchema(context.Background(), s)
 	require.Empty(t, id)
 	require.Error(t, err)
 	require.Equal(t, err.Error(), "error getting public did to create schema: NO DID")
@@ -157,27 +158,27 @@ func TestNoValidEngine(t *testing.T) {
 
 	did := &datastore.DID{}
 	s := &datastore.Schema{Format: "indy"}
-	id, attach, err := reg.CreateCredentialOffer(did, "", s, nil)
+	id, attach, err := reg.CreateCredentialOffer(context.Background(), did, "", s, nil)
 	require.Empty(t, id)
 	require.Nil(t, attach)
 	require.Error(t, err)
 	require.Equal(t, err.Error(), "credential format indy not supported by any engine")
 
-	attach, err = reg.IssueCredential(did, s, "test", decorator.AttachmentData{}, map[string]interface{}{})
+	attach, err = reg.IssueCredential(context.Background(), did, s, "test", decorator.AttachmentData{}, map[string]interface{}{})
 	require.Nil(t, attach)
 	require.Error(t, err)
 	require.Equal(t, err.Error(), "credential format indy not supported by any engine")
 
-	err = reg.RegisterSchema(did, s)
+	err = reg.RegisterSchema(context.Background(), did, s)
 	require.Error(t, err)
 	require.Equal(t, err.Error(), "credential format indy not supported by any engine")
 
-	id, err = reg.CreateSchema(s)
+	id, err = reg.CreateSchema(context.Background(), s)
 	require.Empty(t, id)
 	require.Error(t, err)
 	require.Equal(t, err.Error(), "credential format indy not supported by any engine")
 
-	id, err = reg.GetSchemaForProposal("bad", []byte{})
+	id, err = reg.GetSchemaForProposal(context.Background(), "bad", []byte{})
 	require.Empty(t, id)
 	require.Error(t, err)
 	require.Equal(t, err.Error(), "credential format bad not supported by any engine")
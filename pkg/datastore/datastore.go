@@ -7,6 +7,8 @@ SPDX-License-Identifier: Apache-2.0
 package datastore
 
 import (
+	"time"
+
 	"github.com/hyperledger/aries-framework-go/pkg/client/didexchange"
 )
 
@@ -28,10 +30,12 @@ type Store interface {
 	GetDID(id string) (*DID, error)
 	// SetPublicDID update single DID to public, unset remaining
 	ListDIDs(c *DIDCriteria) (*DIDList, error)
-	// SetPublicDID update single DID to public, unset remaining
+	// SetPublicDID update single DID to public for its ledger, unset remaining on that ledger
 	SetPublicDID(DID *DID) error
-	// GetPublicDID get public DID
+	// GetPublicDID get public DID on the default ledger
 	GetPublicDID() (*DID, error)
+	// GetLedgerPublicDID get public DID on the ledger with namespace, empty for the default ledger
+	GetLedgerPublicDID(ledger string) (*DID, error)
 
 	// InsertSchema add Schema to store
 	InsertSchema(s *Schema) (string, error)
@@ -104,8 +108,8 @@ type Store interface {
 	GetMediatorDID() (*DID, error)
 
 	// RegisterEdgeAgent associates the DID and external ID with an internal ID for a registered
-	// Edge Agent
-	RegisterEdgeAgent(connectionID, externalID string) (string, error)
+	// Edge Agent, pushToken is empty when the device does not want wake-up notifications
+	RegisterEdgeAgent(connectionID, externalID, pushToken string) (string, error)
 
 	// GetEdgeAgent retrieves the internal ID associated with a registered DID for an
 	// Edge Agent
@@ -114,12 +118,37 @@ type Store interface {
 	// GetEdgeAgentForDID retrieves the internal ID associated with a registered DID for an existing connection
 	GetEdgeAgentForDID(theirDID string) (*EdgeAgent, error)
 
+	// GetEdgeAgentForKey retrieves the edge agent that receives messages for a recipient key
+	GetEdgeAgentForKey(key string) (*EdgeAgent, error)
+
+	// GetEdgeAgentForRoutingKey retrieves the edge agent the mediator forwards messages for a routing key to
+	GetEdgeAgentForRoutingKey(key string) (*EdgeAgent, error)
+
 	// UpdateEdgeAgent updates the edge agent using the connection ID and the external ID
 	UpdateEdgeAgent(ea *EdgeAgent) error
 
+	// ListEdgeAgents returns all registered edge agents
+	ListEdgeAgents() ([]*EdgeAgent, error)
+
+	// InsertMailboxMessage queues a message for an edge agent
+	InsertMailboxMessage(m *MailboxMessage) error
+	// ListMailboxMessages returns up to limit messages queued for an edge agent, oldest first
+	ListMailboxMessages(edgeAgentID string, limit int) ([]*MailboxMessage, error)
+	// CountMailboxMessages returns the number of messages queued for an edge agent
+	CountMailboxMessages(edgeAgentID string) (int, error)
+	// DeleteMailboxMessages removes picked up messages from an edge agent's queue
+	DeleteMailboxMessages(edgeAgentID string, ids []string) (int, error)
+	// PruneMailbox drops messages received before the cutoff and the oldest messages beyond max
+	PruneMailbox(edgeAgentID string, before time.Time, max int) (int, error)
+
+	// InsertEnrollmentToken saves a token an agent can register with
+	InsertEnrollmentToken(t *EnrollmentToken) error
+	// ConsumeEnrollmentToken removes and returns a token that has not expired so it can only be used once
+	ConsumeEnrollmentToken(id string, now time.Time) (*EnrollmentToken, error)
+
 	// RegisterCloudAgent associates the DID and external ID with an internal ID for a registered
-	// Cloud Agent
-	RegisterCloudAgent(externalID string, publicKey, nextKey []byte) (string, error)
+	// Cloud Agent, pushToken is empty when the device does not want wake-up notifications
+	RegisterCloudAgent(externalID string, publicKey, nextKey []byte, pushToken string) (string, error)
 
 	// GetCloudAgent retrieves the internal ID associated with a registered DID for an
 	// Cloud Agent
@@ -139,8 +168,10 @@ type Store interface {
 	ListCloudAgentConnections(a *CloudAgent) ([]*CloudAgentConnection, error)
 	// GetAgentConnection return single connection between an agent and an external subject
 	GetCloudAgentConnection(a *CloudAgent, invitationID string) (*CloudAgentConnection, error)
-	// DeleteAgentConnection deletes a connection for an agent
-	DeleteCloudAgentConnection(a *CloudAgent, externalID string) error
+	// DeleteCloudAgentConnection deletes a connection for a cloud agent
+	DeleteCloudAgentConnection(a *CloudAgent, connectionID string) error
+	// DeleteCloudAgentInvitation deletes the connection of a cloud agent invitation that has not been accepted
+	DeleteCloudAgentInvitation(a *CloudAgent, invitationID string) error
 
 	// GetAgentConnectionForDID return single connection between an agent and an external subject
 	GetCloudAgentConnectionForDIDs(myDID string, theirDID string) (*CloudAgentConnection, error)
@@ -169,4 +200,25 @@ type Store interface {
 	GetCloudAgentProofRequest(a *CloudAgent, id string) (*CloudAgentProofRequest, error)
 	// DeleteAgentProofRequest deletes a ProofRequest for an agent
 	DeleteCloudAgentProofRequest(a *CloudAgent, id string) error
+
+	// InsertAuditEvent appends an event to the audit log
+	InsertAuditEvent(e *AuditEvent) error
+	// GetLastAuditEvent returns the most recently appended audit event, or nil if the log is empty
+	GetLastAuditEvent() (*AuditEvent, error)
+	// ListAuditEvents query audit events in sequence order
+	ListAuditEvents(c *AuditEventCriteria) (*AuditEventList, error)
+
+	// SetTAAAcceptance records the operator's acceptance of a ledger's transaction author agreement
+	SetTAAAcceptance(a *TAAAcceptance) error
+	// GetTAAAcceptance returns the current transaction author agreement acceptance for a ledger
+	GetTAAAcceptance(ledger string) (*TAAAcceptance, error)
+
+	// InsertEndorsement tracks a ledger transaction waiting on an external endorser
+	InsertEndorsement(e *Endorsement) (string, error)
+	// GetEndorsement return single endorsement
+	GetEndorsement(id string) (*Endorsement, error)
+	// UpdateEndorsement updates the status of an endorsement
+	UpdateEndorsement(e *Endorsement) error
+	// ListEndorsements query endorsements
+	ListEndorsements(c *EndorsementCriteria) (*EndorsementList, error)
 }
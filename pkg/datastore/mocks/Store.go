@@ -6,6 +6,8 @@ import (
 	didexchange "github.com/hyperledger/aries-framework-go/pkg/client/didexchange"
 	datastore "github.com/scoir/canis/pkg/datastore"
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // Store is an autogenerated mock type for the Store type
@@ -27,6 +29,50 @@ func (_m *Store) AddWebhook(hook *datastore.Webhook) error {
 	return r0
 }
 
+// ConsumeEnrollmentToken provides a mock function with given fields: id, now
+func (_m *Store) ConsumeEnrollmentToken(id string, now time.Time) (*datastore.EnrollmentToken, error) {
+	ret := _m.Called(id, now)
+
+	var r0 *datastore.EnrollmentToken
+	if rf, ok := ret.Get(0).(func(string, time.Time) *datastore.EnrollmentToken); ok {
+		r0 = rf(id, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datastore.EnrollmentToken)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, time.Time) error); ok {
+		r1 = rf(id, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CountMailboxMessages provides a mock function with given fields: edgeAgentID
+func (_m *Store) CountMailboxMessages(edgeAgentID string) (int, error) {
+	ret := _m.Called(edgeAgentID)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(string) int); ok {
+		r0 = rf(edgeAgentID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(edgeAgentID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DeleteAgent provides a mock function with given fields: name
 func (_m *Store) DeleteAgent(name string) error {
 	ret := _m.Called(name)
@@ -55,13 +101,13 @@ func (_m *Store) DeleteAgentConnection(a *datastore.Agent, externalID string) er
 	return r0
 }
 
-// DeleteCloudAgentConnection provides a mock function with given fields: a, externalID
-func (_m *Store) DeleteCloudAgentConnection(a *datastore.CloudAgent, externalID string) error {
-	ret := _m.Called(a, externalID)
+// DeleteCloudAgentConnection provides a mock function with given fields: a, connectionID
+func (_m *Store) DeleteCloudAgentConnection(a *datastore.CloudAgent, connectionID string) error {
+	ret := _m.Called(a, connectionID)
 
 	var r0 error
 	if rf, ok := ret.Get(0).(func(*datastore.CloudAgent, string) error); ok {
-		r0 = rf(a, externalID)
+		r0 = rf(a, connectionID)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -83,6 +129,34 @@ func (_m *Store) DeleteCloudAgentCredential(a *datastore.CloudAgent, id string)
 	return r0
 }
 
+// DeleteCloudAgentInvitation provides a mock function with given fields: a, invitationID
+func (_m *Store) DeleteCloudAgentInvitation(a *datastore.CloudAgent, invitationID string) error {
+	ret := _m.Called(a, invitationID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*datastore.CloudAgent, string) error); ok {
+		r0 = rf(a, invitationID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteCloudAgentProofRequest provides a mock function with given fields: a, id
+func (_m *Store) DeleteCloudAgentProofRequest(a *datastore.CloudAgent, id string) error {
+	ret := _m.Called(a, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*datastore.CloudAgent, string) error); ok {
+		r0 = rf(a, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteCredentialByOffer provides a mock function with given fields: offerID
 func (_m *Store) DeleteCredentialByOffer(offerID string) error {
 	ret := _m.Called(offerID)
@@ -97,6 +171,27 @@ func (_m *Store) DeleteCredentialByOffer(offerID string) error {
 	return r0
 }
 
+// DeleteMailboxMessages provides a mock function with given fields: edgeAgentID, ids
+func (_m *Store) DeleteMailboxMessages(edgeAgentID string, ids []string) (int, error) {
+	ret := _m.Called(edgeAgentID, ids)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(string, []string) int); ok {
+		r0 = rf(edgeAgentID, ids)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, []string) error); ok {
+		r1 = rf(edgeAgentID, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DeleteSchema provides a mock function with given fields: name
 func (_m *Store) DeleteSchema(name string) error {
 	ret := _m.Called(name)
@@ -355,13 +450,13 @@ func (_m *Store) GetCloudAgentCredentialFromThread(cloudAgentID string, thid str
 	return r0, r1
 }
 
-// GetCloudAgentForDID provides a mock function with given fields: theirDID
-func (_m *Store) GetCloudAgentForDID(theirDID string) (*datastore.CloudAgent, error) {
-	ret := _m.Called(theirDID)
+// GetCloudAgentForDID provides a mock function with given fields: myDID
+func (_m *Store) GetCloudAgentForDID(myDID string) (*datastore.CloudAgent, error) {
+	ret := _m.Called(myDID)
 
 	var r0 *datastore.CloudAgent
 	if rf, ok := ret.Get(0).(func(string) *datastore.CloudAgent); ok {
-		r0 = rf(theirDID)
+		r0 = rf(myDID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*datastore.CloudAgent)
@@ -370,7 +465,30 @@ func (_m *Store) GetCloudAgentForDID(theirDID string) (*datastore.CloudAgent, er
 
 	var r1 error
 	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(theirDID)
+		r1 = rf(myDID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCloudAgentProofRequest provides a mock function with given fields: a, id
+func (_m *Store) GetCloudAgentProofRequest(a *datastore.CloudAgent, id string) (*datastore.CloudAgentProofRequest, error) {
+	ret := _m.Called(a, id)
+
+	var r0 *datastore.CloudAgentProofRequest
+	if rf, ok := ret.Get(0).(func(*datastore.CloudAgent, string) *datastore.CloudAgentProofRequest); ok {
+		r0 = rf(a, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datastore.CloudAgentProofRequest)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*datastore.CloudAgent, string) error); ok {
+		r1 = rf(a, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -447,6 +565,121 @@ func (_m *Store) GetEdgeAgentForDID(theirDID string) (*datastore.EdgeAgent, erro
 	return r0, r1
 }
 
+// GetEdgeAgentForKey provides a mock function with given fields: key
+func (_m *Store) GetEdgeAgentForKey(key string) (*datastore.EdgeAgent, error) {
+	ret := _m.Called(key)
+
+	var r0 *datastore.EdgeAgent
+	if rf, ok := ret.Get(0).(func(string) *datastore.EdgeAgent); ok {
+		r0 = rf(key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datastore.EdgeAgent)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetEdgeAgentForRoutingKey provides a mock function with given fields: key
+func (_m *Store) GetEdgeAgentForRoutingKey(key string) (*datastore.EdgeAgent, error) {
+	ret := _m.Called(key)
+
+	var r0 *datastore.EdgeAgent
+	if rf, ok := ret.Get(0).(func(string) *datastore.EdgeAgent); ok {
+		r0 = rf(key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datastore.EdgeAgent)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetEndorsement provides a mock function with given fields: id
+func (_m *Store) GetEndorsement(id string) (*datastore.Endorsement, error) {
+	ret := _m.Called(id)
+
+	var r0 *datastore.Endorsement
+	if rf, ok := ret.Get(0).(func(string) *datastore.Endorsement); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datastore.Endorsement)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLastAuditEvent provides a mock function with given fields:
+func (_m *Store) GetLastAuditEvent() (*datastore.AuditEvent, error) {
+	ret := _m.Called()
+
+	var r0 *datastore.AuditEvent
+	if rf, ok := ret.Get(0).(func() *datastore.AuditEvent); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datastore.AuditEvent)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLedgerPublicDID provides a mock function with given fields: ledger
+func (_m *Store) GetLedgerPublicDID(ledger string) (*datastore.DID, error) {
+	ret := _m.Called(ledger)
+
+	var r0 *datastore.DID
+	if rf, ok := ret.Get(0).(func(string) *datastore.DID); ok {
+		r0 = rf(ledger)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datastore.DID)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(ledger)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetMediatorDID provides a mock function with given fields:
 func (_m *Store) GetMediatorDID() (*datastore.DID, error) {
 	ret := _m.Called()
@@ -562,6 +795,29 @@ func (_m *Store) GetSchemaByExternalID(externalID string) (*datastore.Schema, er
 	return r0, r1
 }
 
+// GetTAAAcceptance provides a mock function with given fields: ledger
+func (_m *Store) GetTAAAcceptance(ledger string) (*datastore.TAAAcceptance, error) {
+	ret := _m.Called(ledger)
+
+	var r0 *datastore.TAAAcceptance
+	if rf, ok := ret.Get(0).(func(string) *datastore.TAAAcceptance); ok {
+		r0 = rf(ledger)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datastore.TAAAcceptance)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(ledger)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // InsertAgent provides a mock function with given fields: a
 func (_m *Store) InsertAgent(a *datastore.Agent) (string, error) {
 	ret := _m.Called(a)
@@ -597,6 +853,20 @@ func (_m *Store) InsertAgentConnection(a *datastore.Agent, externalID string, co
 	return r0
 }
 
+// InsertAuditEvent provides a mock function with given fields: e
+func (_m *Store) InsertAuditEvent(e *datastore.AuditEvent) error {
+	ret := _m.Called(e)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*datastore.AuditEvent) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // InsertCloudAgentConnection provides a mock function with given fields: ac
 func (_m *Store) InsertCloudAgentConnection(ac *datastore.CloudAgentConnection) error {
 	ret := _m.Called(ac)
@@ -625,6 +895,20 @@ func (_m *Store) InsertCloudAgentCredential(cred *datastore.CloudAgentCredential
 	return r0
 }
 
+// InsertCloudAgentProofRequest provides a mock function with given fields: cred
+func (_m *Store) InsertCloudAgentProofRequest(cred *datastore.CloudAgentProofRequest) error {
+	ret := _m.Called(cred)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*datastore.CloudAgentProofRequest) error); ok {
+		r0 = rf(cred)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // InsertCredential provides a mock function with given fields: c
 func (_m *Store) InsertCredential(c *datastore.IssuedCredential) (string, error) {
 	ret := _m.Called(c)
@@ -660,6 +944,55 @@ func (_m *Store) InsertDID(d *datastore.DID) error {
 	return r0
 }
 
+// InsertEndorsement provides a mock function with given fields: e
+func (_m *Store) InsertEndorsement(e *datastore.Endorsement) (string, error) {
+	ret := _m.Called(e)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(*datastore.Endorsement) string); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*datastore.Endorsement) error); ok {
+		r1 = rf(e)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InsertEnrollmentToken provides a mock function with given fields: t
+func (_m *Store) InsertEnrollmentToken(t *datastore.EnrollmentToken) error {
+	ret := _m.Called(t)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*datastore.EnrollmentToken) error); ok {
+		r0 = rf(t)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InsertMailboxMessage provides a mock function with given fields: m
+func (_m *Store) InsertMailboxMessage(m *datastore.MailboxMessage) error {
+	ret := _m.Called(m)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*datastore.MailboxMessage) error); ok {
+		r0 = rf(m)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // InsertPresentation provides a mock function with given fields: p
 func (_m *Store) InsertPresentation(p *datastore.Presentation) (string, error) {
 	ret := _m.Called(p)
@@ -769,6 +1102,29 @@ func (_m *Store) ListAgentConnections(a *datastore.Agent) ([]*datastore.AgentCon
 	return r0, r1
 }
 
+// ListAuditEvents provides a mock function with given fields: c
+func (_m *Store) ListAuditEvents(c *datastore.AuditEventCriteria) (*datastore.AuditEventList, error) {
+	ret := _m.Called(c)
+
+	var r0 *datastore.AuditEventList
+	if rf, ok := ret.Get(0).(func(*datastore.AuditEventCriteria) *datastore.AuditEventList); ok {
+		r0 = rf(c)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datastore.AuditEventList)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*datastore.AuditEventCriteria) error); ok {
+		r1 = rf(c)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListCloudAgentConnections provides a mock function with given fields: a
 func (_m *Store) ListCloudAgentConnections(a *datastore.CloudAgent) ([]*datastore.CloudAgentConnection, error) {
 	ret := _m.Called(a)
@@ -815,6 +1171,29 @@ func (_m *Store) ListCloudAgentCredentials(a *datastore.CloudAgent) ([]*datastor
 	return r0, r1
 }
 
+// ListCloudAgentProofRequests provides a mock function with given fields: a
+func (_m *Store) ListCloudAgentProofRequests(a *datastore.CloudAgent) ([]*datastore.CloudAgentProofRequest, error) {
+	ret := _m.Called(a)
+
+	var r0 []*datastore.CloudAgentProofRequest
+	if rf, ok := ret.Get(0).(func(*datastore.CloudAgent) []*datastore.CloudAgentProofRequest); ok {
+		r0 = rf(a)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*datastore.CloudAgentProofRequest)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*datastore.CloudAgent) error); ok {
+		r1 = rf(a)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListDIDs provides a mock function with given fields: c
 func (_m *Store) ListDIDs(c *datastore.DIDCriteria) (*datastore.DIDList, error) {
 	ret := _m.Called(c)
@@ -838,6 +1217,75 @@ func (_m *Store) ListDIDs(c *datastore.DIDCriteria) (*datastore.DIDList, error)
 	return r0, r1
 }
 
+// ListEdgeAgents provides a mock function with given fields:
+func (_m *Store) ListEdgeAgents() ([]*datastore.EdgeAgent, error) {
+	ret := _m.Called()
+
+	var r0 []*datastore.EdgeAgent
+	if rf, ok := ret.Get(0).(func() []*datastore.EdgeAgent); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*datastore.EdgeAgent)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListEndorsements provides a mock function with given fields: c
+func (_m *Store) ListEndorsements(c *datastore.EndorsementCriteria) (*datastore.EndorsementList, error) {
+	ret := _m.Called(c)
+
+	var r0 *datastore.EndorsementList
+	if rf, ok := ret.Get(0).(func(*datastore.EndorsementCriteria) *datastore.EndorsementList); ok {
+		r0 = rf(c)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datastore.EndorsementList)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*datastore.EndorsementCriteria) error); ok {
+		r1 = rf(c)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListMailboxMessages provides a mock function with given fields: edgeAgentID, limit
+func (_m *Store) ListMailboxMessages(edgeAgentID string, limit int) ([]*datastore.MailboxMessage, error) {
+	ret := _m.Called(edgeAgentID, limit)
+
+	var r0 []*datastore.MailboxMessage
+	if rf, ok := ret.Get(0).(func(string, int) []*datastore.MailboxMessage); ok {
+		r0 = rf(edgeAgentID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*datastore.MailboxMessage)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, int) error); ok {
+		r1 = rf(edgeAgentID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListSchema provides a mock function with given fields: c
 func (_m *Store) ListSchema(c *datastore.SchemaCriteria) (*datastore.SchemaList, error) {
 	ret := _m.Called(c)
@@ -884,20 +1332,41 @@ func (_m *Store) ListWebhooks(typ string) ([]*datastore.Webhook, error) {
 	return r0, r1
 }
 
-// RegisterCloudAgent provides a mock function with given fields: externalID, publicKey, nextKey
-func (_m *Store) RegisterCloudAgent(externalID string, publicKey []byte, nextKey []byte) (string, error) {
-	ret := _m.Called(externalID, publicKey, nextKey)
+// PruneMailbox provides a mock function with given fields: edgeAgentID, before, max
+func (_m *Store) PruneMailbox(edgeAgentID string, before time.Time, max int) (int, error) {
+	ret := _m.Called(edgeAgentID, before, max)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(string, time.Time, int) int); ok {
+		r0 = rf(edgeAgentID, before, max)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, time.Time, int) error); ok {
+		r1 = rf(edgeAgentID, before, max)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RegisterCloudAgent provides a mock function with given fields: externalID, publicKey, nextKey, pushToken
+func (_m *Store) RegisterCloudAgent(externalID string, publicKey []byte, nextKey []byte, pushToken string) (string, error) {
+	ret := _m.Called(externalID, publicKey, nextKey, pushToken)
 
 	var r0 string
-	if rf, ok := ret.Get(0).(func(string, []byte, []byte) string); ok {
-		r0 = rf(externalID, publicKey, nextKey)
+	if rf, ok := ret.Get(0).(func(string, []byte, []byte, string) string); ok {
+		r0 = rf(externalID, publicKey, nextKey, pushToken)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, []byte, []byte) error); ok {
-		r1 = rf(externalID, publicKey, nextKey)
+	if rf, ok := ret.Get(1).(func(string, []byte, []byte, string) error); ok {
+		r1 = rf(externalID, publicKey, nextKey, pushToken)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -905,20 +1374,20 @@ func (_m *Store) RegisterCloudAgent(externalID string, publicKey []byte, nextKey
 	return r0, r1
 }
 
-// RegisterEdgeAgent provides a mock function with given fields: connectionID, externalID
-func (_m *Store) RegisterEdgeAgent(connectionID string, externalID string) (string, error) {
-	ret := _m.Called(connectionID, externalID)
+// RegisterEdgeAgent provides a mock function with given fields: connectionID, externalID, pushToken
+func (_m *Store) RegisterEdgeAgent(connectionID string, externalID string, pushToken string) (string, error) {
+	ret := _m.Called(connectionID, externalID, pushToken)
 
 	var r0 string
-	if rf, ok := ret.Get(0).(func(string, string) string); ok {
-		r0 = rf(connectionID, externalID)
+	if rf, ok := ret.Get(0).(func(string, string, string) string); ok {
+		r0 = rf(connectionID, externalID, pushToken)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, string) error); ok {
-		r1 = rf(connectionID, externalID)
+	if rf, ok := ret.Get(1).(func(string, string, string) error); ok {
+		r1 = rf(connectionID, externalID, pushToken)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -954,6 +1423,20 @@ func (_m *Store) SetPublicDID(DID *datastore.DID) error {
 	return r0
 }
 
+// SetTAAAcceptance provides a mock function with given fields: a
+func (_m *Store) SetTAAAcceptance(a *datastore.TAAAcceptance) error {
+	ret := _m.Called(a)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*datastore.TAAAcceptance) error); ok {
+		r0 = rf(a)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateAgent provides a mock function with given fields: a
 func (_m *Store) UpdateAgent(a *datastore.Agent) error {
 	ret := _m.Called(a)
@@ -1010,6 +1493,20 @@ func (_m *Store) UpdateCloudAgentCredential(cred *datastore.CloudAgentCredential
 	return r0
 }
 
+// UpdateCloudAgentProofRequest provides a mock function with given fields: cred
+func (_m *Store) UpdateCloudAgentProofRequest(cred *datastore.CloudAgentProofRequest) error {
+	ret := _m.Called(cred)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*datastore.CloudAgentProofRequest) error); ok {
+		r0 = rf(cred)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateCredential provides a mock function with given fields: c
 func (_m *Store) UpdateCredential(c *datastore.IssuedCredential) error {
 	ret := _m.Called(c)
@@ -1038,6 +1535,20 @@ func (_m *Store) UpdateEdgeAgent(ea *datastore.EdgeAgent) error {
 	return r0
 }
 
+// UpdateEndorsement provides a mock function with given fields: e
+func (_m *Store) UpdateEndorsement(e *datastore.Endorsement) error {
+	ret := _m.Called(e)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*datastore.Endorsement) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateSchema provides a mock function with given fields: s
 func (_m *Store) UpdateSchema(s *datastore.Schema) error {
 	ret := _m.Called(s)
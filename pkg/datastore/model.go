@@ -34,6 +34,8 @@ type Agent struct {
 	PID                   string
 	HasPublicDID          bool
 	PublicDID             *DID
+	PublicDIDMethod       string
+	Ledger                string
 }
 
 func (r *Agent) CanIssue(schemaID string) bool {
@@ -79,6 +81,7 @@ type Schema struct {
 	ExternalSchemaID string
 	Context          []string
 	Attributes       []*Attribute
+	Ledger           string
 }
 
 type Schemas []*Schema
@@ -96,6 +99,13 @@ type DID struct {
 	KeyPair  *KeyPair
 	Endpoint string
 	Public   bool
+	Ledger   string
+	// RetiredKeys are keys replaced by a rotation, kept until they expire so in-flight messages
+	// packed for them can still be unpacked
+	RetiredKeys []*RetiredKey
+	// PendingKey is the key a rotation is replacing KeyPair with, saved before the ledger is updated so an
+	// interrupted rotation can be completed
+	PendingKey *KeyPair
 }
 
 type DIDList struct {
@@ -113,6 +123,11 @@ func (r *KeyPair) RawPublicKey() []byte {
 	return k
 }
 
+type RetiredKey struct {
+	KeyPair *KeyPair
+	Expires time.Time
+}
+
 type Offer struct {
 	Comment string
 	Type    string
@@ -166,17 +181,30 @@ type Presentation struct {
 }
 
 type EdgeAgent struct {
-	ID           string
-	TheirDID     string
-	MyDID        string
-	ConnectionID string
-	ExternalID   string
+	ID                  string
+	TheirDID            string
+	MyDID               string
+	ConnectionID        string
+	DIDCommConnectionID string
+	ExternalID          string
+	RecipientKeys       []string
+	RoutingKeys         []string
+	PushToken           string
+}
+
+// MailboxMessage is a packed message queued by the mediator for an edge agent to pick up
+type MailboxMessage struct {
+	ID          string
+	EdgeAgentID string
+	Message     []byte
+	Received    time.Time
 }
 
 type CloudAgent struct {
 	ID        string
 	PublicKey []byte
 	NextKey   []byte
+	PushToken string
 }
 
 type CloudAgentConnection struct {
@@ -204,6 +232,7 @@ type CloudAgentCredential struct {
 	Credential                *Credential
 	CredentialRequest         *CredentialRequest
 	CredentialRequestMetadata *CredentialRequestMetadata
+	Replaces                  string
 }
 
 type CloudAgentProofRequest struct {
@@ -235,3 +264,76 @@ type CredentialRequestMetadata struct {
 	Nonce                    string `json:"nonce"`
 	MasterSecretName         string `json:"master_secret_name"`
 }
+
+type AuditEvent struct {
+	Sequence   int64
+	Timestamp  time.Time
+	Caller     string
+	RPC        string
+	AgentName  string
+	ExternalID string
+	Outcome    string
+	Error      string
+	PrevHash   string
+	Hash       string
+}
+
+// TAAAcceptance records which transaction author agreement was accepted, how and when.  Time is in
+// seconds since the epoch, truncated to the day as the ledger requires
+type TAAAcceptance struct {
+	Version    string
+	Text       string
+	Digest     string
+	Mechanism  string
+	Time       int64
+	AcceptedBy string
+	Ledger     string
+}
+
+const (
+	EndorsementPending   = "pending"
+	EndorsementCompleted = "completed"
+	EndorsementRejected  = "rejected"
+)
+
+// Endorsement is a ledger transaction signed by its author and waiting on an external endorser
+type Endorsement struct {
+	ID          string
+	TxnType     string
+	AuthorDID   string
+	EndorserDID string
+	Request     string
+	Status      string
+	Ledger      string
+	Created     time.Time
+	Updated     time.Time
+}
+
+type EndorsementCriteria struct {
+	Status          string
+	Start, PageSize int
+}
+
+type EndorsementList struct {
+	Count        int
+	Endorsements []*Endorsement
+}
+
+type AuditEventCriteria struct {
+	Start, PageSize int
+	From, To        time.Time
+}
+
+type AuditEventList struct {
+	Count  int
+	Events []*AuditEvent
+}
+
+// EnrollmentToken lets one agent register for an external ID before it expires.  The ID is a hash of the token
+type EnrollmentToken struct {
+	ID         string
+	AgentType  string
+	ExternalID string
+	Created    time.Time
+	Expires    time.Time
+}
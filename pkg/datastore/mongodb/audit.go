@@ -0,0 +1,105 @@
+/*
+Copyright Scoir Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package mongodb
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/scoir/canis/pkg/datastore"
+)
+
+func (r *mongoDBStore) ensureAuditIndex() error {
+	_, err := r.db.Collection(AuditEventC).Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys:    bson.D{{Key: "sequence", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return errors.Wrap(err, "unable to create audit event sequence index")
+	}
+
+	return nil
+}
+
+// InsertAuditEvent appends an event to the audit log, the unique sequence index prevents two
+// writers from extending the chain from the same link
+func (r *mongoDBStore) InsertAuditEvent(e *datastore.AuditEvent) error {
+	_, err := r.db.Collection(AuditEventC).InsertOne(context.Background(), e)
+	if err != nil {
+		return errors.Wrap(err, "unable to insert audit event")
+	}
+
+	return nil
+}
+
+// GetLastAuditEvent returns the most recently appended audit event, or nil if the log is empty
+func (r *mongoDBStore) GetLastAuditEvent() (*datastore.AuditEvent, error) {
+	e := &datastore.AuditEvent{}
+
+	opts := options.FindOne().SetSort(bson.M{"sequence": -1})
+	err := r.db.Collection(AuditEventC).FindOne(context.Background(), bson.M{}, opts).Decode(e)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to load last audit event")
+	}
+
+	return e, nil
+}
+
+// ListAuditEvents query audit events in sequence order
+func (r *mongoDBStore) ListAuditEvents(c *datastore.AuditEventCriteria) (*datastore.AuditEventList, error) {
+	if c == nil {
+		c = &datastore.AuditEventCriteria{
+			Start:    0,
+			PageSize: 10,
+		}
+	}
+
+	bc := bson.M{}
+	ts := bson.M{}
+	if !c.From.IsZero() {
+		ts["$gte"] = c.From
+	}
+	if !c.To.IsZero() {
+		ts["$lt"] = c.To
+	}
+	if len(ts) > 0 {
+		bc["timestamp"] = ts
+	}
+
+	opts := &options.FindOptions{}
+	opts = opts.SetSkip(int64(c.Start)).SetLimit(int64(c.PageSize)).SetSort(bson.M{"sequence": 1})
+
+	ctx := context.Background()
+	count, err := r.db.Collection(AuditEventC).CountDocuments(ctx, bc)
+	if err != nil {
+		return nil, errors.Wrap(err, "error trying to count audit events")
+	}
+
+	results, err := r.db.Collection(AuditEventC).Find(ctx, bc, opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "error trying to find audit events")
+	}
+
+	out := datastore.AuditEventList{
+		Count:  int(count),
+		Events: []*datastore.AuditEvent{},
+	}
+
+	err = results.All(ctx, &out.Events)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to decode audit events")
+	}
+
+	return &out, nil
+}
@@ -11,11 +11,12 @@ import (
 	"github.com/scoir/canis/pkg/datastore"
 )
 
-func (r *mongoDBStore) RegisterCloudAgent(externalID string, publicKey, nextKey []byte) (string, error) {
+func (r *mongoDBStore) RegisterCloudAgent(externalID string, publicKey, nextKey []byte, pushToken string) (string, error) {
 	a := &datastore.CloudAgent{
 		ID:        uuid.New().String(),
 		PublicKey: publicKey,
 		NextKey:   nextKey,
+		PushToken: pushToken,
 	}
 
 	_, err := r.db.Collection(CloudAgentC).InsertOne(context.Background(), a)
@@ -50,7 +51,7 @@ func (r *mongoDBStore) GetCloudAgentForDID(myDID string) (*datastore.CloudAgent,
 }
 
 func (r *mongoDBStore) UpdateCloudAgent(a *datastore.CloudAgent) error {
-	_, err := r.db.Collection(CloudAgentC).UpdateOne(context.Background(), bson.M{"_id": a.ID}, bson.M{"$set": a})
+	_, err := r.db.Collection(CloudAgentC).UpdateOne(context.Background(), bson.M{"id": a.ID}, bson.M{"$set": a})
 	if err != nil {
 		return errors.Wrap(err, "unable to update cloud agent")
 	}
@@ -123,9 +124,9 @@ func (r *mongoDBStore) ListCloudAgentConnections(a *datastore.CloudAgent) ([]*da
 	return ac, nil
 }
 
-func (r *mongoDBStore) DeleteCloudAgentConnection(a *datastore.CloudAgent, externalID string) error {
+func (r *mongoDBStore) DeleteCloudAgentConnection(a *datastore.CloudAgent, connectionID string) error {
 	_, err := r.db.Collection(CloudAgentConnectionC).DeleteMany(context.Background(),
-		bson.M{"cloudagentid": a.ID, "externalid": externalID})
+		bson.M{"cloudagentid": a.ID, "connectionid": connectionID})
 
 	if err != nil {
 		return errors.Wrap(err, "unable to delete cloud agent connection")
@@ -134,6 +135,17 @@ func (r *mongoDBStore) DeleteCloudAgentConnection(a *datastore.CloudAgent, exter
 	return nil
 }
 
+func (r *mongoDBStore) DeleteCloudAgentInvitation(a *datastore.CloudAgent, invitationID string) error {
+	_, err := r.db.Collection(CloudAgentConnectionC).DeleteOne(context.Background(),
+		bson.M{"cloudagentid": a.ID, "invitationid": invitationID, "connectionid": ""})
+
+	if err != nil {
+		return errors.Wrap(err, "unable to delete cloud agent invitation")
+	}
+
+	return nil
+}
+
 func (r *mongoDBStore) GetCloudAgentConnection(a *datastore.CloudAgent, invitationID string) (*datastore.CloudAgentConnection, error) {
 	ac := &datastore.CloudAgentConnection{}
 	err := r.db.Collection(CloudAgentConnectionC).FindOne(context.Background(),
@@ -0,0 +1,98 @@
+/*
+Copyright Scoir Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/scoir/canis/pkg/datastore"
+)
+
+// InsertEndorsement tracks a ledger transaction waiting on an external endorser
+func (r *mongoDBStore) InsertEndorsement(e *datastore.Endorsement) (string, error) {
+	e.ID = uuid.New().String()
+	e.Created = time.Now().UTC().Truncate(time.Millisecond)
+	e.Updated = e.Created
+
+	_, err := r.db.Collection(EndorsementC).InsertOne(context.Background(), e)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to insert endorsement")
+	}
+
+	return e.ID, nil
+}
+
+// GetEndorsement return single endorsement
+func (r *mongoDBStore) GetEndorsement(id string) (*datastore.Endorsement, error) {
+	e := &datastore.Endorsement{}
+
+	err := r.db.Collection(EndorsementC).FindOne(context.Background(), bson.M{"id": id}).Decode(e)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to load endorsement")
+	}
+
+	return e, nil
+}
+
+// UpdateEndorsement updates the status of an endorsement
+func (r *mongoDBStore) UpdateEndorsement(e *datastore.Endorsement) error {
+	e.Updated = time.Now().UTC().Truncate(time.Millisecond)
+
+	_, err := r.db.Collection(EndorsementC).UpdateOne(context.Background(), bson.M{"id": e.ID}, bson.M{"$set": e})
+	if err != nil {
+		return errors.Wrap(err, "unable to update endorsement")
+	}
+
+	return nil
+}
+
+// ListEndorsements query endorsements, oldest first
+func (r *mongoDBStore) ListEndorsements(c *datastore.EndorsementCriteria) (*datastore.EndorsementList, error) {
+	if c == nil {
+		c = &datastore.EndorsementCriteria{
+			Start:    0,
+			PageSize: 10,
+		}
+	}
+
+	bc := bson.M{}
+	if c.Status != "" {
+		bc["status"] = c.Status
+	}
+
+	opts := &options.FindOptions{}
+	opts = opts.SetSkip(int64(c.Start)).SetLimit(int64(c.PageSize)).SetSort(bson.M{"created": 1})
+
+	ctx := context.Background()
+	count, err := r.db.Collection(EndorsementC).CountDocuments(ctx, bc)
+	if err != nil {
+		return nil, errors.Wrap(err, "error trying to count endorsements")
+	}
+
+	results, err := r.db.Collection(EndorsementC).Find(ctx, bc, opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "error trying to find endorsements")
+	}
+
+	out := datastore.EndorsementList{
+		Count:        int(count),
+		Endorsements: []*datastore.Endorsement{},
+	}
+
+	err = results.All(ctx, &out.Endorsements)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to decode endorsements")
+	}
+
+	return &out, nil
+}
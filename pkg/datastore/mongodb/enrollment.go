@@ -0,0 +1,56 @@
+/*
+Copyright Scoir Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/scoir/canis/pkg/datastore"
+)
+
+// ensureEnrollmentIndex has MongoDB remove enrollment tokens once they expire
+func (r *mongoDBStore) ensureEnrollmentIndex() error {
+	_, err := r.db.Collection(EnrollmentTokenC).Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys:    bson.D{{Key: "expires", Value: 1}},
+		Options: options.Index().SetExpireAfterSeconds(0),
+	})
+	if err != nil {
+		return errors.Wrap(err, "unable to create enrollment token expiry index")
+	}
+
+	return nil
+}
+
+// InsertEnrollmentToken saves a token an agent can register with
+func (r *mongoDBStore) InsertEnrollmentToken(t *datastore.EnrollmentToken) error {
+	_, err := r.db.Collection(EnrollmentTokenC).InsertOne(context.Background(), t)
+	if err != nil {
+		return errors.Wrap(err, "unable to insert enrollment token")
+	}
+
+	return nil
+}
+
+// ConsumeEnrollmentToken removes and returns a token that has not expired, the find and delete is atomic so
+// concurrent registrations can not both use it
+func (r *mongoDBStore) ConsumeEnrollmentToken(id string, now time.Time) (*datastore.EnrollmentToken, error) {
+	t := &datastore.EnrollmentToken{}
+
+	err := r.db.Collection(EnrollmentTokenC).FindOneAndDelete(context.Background(),
+		bson.M{"id": id, "expires": bson.M{"$gt": now}}).Decode(t)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to find enrollment token")
+	}
+
+	return t, nil
+}
@@ -0,0 +1,112 @@
+/*
+Copyright Scoir Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/scoir/canis/pkg/datastore"
+)
+
+// InsertMailboxMessage queues a message for an edge agent
+func (r *mongoDBStore) InsertMailboxMessage(m *datastore.MailboxMessage) error {
+	_, err := r.db.Collection(MailboxMessageC).InsertOne(context.Background(), m)
+	if err != nil {
+		return errors.Wrap(err, "unable to insert mailbox message")
+	}
+
+	return nil
+}
+
+// ListMailboxMessages returns up to limit messages queued for an edge agent, oldest first
+func (r *mongoDBStore) ListMailboxMessages(edgeAgentID string, limit int) ([]*datastore.MailboxMessage, error) {
+	ctx := context.Background()
+	opts := options.Find().SetSort(bson.M{"received": 1}).SetLimit(int64(limit))
+
+	results, err := r.db.Collection(MailboxMessageC).Find(ctx, bson.M{"edgeagentid": edgeAgentID}, opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list mailbox messages")
+	}
+
+	out := []*datastore.MailboxMessage{}
+	err = results.All(ctx, &out)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to decode mailbox messages")
+	}
+
+	return out, nil
+}
+
+// CountMailboxMessages returns the number of messages queued for an edge agent
+func (r *mongoDBStore) CountMailboxMessages(edgeAgentID string) (int, error) {
+	count, err := r.db.Collection(MailboxMessageC).CountDocuments(context.Background(), bson.M{"edgeagentid": edgeAgentID})
+	if err != nil {
+		return 0, errors.Wrap(err, "unable to count mailbox messages")
+	}
+
+	return int(count), nil
+}
+
+// DeleteMailboxMessages removes picked up messages from an edge agent's queue
+func (r *mongoDBStore) DeleteMailboxMessages(edgeAgentID string, ids []string) (int, error) {
+	res, err := r.db.Collection(MailboxMessageC).DeleteMany(context.Background(),
+		bson.M{"edgeagentid": edgeAgentID, "id": bson.M{"$in": ids}})
+	if err != nil {
+		return 0, errors.Wrap(err, "unable to delete mailbox messages")
+	}
+
+	return int(res.DeletedCount), nil
+}
+
+// PruneMailbox drops messages received before the cutoff and then the oldest messages beyond max.  A zero
+// cutoff or max skips that limit
+func (r *mongoDBStore) PruneMailbox(edgeAgentID string, before time.Time, max int) (int, error) {
+	var pruned int
+	if !before.IsZero() {
+		res, err := r.db.Collection(MailboxMessageC).DeleteMany(context.Background(),
+			bson.M{"edgeagentid": edgeAgentID, "received": bson.M{"$lt": before}})
+		if err != nil {
+			return 0, errors.Wrap(err, "unable to prune expired mailbox messages")
+		}
+		pruned += int(res.DeletedCount)
+	}
+
+	if max <= 0 {
+		return pruned, nil
+	}
+
+	count, err := r.CountMailboxMessages(edgeAgentID)
+	if err != nil {
+		return pruned, err
+	}
+
+	if count <= max {
+		return pruned, nil
+	}
+
+	oldest, err := r.ListMailboxMessages(edgeAgentID, count-max)
+	if err != nil {
+		return pruned, err
+	}
+
+	ids := make([]string, len(oldest))
+	for i, m := range oldest {
+		ids[i] = m.ID
+	}
+
+	n, err := r.DeleteMailboxMessages(edgeAgentID, ids)
+	if err != nil {
+		return pruned, errors.Wrap(err, "unable to prune mailbox overflow")
+	}
+
+	return pruned + n, nil
+}
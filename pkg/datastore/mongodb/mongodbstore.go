@@ -40,6 +40,11 @@ const (
 	CloudAgentConnectionC   = "CloudAgentConnection"
 	CloudAgentCredentialC   = "CloudAgentCredential"
 	CloudAgentProofRequestC = "CloudAgentProofRequest"
+	AuditEventC             = "AuditEvent"
+	TAAAcceptanceC          = "TAAAcceptance"
+	EndorsementC            = "Endorsement"
+	MailboxMessageC         = "MailboxMessage"
+	EnrollmentTokenC        = "EnrollmentToken"
 )
 
 type Config struct {
@@ -105,6 +110,16 @@ func (r *Provider) Open() (datastore.Store, error) {
 		db:     db,
 	}
 
+	err := theStore.ensureAuditIndex()
+	if err != nil {
+		return nil, err
+	}
+
+	err = theStore.ensureEnrollmentIndex()
+	if err != nil {
+		return nil, err
+	}
+
 	r.store = theStore
 
 	return theStore, nil
@@ -183,10 +198,10 @@ func (r *mongoDBStore) ListDIDs(c *datastore.DIDCriteria) (*datastore.DIDList, e
 	return &out, nil
 }
 
-// SetPublicDID update single DID to public, unset remaining
+// SetPublicDID update single DID to public for its ledger, unset remaining on that ledger
 func (r *mongoDBStore) SetPublicDID(d *datastore.DID) error {
 	ctx := context.Background()
-	_, err := r.db.Collection(PublicDIDC).DeleteMany(ctx, bson.M{})
+	_, err := r.db.Collection(PublicDIDC).DeleteMany(ctx, ledgerFilter(d.Ledger))
 	if err != nil {
 		return errors.Wrap(err, "unable to unset public DID")
 	}
@@ -201,11 +216,11 @@ func (r *mongoDBStore) SetPublicDID(d *datastore.DID) error {
 	return nil
 }
 
-// GetPublicDID get public DID
+// GetPublicDID get public DID on the default ledger
 func (r *mongoDBStore) GetPublicDID() (*datastore.DID, error) {
 	out := &datastore.DID{}
 
-	err := r.db.Collection(PublicDIDC).FindOne(context.Background(), bson.M{}).Decode(out)
+	err := r.db.Collection(PublicDIDC).FindOne(context.Background(), ledgerFilter("")).Decode(out)
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to find public PeerDID")
 	}
@@ -213,6 +228,28 @@ func (r *mongoDBStore) GetPublicDID() (*datastore.DID, error) {
 	return out, nil
 }
 
+// GetLedgerPublicDID get public DID on the ledger with namespace, empty for the default ledger
+func (r *mongoDBStore) GetLedgerPublicDID(ledger string) (*datastore.DID, error) {
+	out := &datastore.DID{}
+
+	err := r.db.Collection(PublicDIDC).FindOne(context.Background(), ledgerFilter(ledger)).Decode(out)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to find public DID for ledger %s", ledger)
+	}
+
+	return out, nil
+}
+
+// ledgerFilter matches documents for a ledger namespace, documents saved before ledgers were named
+// belong to the default ledger
+func ledgerFilter(ledger string) bson.M {
+	if ledger == "" {
+		return bson.M{"ledger": bson.M{"$in": bson.A{"", nil}}}
+	}
+
+	return bson.M{"ledger": ledger}
+}
+
 // InsertSchema add Schema to store
 func (r *mongoDBStore) InsertSchema(s *datastore.Schema) (string, error) {
 	_, err := r.db.Collection(SchemaC).InsertOne(context.Background(), s)
@@ -540,18 +577,20 @@ func (r *mongoDBStore) GetPresentationRequest(ID string) (*datastore.Presentatio
 	return pr, nil
 }
 
-func (r *mongoDBStore) RegisterEdgeAgent(connectionID, externalID string) (string, error) {
+func (r *mongoDBStore) RegisterEdgeAgent(connectionID, externalID, pushToken string) (string, error) {
 	ea := &datastore.EdgeAgent{
+		ID:           primitive.NewObjectID().Hex(),
 		ConnectionID: connectionID,
 		ExternalID:   externalID,
+		PushToken:    pushToken,
 	}
 
-	res, err := r.db.Collection(EdgeAgentC).InsertOne(context.Background(), ea)
+	_, err := r.db.Collection(EdgeAgentC).InsertOne(context.Background(), ea)
 	if err != nil {
 		return "", err
 	}
 
-	return res.InsertedID.(primitive.ObjectID).Hex(), nil
+	return ea.ID, nil
 }
 
 func (r *mongoDBStore) GetEdgeAgent(connectionID string) (*datastore.EdgeAgent, error) {
@@ -576,6 +615,44 @@ func (r *mongoDBStore) GetEdgeAgentForDID(theirDID string) (*datastore.EdgeAgent
 	return ea, nil
 }
 
+func (r *mongoDBStore) GetEdgeAgentForKey(key string) (*datastore.EdgeAgent, error) {
+	ea := &datastore.EdgeAgent{}
+
+	err := r.db.Collection(EdgeAgentC).FindOne(context.Background(), bson.M{"recipientkeys": key}).Decode(ea)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to find edge agent by key")
+	}
+
+	return ea, nil
+}
+
+func (r *mongoDBStore) GetEdgeAgentForRoutingKey(key string) (*datastore.EdgeAgent, error) {
+	ea := &datastore.EdgeAgent{}
+
+	err := r.db.Collection(EdgeAgentC).FindOne(context.Background(), bson.M{"routingkeys": key}).Decode(ea)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to find edge agent by routing key")
+	}
+
+	return ea, nil
+}
+
+func (r *mongoDBStore) ListEdgeAgents() ([]*datastore.EdgeAgent, error) {
+	ctx := context.Background()
+	results, err := r.db.Collection(EdgeAgentC).Find(ctx, bson.M{})
+	if err != nil {
+		return nil, errors.Wrap(err, "error trying to find edge agents")
+	}
+
+	out := []*datastore.EdgeAgent{}
+	err = results.All(ctx, &out)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to decode edge agents")
+	}
+
+	return out, nil
+}
+
 func (r *mongoDBStore) UpdateEdgeAgent(ea *datastore.EdgeAgent) error {
 	_, err := r.db.Collection(EdgeAgentC).UpdateOne(context.Background(),
 		bson.M{"externalid": ea.ExternalID, "connectionid": ea.ConnectionID}, bson.M{"$set": ea})
@@ -193,6 +193,36 @@ func TestSetGetPublicDID(t *testing.T) {
 		err = prov.Close()
 		require.NoError(t, err)
 	})
+
+	t.Run("Test public did per ledger", func(t *testing.T) {
+		conf := testConfig()
+		prov, err := NewProvider(conf)
+		defer dropTestDatabase(conf.Database)
+		require.NoError(t, err)
+
+		store, err := prov.Open()
+		require.NoError(t, err)
+
+		for _, ledger := range []string{"", "sovrin", "sovrin:staging"} {
+			err = store.SetPublicDID(&datastore.DID{DID: &identifiers.DID{
+				DIDVal: identifiers.DIDValue{
+					MethodSpecificID: "public" + ledger,
+				},
+			}, Ledger: ledger})
+			require.NoError(t, err)
+		}
+
+		public, err := store.GetLedgerPublicDID("sovrin")
+		require.NoError(t, err)
+		require.Equal(t, "publicsovrin", public.DID.DIDVal.MethodSpecificID)
+
+		public, err = store.GetLedgerPublicDID("")
+		require.NoError(t, err)
+		require.Equal(t, "public", public.DID.DIDVal.MethodSpecificID)
+
+		_, err = store.GetLedgerPublicDID("bcovrin")
+		require.Error(t, err)
+	})
 }
 
 func TestSchema(t *testing.T) {
@@ -640,13 +670,14 @@ func TestEdgeAgent(t *testing.T) {
 	store, err := prov.Open()
 	require.NoError(t, err)
 
-	id, err := store.RegisterEdgeAgent("test-connect-id", "test-external-id")
+	id, err := store.RegisterEdgeAgent("test-connect-id", "test-external-id", "test-push-token")
 	require.NoError(t, err)
 	require.NotEmpty(t, id)
 
 	ae, err := store.GetEdgeAgent("test-connect-id")
 	require.NoError(t, err)
 	require.NotNil(t, ae)
+	require.Equal(t, "test-push-token", ae.PushToken)
 
 	ae.TheirDID = "did:sov:abc"
 	err = store.UpdateEdgeAgent(ae)
@@ -694,3 +725,172 @@ func TestMediator(t *testing.T) {
 	require.Equal(t, "did:sov:abc", did.ID)
 
 }
+
+func TestCloudAgent(t *testing.T) {
+	conf := testConfig()
+	prov, err := NewProvider(conf)
+	defer dropTestDatabase(conf.Database)
+	require.NoError(t, err)
+
+	store, err := prov.Open()
+	require.NoError(t, err)
+
+	id, err := store.RegisterCloudAgent("test-external-id", []byte("public-key"), []byte("next-key"), "test-push-token")
+	require.NoError(t, err)
+	require.NotEmpty(t, id)
+
+	a, err := store.GetCloudAgent(id)
+	require.NoError(t, err)
+	require.Equal(t, []byte("public-key"), a.PublicKey)
+	require.Equal(t, "test-push-token", a.PushToken)
+
+	a.PublicKey = []byte("rotated-key")
+	a.NextKey = []byte("rotated-next-key")
+	err = store.UpdateCloudAgent(a)
+	require.NoError(t, err)
+
+	a, err = store.GetCloudAgent(id)
+	require.NoError(t, err)
+	require.Equal(t, []byte("rotated-key"), a.PublicKey)
+	require.Equal(t, []byte("rotated-next-key"), a.NextKey)
+}
+
+func TestAuditEvents(t *testing.T) {
+	conf := testConfig()
+	prov, err := NewProvider(conf)
+	defer dropTestDatabase(conf.Database)
+	require.NoError(t, err)
+
+	store, err := prov.Open()
+	require.NoError(t, err)
+
+	e, err := store.GetLastAuditEvent()
+	require.NoError(t, err)
+	require.Nil(t, e)
+
+	start := time.Date(2020, 11, 1, 0, 0, 0, 0, time.UTC)
+	for i := 1; i <= 5; i++ {
+		err = store.InsertAuditEvent(&datastore.AuditEvent{
+			Sequence:  int64(i),
+			Timestamp: start.Add(time.Duration(i) * time.Hour),
+			RPC:       "CreateAgent",
+			Hash:      fmt.Sprintf("hash-%d", i),
+		})
+		require.NoError(t, err)
+	}
+
+	err = store.InsertAuditEvent(&datastore.AuditEvent{Sequence: 5})
+	require.Error(t, err)
+
+	e, err = store.GetLastAuditEvent()
+	require.NoError(t, err)
+	require.Equal(t, int64(5), e.Sequence)
+	require.Equal(t, "hash-5", e.Hash)
+
+	list, err := store.ListAuditEvents(&datastore.AuditEventCriteria{
+		PageSize: 10,
+		From:     start.Add(2 * time.Hour),
+		To:       start.Add(5 * time.Hour),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, list.Count)
+	require.Len(t, list.Events, 3)
+	require.Equal(t, int64(2), list.Events[0].Sequence)
+	require.Equal(t, int64(4), list.Events[2].Sequence)
+}
+
+func TestTAAAcceptance(t *testing.T) {
+	conf := testConfig()
+	prov, err := NewProvider(conf)
+	defer dropTestDatabase(conf.Database)
+	require.NoError(t, err)
+
+	store, err := prov.Open()
+	require.NoError(t, err)
+
+	a, err := store.GetTAAAcceptance("")
+	require.Error(t, err)
+	require.Nil(t, a)
+
+	for _, version := range []string{"1.0", "2.0"} {
+		err = store.SetTAAAcceptance(&datastore.TAAAcceptance{
+			Version:   version,
+			Text:      "agreement text",
+			Digest:    "digest-" + version,
+			Mechanism: "service_agreement",
+			Time:      1604188800,
+		})
+		require.NoError(t, err)
+	}
+
+	a, err = store.GetTAAAcceptance("")
+	require.NoError(t, err)
+	require.Equal(t, "2.0", a.Version)
+	require.Equal(t, "digest-2.0", a.Digest)
+	require.Equal(t, "service_agreement", a.Mechanism)
+	require.Equal(t, int64(1604188800), a.Time)
+
+	err = store.SetTAAAcceptance(&datastore.TAAAcceptance{
+		Version:   "3.0",
+		Digest:    "digest-3.0",
+		Mechanism: "wallet_agreement",
+		Ledger:    "sovrin:staging",
+	})
+	require.NoError(t, err)
+
+	a, err = store.GetTAAAcceptance("sovrin:staging")
+	require.NoError(t, err)
+	require.Equal(t, "3.0", a.Version)
+
+	a, err = store.GetTAAAcceptance("")
+	require.NoError(t, err)
+	require.Equal(t, "2.0", a.Version)
+}
+
+func TestEndorsements(t *testing.T) {
+	conf := testConfig()
+	prov, err := NewProvider(conf)
+	defer dropTestDatabase(conf.Database)
+	require.NoError(t, err)
+
+	store, err := prov.Open()
+	require.NoError(t, err)
+
+	e, err := store.GetEndorsement("missing")
+	require.Error(t, err)
+	require.Nil(t, e)
+
+	var ids []string
+	for i := 0; i < 3; i++ {
+		id, err := store.InsertEndorsement(&datastore.Endorsement{
+			TxnType:     "101",
+			AuthorDID:   "author",
+			EndorserDID: "endorser",
+			Request:     fmt.Sprintf(`{"reqId":%d}`, i),
+			Status:      datastore.EndorsementPending,
+		})
+		require.NoError(t, err)
+		ids = append(ids, id)
+	}
+
+	e, err = store.GetEndorsement(ids[1])
+	require.NoError(t, err)
+	require.Equal(t, `{"reqId":1}`, e.Request)
+
+	e.Status = datastore.EndorsementCompleted
+	err = store.UpdateEndorsement(e)
+	require.NoError(t, err)
+
+	list, err := store.ListEndorsements(&datastore.EndorsementCriteria{
+		Status:   datastore.EndorsementPending,
+		PageSize: 10,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, list.Count)
+	require.Equal(t, ids[0], list.Endorsements[0].ID)
+	require.Equal(t, ids[2], list.Endorsements[1].ID)
+
+	list, err = store.ListEndorsements(nil)
+	require.NoError(t, err)
+	require.Equal(t, 3, list.Count)
+}
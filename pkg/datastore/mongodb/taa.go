@@ -0,0 +1,43 @@
+/*
+Copyright Scoir Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package mongodb
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/scoir/canis/pkg/datastore"
+)
+
+// SetTAAAcceptance replaces any previous transaction author agreement acceptance for the same ledger
+func (r *mongoDBStore) SetTAAAcceptance(a *datastore.TAAAcceptance) error {
+	ctx := context.Background()
+	_, err := r.db.Collection(TAAAcceptanceC).DeleteMany(ctx, ledgerFilter(a.Ledger))
+	if err != nil {
+		return errors.Wrap(err, "unable to unset TAA acceptance")
+	}
+
+	_, err = r.db.Collection(TAAAcceptanceC).InsertOne(ctx, a)
+	if err != nil {
+		return errors.Wrap(err, "unable to insert TAA acceptance")
+	}
+
+	return nil
+}
+
+// GetTAAAcceptance returns the current transaction author agreement acceptance for a ledger
+func (r *mongoDBStore) GetTAAAcceptance(ledger string) (*datastore.TAAAcceptance, error) {
+	out := &datastore.TAAAcceptance{}
+
+	err := r.db.Collection(TAAAcceptanceC).FindOne(context.Background(), ledgerFilter(ledger)).Decode(out)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to find TAA acceptance")
+	}
+
+	return out, nil
+}
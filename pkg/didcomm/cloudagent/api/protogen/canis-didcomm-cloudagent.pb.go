@@ -1,16 +1,16 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.25.0
-// 	protoc        v3.6.1
+// 	protoc-gen-go v1.27.1
+// 	protoc        (unknown)
 // source: canis-didcomm-cloudagent.proto
 
 package api
 
 import (
 	context "context"
-	proto "github.com/golang/protobuf/proto"
 	common "github.com/scoir/canis/pkg/protogen/common"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -26,10 +26,6 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-// This is a compile-time assertion that a sufficiently up-to-date version
-// of the legacy proto package is being used.
-const _ = proto.ProtoPackageIsVersion4
-
 var File_canis_didcomm_cloudagent_proto protoreflect.FileDescriptor
 
 var file_canis_didcomm_cloudagent_proto_rawDesc = []byte{
@@ -37,110 +33,243 @@ var file_canis_didcomm_cloudagent_proto_rawDesc = []byte{
 	0x63, 0x6c, 0x6f, 0x75, 0x64, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x12, 0x07, 0x64, 0x69, 0x64, 0x63, 0x6f, 0x6d, 0x6d, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
 	0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x15, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0xc0,
-	0x07, 0x0a, 0x0a, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x74, 0x0a,
-	0x12, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x41, 0x67,
-	0x65, 0x6e, 0x74, 0x12, 0x21, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x67,
-	0x69, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
-	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x41, 0x67, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x11, 0x22, 0x0c, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73,
-	0x3a, 0x01, 0x2a, 0x12, 0x42, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69,
-	0x6e, 0x74, 0x12, 0x17, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x6e, 0x64, 0x70,
-	0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x79, 0x0a, 0x10, 0x41, 0x63, 0x63, 0x65, 0x70,
-	0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x49, 0x6e, 0x76, 0x69,
-	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x22, 0x17, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x61, 0x67,
-	0x65, 0x6e, 0x74, 0x73, 0x2f, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a,
-	0x01, 0x2a, 0x12, 0x8a, 0x01, 0x0a, 0x10, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x43, 0x72, 0x65,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x1f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
-	0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
-	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x2d, 0x22, 0x28, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73,
-	0x2f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x2f, 0x7b, 0x63, 0x72,
-	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x3a, 0x01, 0x2a, 0x12,
-	0x74, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x12, 0x1e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x22, 0x18, 0x2f, 0x63, 0x6c,
-	0x6f, 0x75, 0x64, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x74, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x72, 0x65,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x1e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x1a, 0x22, 0x18, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2f,
-	0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x7d, 0x0a, 0x11, 0x4c,
-	0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73,
-	0x12, 0x20, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72,
-	0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x21, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x22, 0x1b, 0x2f,
-	0x63, 0x6c, 0x6f, 0x75, 0x64, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x6f,
-	0x66, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x84, 0x01, 0x0a, 0x0c, 0x50,
-	0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x1b, 0x2e, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x6f,
-	0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x2e, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x33, 0x22, 0x2e,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x68, 0x74, 0x74, 0x70, 0x62, 0x6f, 0x64, 0x79, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x15, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0xcf, 0x12, 0x0a, 0x0a, 0x43, 0x6c,
+	0x6f, 0x75, 0x64, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x74, 0x0a, 0x12, 0x52, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x65, 0x72, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x21,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
+	0x43, 0x6c, 0x6f, 0x75, 0x64, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x22, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x22, 0x0c, 0x2f,
+	0x63, 0x6c, 0x6f, 0x75, 0x64, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x7c,
+	0x0a, 0x13, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x41, 0x67, 0x65,
+	0x6e, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x22, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52,
+	0x6f, 0x74, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x4b,
+	0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x41, 0x67,
+	0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x22, 0x11, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x73, 0x2f, 0x6b, 0x65, 0x79, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x42, 0x0a, 0x0b,
+	0x47, 0x65, 0x74, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x17, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x6e,
+	0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x79, 0x0a, 0x10, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x48, 0x61,
+	0x6e, 0x64, 0x6c, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x48,
+	0x61, 0x6e, 0x64, 0x6c, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x22,
+	0x17, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x69, 0x6e,
+	0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x01, 0x2a, 0x12, 0x8a, 0x01, 0x0a, 0x10,
+	0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
+	0x12, 0x1f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74,
+	0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x20, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70,
+	0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x33, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x22, 0x28, 0x2f, 0x63, 0x6c,
+	0x6f, 0x75, 0x64, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x73, 0x2f, 0x7b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x3a, 0x01, 0x2a, 0x12, 0x85, 0x01, 0x0a, 0x11, 0x50, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x20,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x43,
+	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x21, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x22, 0x20, 0x2f, 0x63, 0x6c,
+	0x6f, 0x75, 0x64, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x73, 0x3a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x3a, 0x01, 0x2a,
+	0x12, 0x7a, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x22,
+	0x18, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x69, 0x6e,
+	0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x76, 0x0a, 0x15,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x42, 0x6f, 0x64, 0x79, 0x22, 0x26, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x20, 0x22, 0x1b, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x61, 0x67, 0x65, 0x6e,
+	0x74, 0x73, 0x2f, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x71,
+	0x72, 0x3a, 0x01, 0x2a, 0x12, 0x87, 0x01, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x2a, 0x2a, 0x28, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x61, 0x67, 0x65, 0x6e,
+	0x74, 0x73, 0x2f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x9f,
+	0x01, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x24, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x33, 0x22, 0x2e, 0x2f,
+	0x63, 0x6c, 0x6f, 0x75, 0x64, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x3a, 0x01, 0x2a,
+	0x12, 0x74, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x22, 0x18, 0x2f, 0x63,
+	0x6c, 0x6f, 0x75, 0x64, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x74, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x72,
+	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x1e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1a, 0x22, 0x18, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73,
+	0x2f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x7d, 0x0a, 0x11,
+	0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x73, 0x12, 0x20, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x22, 0x1b,
 	0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x70, 0x72, 0x6f,
-	0x6f, 0x66, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f,
-	0x6f, 0x66, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x3a, 0x01,
-	0x2a, 0x42, 0x18, 0x5a, 0x16, 0x64, 0x69, 0x64, 0x63, 0x6f, 0x6d, 0x6d, 0x2f, 0x63, 0x6c, 0x6f,
-	0x75, 0x64, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2f, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x6f, 0x66, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x8e, 0x01, 0x0a, 0x13,
+	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x22, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x65, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x28, 0x22, 0x23, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x61, 0x67, 0x65, 0x6e,
+	0x74, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x73, 0x3a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0xb3, 0x01, 0x0a,
+	0x19, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x28, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x47, 0x65,
+	0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x61, 0x6e,
+	0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x41, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3b, 0x22, 0x39, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x61,
+	0x67, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x73, 0x12, 0x84, 0x01, 0x0a, 0x0c, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x50, 0x72,
+	0x6f, 0x6f, 0x66, 0x12, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x72, 0x65,
+	0x73, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e,
+	0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x33, 0x22, 0x2e, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x3a, 0x01, 0x2a, 0x12, 0x70, 0x0a, 0x0c, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x22, 0x1a, 0x2f, 0x63,
+	0x6c, 0x6f, 0x75, 0x64, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x3a, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0x70, 0x0a, 0x0c, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x1b, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x22, 0x1a,
+	0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x77, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x3a, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0x3c, 0x0a,
+	0x06, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x15, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x41, 0x67, 0x65,
+	0x6e, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x30, 0x01, 0x42, 0x18, 0x5a, 0x16, 0x64,
+	0x69, 0x64, 0x63, 0x6f, 0x6d, 0x6d, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x61, 0x67, 0x65, 0x6e,
+	0x74, 0x2f, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var file_canis_didcomm_cloudagent_proto_goTypes = []interface{}{
-	(*common.RegisterCloudAgentRequest)(nil),  // 0: common.RegisterCloudAgentRequest
-	(*common.EndpointRequest)(nil),            // 1: common.EndpointRequest
-	(*common.HandleInvitationRequest)(nil),    // 2: common.HandleInvitationRequest
-	(*common.AcceptCredentialRequest)(nil),    // 3: common.AcceptCredentialRequest
-	(*common.ListConnectionsRequest)(nil),     // 4: common.ListConnectionsRequest
-	(*common.ListCredentialsRequest)(nil),     // 5: common.ListCredentialsRequest
-	(*common.ListProofRequestsRequest)(nil),   // 6: common.ListProofRequestsRequest
-	(*common.PresentProofRequest)(nil),        // 7: common.PresentProofRequest
-	(*common.RegisterCloudAgentResponse)(nil), // 8: common.RegisterCloudAgentResponse
-	(*common.EndpointResponse)(nil),           // 9: common.EndpointResponse
-	(*common.HandleInvitationResponse)(nil),   // 10: common.HandleInvitationResponse
-	(*common.AcceptCredentialResponse)(nil),   // 11: common.AcceptCredentialResponse
-	(*common.ListConnectionsResponse)(nil),    // 12: common.ListConnectionsResponse
-	(*common.ListCredentialsResponse)(nil),    // 13: common.ListCredentialsResponse
-	(*common.ListProofRequestsResponse)(nil),  // 14: common.ListProofRequestsResponse
-	(*common.PresentProofResponse)(nil),       // 15: common.PresentProofResponse
+	(*common.RegisterCloudAgentRequest)(nil),         // 0: common.RegisterCloudAgentRequest
+	(*common.RotateCloudAgentKeyRequest)(nil),        // 1: common.RotateCloudAgentKeyRequest
+	(*common.EndpointRequest)(nil),                   // 2: common.EndpointRequest
+	(*common.HandleInvitationRequest)(nil),           // 3: common.HandleInvitationRequest
+	(*common.AcceptCredentialRequest)(nil),           // 4: common.AcceptCredentialRequest
+	(*common.ProposeCredentialRequest)(nil),          // 5: common.ProposeCredentialRequest
+	(*common.CreateInvitationRequest)(nil),           // 6: common.CreateInvitationRequest
+	(*common.DeleteConnectionRequest)(nil),           // 7: common.DeleteConnectionRequest
+	(*common.UpdateConnectionLabelRequest)(nil),      // 8: common.UpdateConnectionLabelRequest
+	(*common.ListConnectionsRequest)(nil),            // 9: common.ListConnectionsRequest
+	(*common.ListCredentialsRequest)(nil),            // 10: common.ListCredentialsRequest
+	(*common.ListProofRequestsRequest)(nil),          // 11: common.ListProofRequestsRequest
+	(*common.ProposePresentationRequest)(nil),        // 12: common.ProposePresentationRequest
+	(*common.GetProofRequestCandidatesRequest)(nil),  // 13: common.GetProofRequestCandidatesRequest
+	(*common.PresentProofRequest)(nil),               // 14: common.PresentProofRequest
+	(*common.ExportWalletRequest)(nil),               // 15: common.ExportWalletRequest
+	(*common.ImportWalletRequest)(nil),               // 16: common.ImportWalletRequest
+	(*common.EventsRequest)(nil),                     // 17: common.EventsRequest
+	(*common.RegisterCloudAgentResponse)(nil),        // 18: common.RegisterCloudAgentResponse
+	(*common.RotateCloudAgentKeyResponse)(nil),       // 19: common.RotateCloudAgentKeyResponse
+	(*common.EndpointResponse)(nil),                  // 20: common.EndpointResponse
+	(*common.HandleInvitationResponse)(nil),          // 21: common.HandleInvitationResponse
+	(*common.AcceptCredentialResponse)(nil),          // 22: common.AcceptCredentialResponse
+	(*common.ProposeCredentialResponse)(nil),         // 23: common.ProposeCredentialResponse
+	(*common.CreateInvitationResponse)(nil),          // 24: common.CreateInvitationResponse
+	(*httpbody.HttpBody)(nil),                        // 25: google.api.HttpBody
+	(*common.DeleteConnectionResponse)(nil),          // 26: common.DeleteConnectionResponse
+	(*common.UpdateConnectionLabelResponse)(nil),     // 27: common.UpdateConnectionLabelResponse
+	(*common.ListConnectionsResponse)(nil),           // 28: common.ListConnectionsResponse
+	(*common.ListCredentialsResponse)(nil),           // 29: common.ListCredentialsResponse
+	(*common.ListProofRequestsResponse)(nil),         // 30: common.ListProofRequestsResponse
+	(*common.ProposePresentationResponse)(nil),       // 31: common.ProposePresentationResponse
+	(*common.GetProofRequestCandidatesResponse)(nil), // 32: common.GetProofRequestCandidatesResponse
+	(*common.PresentProofResponse)(nil),              // 33: common.PresentProofResponse
+	(*common.ExportWalletResponse)(nil),              // 34: common.ExportWalletResponse
+	(*common.ImportWalletResponse)(nil),              // 35: common.ImportWalletResponse
+	(*common.CloudAgentEvent)(nil),                   // 36: common.CloudAgentEvent
 }
 var file_canis_didcomm_cloudagent_proto_depIdxs = []int32{
 	0,  // 0: didcomm.CloudAgent.RegisterCloudAgent:input_type -> common.RegisterCloudAgentRequest
-	1,  // 1: didcomm.CloudAgent.GetEndpoint:input_type -> common.EndpointRequest
-	2,  // 2: didcomm.CloudAgent.AcceptInvitation:input_type -> common.HandleInvitationRequest
-	3,  // 3: didcomm.CloudAgent.AcceptCredential:input_type -> common.AcceptCredentialRequest
-	4,  // 4: didcomm.CloudAgent.ListConnections:input_type -> common.ListConnectionsRequest
-	5,  // 5: didcomm.CloudAgent.ListCredentials:input_type -> common.ListCredentialsRequest
-	6,  // 6: didcomm.CloudAgent.ListProofRequests:input_type -> common.ListProofRequestsRequest
-	7,  // 7: didcomm.CloudAgent.PresentProof:input_type -> common.PresentProofRequest
-	8,  // 8: didcomm.CloudAgent.RegisterCloudAgent:output_type -> common.RegisterCloudAgentResponse
-	9,  // 9: didcomm.CloudAgent.GetEndpoint:output_type -> common.EndpointResponse
-	10, // 10: didcomm.CloudAgent.AcceptInvitation:output_type -> common.HandleInvitationResponse
-	11, // 11: didcomm.CloudAgent.AcceptCredential:output_type -> common.AcceptCredentialResponse
-	12, // 12: didcomm.CloudAgent.ListConnections:output_type -> common.ListConnectionsResponse
-	13, // 13: didcomm.CloudAgent.ListCredentials:output_type -> common.ListCredentialsResponse
-	14, // 14: didcomm.CloudAgent.ListProofRequests:output_type -> common.ListProofRequestsResponse
-	15, // 15: didcomm.CloudAgent.PresentProof:output_type -> common.PresentProofResponse
-	8,  // [8:16] is the sub-list for method output_type
-	0,  // [0:8] is the sub-list for method input_type
+	1,  // 1: didcomm.CloudAgent.RotateCloudAgentKey:input_type -> common.RotateCloudAgentKeyRequest
+	2,  // 2: didcomm.CloudAgent.GetEndpoint:input_type -> common.EndpointRequest
+	3,  // 3: didcomm.CloudAgent.AcceptInvitation:input_type -> common.HandleInvitationRequest
+	4,  // 4: didcomm.CloudAgent.AcceptCredential:input_type -> common.AcceptCredentialRequest
+	5,  // 5: didcomm.CloudAgent.ProposeCredential:input_type -> common.ProposeCredentialRequest
+	6,  // 6: didcomm.CloudAgent.CreateInvitation:input_type -> common.CreateInvitationRequest
+	6,  // 7: didcomm.CloudAgent.CreateInvitationImage:input_type -> common.CreateInvitationRequest
+	7,  // 8: didcomm.CloudAgent.DeleteConnection:input_type -> common.DeleteConnectionRequest
+	8,  // 9: didcomm.CloudAgent.UpdateConnectionLabel:input_type -> common.UpdateConnectionLabelRequest
+	9,  // 10: didcomm.CloudAgent.ListConnections:input_type -> common.ListConnectionsRequest
+	10, // 11: didcomm.CloudAgent.ListCredentials:input_type -> common.ListCredentialsRequest
+	11, // 12: didcomm.CloudAgent.ListProofRequests:input_type -> common.ListProofRequestsRequest
+	12, // 13: didcomm.CloudAgent.ProposePresentation:input_type -> common.ProposePresentationRequest
+	13, // 14: didcomm.CloudAgent.GetProofRequestCandidates:input_type -> common.GetProofRequestCandidatesRequest
+	14, // 15: didcomm.CloudAgent.PresentProof:input_type -> common.PresentProofRequest
+	15, // 16: didcomm.CloudAgent.ExportWallet:input_type -> common.ExportWalletRequest
+	16, // 17: didcomm.CloudAgent.ImportWallet:input_type -> common.ImportWalletRequest
+	17, // 18: didcomm.CloudAgent.Events:input_type -> common.EventsRequest
+	18, // 19: didcomm.CloudAgent.RegisterCloudAgent:output_type -> common.RegisterCloudAgentResponse
+	19, // 20: didcomm.CloudAgent.RotateCloudAgentKey:output_type -> common.RotateCloudAgentKeyResponse
+	20, // 21: didcomm.CloudAgent.GetEndpoint:output_type -> common.EndpointResponse
+	21, // 22: didcomm.CloudAgent.AcceptInvitation:output_type -> common.HandleInvitationResponse
+	22, // 23: didcomm.CloudAgent.AcceptCredential:output_type -> common.AcceptCredentialResponse
+	23, // 24: didcomm.CloudAgent.ProposeCredential:output_type -> common.ProposeCredentialResponse
+	24, // 25: didcomm.CloudAgent.CreateInvitation:output_type -> common.CreateInvitationResponse
+	25, // 26: didcomm.CloudAgent.CreateInvitationImage:output_type -> google.api.HttpBody
+	26, // 27: didcomm.CloudAgent.DeleteConnection:output_type -> common.DeleteConnectionResponse
+	27, // 28: didcomm.CloudAgent.UpdateConnectionLabel:output_type -> common.UpdateConnectionLabelResponse
+	28, // 29: didcomm.CloudAgent.ListConnections:output_type -> common.ListConnectionsResponse
+	29, // 30: didcomm.CloudAgent.ListCredentials:output_type -> common.ListCredentialsResponse
+	30, // 31: didcomm.CloudAgent.ListProofRequests:output_type -> common.ListProofRequestsResponse
+	31, // 32: didcomm.CloudAgent.ProposePresentation:output_type -> common.ProposePresentationResponse
+	32, // 33: didcomm.CloudAgent.GetProofRequestCandidates:output_type -> common.GetProofRequestCandidatesResponse
+	33, // 34: didcomm.CloudAgent.PresentProof:output_type -> common.PresentProofResponse
+	34, // 35: didcomm.CloudAgent.ExportWallet:output_type -> common.ExportWalletResponse
+	35, // 36: didcomm.CloudAgent.ImportWallet:output_type -> common.ImportWalletResponse
+	36, // 37: didcomm.CloudAgent.Events:output_type -> common.CloudAgentEvent
+	19, // [19:38] is the sub-list for method output_type
+	0,  // [0:19] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
@@ -183,13 +312,24 @@ const _ = grpc.SupportPackageIsVersion6
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type CloudAgentClient interface {
 	RegisterCloudAgent(ctx context.Context, in *common.RegisterCloudAgentRequest, opts ...grpc.CallOption) (*common.RegisterCloudAgentResponse, error)
+	RotateCloudAgentKey(ctx context.Context, in *common.RotateCloudAgentKeyRequest, opts ...grpc.CallOption) (*common.RotateCloudAgentKeyResponse, error)
 	GetEndpoint(ctx context.Context, in *common.EndpointRequest, opts ...grpc.CallOption) (*common.EndpointResponse, error)
 	AcceptInvitation(ctx context.Context, in *common.HandleInvitationRequest, opts ...grpc.CallOption) (*common.HandleInvitationResponse, error)
 	AcceptCredential(ctx context.Context, in *common.AcceptCredentialRequest, opts ...grpc.CallOption) (*common.AcceptCredentialResponse, error)
+	ProposeCredential(ctx context.Context, in *common.ProposeCredentialRequest, opts ...grpc.CallOption) (*common.ProposeCredentialResponse, error)
+	CreateInvitation(ctx context.Context, in *common.CreateInvitationRequest, opts ...grpc.CallOption) (*common.CreateInvitationResponse, error)
+	CreateInvitationImage(ctx context.Context, in *common.CreateInvitationRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
+	DeleteConnection(ctx context.Context, in *common.DeleteConnectionRequest, opts ...grpc.CallOption) (*common.DeleteConnectionResponse, error)
+	UpdateConnectionLabel(ctx context.Context, in *common.UpdateConnectionLabelRequest, opts ...grpc.CallOption) (*common.UpdateConnectionLabelResponse, error)
 	ListConnections(ctx context.Context, in *common.ListConnectionsRequest, opts ...grpc.CallOption) (*common.ListConnectionsResponse, error)
 	ListCredentials(ctx context.Context, in *common.ListCredentialsRequest, opts ...grpc.CallOption) (*common.ListCredentialsResponse, error)
 	ListProofRequests(ctx context.Context, in *common.ListProofRequestsRequest, opts ...grpc.CallOption) (*common.ListProofRequestsResponse, error)
+	ProposePresentation(ctx context.Context, in *common.ProposePresentationRequest, opts ...grpc.CallOption) (*common.ProposePresentationResponse, error)
+	GetProofRequestCandidates(ctx context.Context, in *common.GetProofRequestCandidatesRequest, opts ...grpc.CallOption) (*common.GetProofRequestCandidatesResponse, error)
 	PresentProof(ctx context.Context, in *common.PresentProofRequest, opts ...grpc.CallOption) (*common.PresentProofResponse, error)
+	ExportWallet(ctx context.Context, in *common.ExportWalletRequest, opts ...grpc.CallOption) (*common.ExportWalletResponse, error)
+	ImportWallet(ctx context.Context, in *common.ImportWalletRequest, opts ...grpc.CallOption) (*common.ImportWalletResponse, error)
+	Events(ctx context.Context, in *common.EventsRequest, opts ...grpc.CallOption) (CloudAgent_EventsClient, error)
 }
 
 type cloudAgentClient struct {
@@ -209,6 +349,15 @@ func (c *cloudAgentClient) RegisterCloudAgent(ctx context.Context, in *common.Re
 	return out, nil
 }
 
+func (c *cloudAgentClient) RotateCloudAgentKey(ctx context.Context, in *common.RotateCloudAgentKeyRequest, opts ...grpc.CallOption) (*common.RotateCloudAgentKeyResponse, error) {
+	out := new(common.RotateCloudAgentKeyResponse)
+	err := c.cc.Invoke(ctx, "/didcomm.CloudAgent/RotateCloudAgentKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *cloudAgentClient) GetEndpoint(ctx context.Context, in *common.EndpointRequest, opts ...grpc.CallOption) (*common.EndpointResponse, error) {
 	out := new(common.EndpointResponse)
 	err := c.cc.Invoke(ctx, "/didcomm.CloudAgent/GetEndpoint", in, out, opts...)
@@ -236,6 +385,51 @@ func (c *cloudAgentClient) AcceptCredential(ctx context.Context, in *common.Acce
 	return out, nil
 }
 
+func (c *cloudAgentClient) ProposeCredential(ctx context.Context, in *common.ProposeCredentialRequest, opts ...grpc.CallOption) (*common.ProposeCredentialResponse, error) {
+	out := new(common.ProposeCredentialResponse)
+	err := c.cc.Invoke(ctx, "/didcomm.CloudAgent/ProposeCredential", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cloudAgentClient) CreateInvitation(ctx context.Context, in *common.CreateInvitationRequest, opts ...grpc.CallOption) (*common.CreateInvitationResponse, error) {
+	out := new(common.CreateInvitationResponse)
+	err := c.cc.Invoke(ctx, "/didcomm.CloudAgent/CreateInvitation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cloudAgentClient) CreateInvitationImage(ctx context.Context, in *common.CreateInvitationRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error) {
+	out := new(httpbody.HttpBody)
+	err := c.cc.Invoke(ctx, "/didcomm.CloudAgent/CreateInvitationImage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cloudAgentClient) DeleteConnection(ctx context.Context, in *common.DeleteConnectionRequest, opts ...grpc.CallOption) (*common.DeleteConnectionResponse, error) {
+	out := new(common.DeleteConnectionResponse)
+	err := c.cc.Invoke(ctx, "/didcomm.CloudAgent/DeleteConnection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cloudAgentClient) UpdateConnectionLabel(ctx context.Context, in *common.UpdateConnectionLabelRequest, opts ...grpc.CallOption) (*common.UpdateConnectionLabelResponse, error) {
+	out := new(common.UpdateConnectionLabelResponse)
+	err := c.cc.Invoke(ctx, "/didcomm.CloudAgent/UpdateConnectionLabel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *cloudAgentClient) ListConnections(ctx context.Context, in *common.ListConnectionsRequest, opts ...grpc.CallOption) (*common.ListConnectionsResponse, error) {
 	out := new(common.ListConnectionsResponse)
 	err := c.cc.Invoke(ctx, "/didcomm.CloudAgent/ListConnections", in, out, opts...)
@@ -263,6 +457,24 @@ func (c *cloudAgentClient) ListProofRequests(ctx context.Context, in *common.Lis
 	return out, nil
 }
 
+func (c *cloudAgentClient) ProposePresentation(ctx context.Context, in *common.ProposePresentationRequest, opts ...grpc.CallOption) (*common.ProposePresentationResponse, error) {
+	out := new(common.ProposePresentationResponse)
+	err := c.cc.Invoke(ctx, "/didcomm.CloudAgent/ProposePresentation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cloudAgentClient) GetProofRequestCandidates(ctx context.Context, in *common.GetProofRequestCandidatesRequest, opts ...grpc.CallOption) (*common.GetProofRequestCandidatesResponse, error) {
+	out := new(common.GetProofRequestCandidatesResponse)
+	err := c.cc.Invoke(ctx, "/didcomm.CloudAgent/GetProofRequestCandidates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *cloudAgentClient) PresentProof(ctx context.Context, in *common.PresentProofRequest, opts ...grpc.CallOption) (*common.PresentProofResponse, error) {
 	out := new(common.PresentProofResponse)
 	err := c.cc.Invoke(ctx, "/didcomm.CloudAgent/PresentProof", in, out, opts...)
@@ -272,16 +484,77 @@ func (c *cloudAgentClient) PresentProof(ctx context.Context, in *common.PresentP
 	return out, nil
 }
 
+func (c *cloudAgentClient) ExportWallet(ctx context.Context, in *common.ExportWalletRequest, opts ...grpc.CallOption) (*common.ExportWalletResponse, error) {
+	out := new(common.ExportWalletResponse)
+	err := c.cc.Invoke(ctx, "/didcomm.CloudAgent/ExportWallet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cloudAgentClient) ImportWallet(ctx context.Context, in *common.ImportWalletRequest, opts ...grpc.CallOption) (*common.ImportWalletResponse, error) {
+	out := new(common.ImportWalletResponse)
+	err := c.cc.Invoke(ctx, "/didcomm.CloudAgent/ImportWallet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cloudAgentClient) Events(ctx context.Context, in *common.EventsRequest, opts ...grpc.CallOption) (CloudAgent_EventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_CloudAgent_serviceDesc.Streams[0], "/didcomm.CloudAgent/Events", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &cloudAgentEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CloudAgent_EventsClient interface {
+	Recv() (*common.CloudAgentEvent, error)
+	grpc.ClientStream
+}
+
+type cloudAgentEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *cloudAgentEventsClient) Recv() (*common.CloudAgentEvent, error) {
+	m := new(common.CloudAgentEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // CloudAgentServer is the server API for CloudAgent service.
 type CloudAgentServer interface {
 	RegisterCloudAgent(context.Context, *common.RegisterCloudAgentRequest) (*common.RegisterCloudAgentResponse, error)
+	RotateCloudAgentKey(context.Context, *common.RotateCloudAgentKeyRequest) (*common.RotateCloudAgentKeyResponse, error)
 	GetEndpoint(context.Context, *common.EndpointRequest) (*common.EndpointResponse, error)
 	AcceptInvitation(context.Context, *common.HandleInvitationRequest) (*common.HandleInvitationResponse, error)
 	AcceptCredential(context.Context, *common.AcceptCredentialRequest) (*common.AcceptCredentialResponse, error)
+	ProposeCredential(context.Context, *common.ProposeCredentialRequest) (*common.ProposeCredentialResponse, error)
+	CreateInvitation(context.Context, *common.CreateInvitationRequest) (*common.CreateInvitationResponse, error)
+	CreateInvitationImage(context.Context, *common.CreateInvitationRequest) (*httpbody.HttpBody, error)
+	DeleteConnection(context.Context, *common.DeleteConnectionRequest) (*common.DeleteConnectionResponse, error)
+	UpdateConnectionLabel(context.Context, *common.UpdateConnectionLabelRequest) (*common.UpdateConnectionLabelResponse, error)
 	ListConnections(context.Context, *common.ListConnectionsRequest) (*common.ListConnectionsResponse, error)
 	ListCredentials(context.Context, *common.ListCredentialsRequest) (*common.ListCredentialsResponse, error)
 	ListProofRequests(context.Context, *common.ListProofRequestsRequest) (*common.ListProofRequestsResponse, error)
+	ProposePresentation(context.Context, *common.ProposePresentationRequest) (*common.ProposePresentationResponse, error)
+	GetProofRequestCandidates(context.Context, *common.GetProofRequestCandidatesRequest) (*common.GetProofRequestCandidatesResponse, error)
 	PresentProof(context.Context, *common.PresentProofRequest) (*common.PresentProofResponse, error)
+	ExportWallet(context.Context, *common.ExportWalletRequest) (*common.ExportWalletResponse, error)
+	ImportWallet(context.Context, *common.ImportWalletRequest) (*common.ImportWalletResponse, error)
+	Events(*common.EventsRequest, CloudAgent_EventsServer) error
 }
 
 // UnimplementedCloudAgentServer can be embedded to have forward compatible implementations.
@@ -291,6 +564,9 @@ type UnimplementedCloudAgentServer struct {
 func (*UnimplementedCloudAgentServer) RegisterCloudAgent(context.Context, *common.RegisterCloudAgentRequest) (*common.RegisterCloudAgentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegisterCloudAgent not implemented")
 }
+func (*UnimplementedCloudAgentServer) RotateCloudAgentKey(context.Context, *common.RotateCloudAgentKeyRequest) (*common.RotateCloudAgentKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateCloudAgentKey not implemented")
+}
 func (*UnimplementedCloudAgentServer) GetEndpoint(context.Context, *common.EndpointRequest) (*common.EndpointResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetEndpoint not implemented")
 }
@@ -300,6 +576,21 @@ func (*UnimplementedCloudAgentServer) AcceptInvitation(context.Context, *common.
 func (*UnimplementedCloudAgentServer) AcceptCredential(context.Context, *common.AcceptCredentialRequest) (*common.AcceptCredentialResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AcceptCredential not implemented")
 }
+func (*UnimplementedCloudAgentServer) ProposeCredential(context.Context, *common.ProposeCredentialRequest) (*common.ProposeCredentialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposeCredential not implemented")
+}
+func (*UnimplementedCloudAgentServer) CreateInvitation(context.Context, *common.CreateInvitationRequest) (*common.CreateInvitationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateInvitation not implemented")
+}
+func (*UnimplementedCloudAgentServer) CreateInvitationImage(context.Context, *common.CreateInvitationRequest) (*httpbody.HttpBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateInvitationImage not implemented")
+}
+func (*UnimplementedCloudAgentServer) DeleteConnection(context.Context, *common.DeleteConnectionRequest) (*common.DeleteConnectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteConnection not implemented")
+}
+func (*UnimplementedCloudAgentServer) UpdateConnectionLabel(context.Context, *common.UpdateConnectionLabelRequest) (*common.UpdateConnectionLabelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateConnectionLabel not implemented")
+}
 func (*UnimplementedCloudAgentServer) ListConnections(context.Context, *common.ListConnectionsRequest) (*common.ListConnectionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListConnections not implemented")
 }
@@ -309,9 +600,24 @@ func (*UnimplementedCloudAgentServer) ListCredentials(context.Context, *common.L
 func (*UnimplementedCloudAgentServer) ListProofRequests(context.Context, *common.ListProofRequestsRequest) (*common.ListProofRequestsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListProofRequests not implemented")
 }
+func (*UnimplementedCloudAgentServer) ProposePresentation(context.Context, *common.ProposePresentationRequest) (*common.ProposePresentationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposePresentation not implemented")
+}
+func (*UnimplementedCloudAgentServer) GetProofRequestCandidates(context.Context, *common.GetProofRequestCandidatesRequest) (*common.GetProofRequestCandidatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProofRequestCandidates not implemented")
+}
 func (*UnimplementedCloudAgentServer) PresentProof(context.Context, *common.PresentProofRequest) (*common.PresentProofResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PresentProof not implemented")
 }
+func (*UnimplementedCloudAgentServer) ExportWallet(context.Context, *common.ExportWalletRequest) (*common.ExportWalletResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportWallet not implemented")
+}
+func (*UnimplementedCloudAgentServer) ImportWallet(context.Context, *common.ImportWalletRequest) (*common.ImportWalletResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportWallet not implemented")
+}
+func (*UnimplementedCloudAgentServer) Events(*common.EventsRequest, CloudAgent_EventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method Events not implemented")
+}
 
 func RegisterCloudAgentServer(s *grpc.Server, srv CloudAgentServer) {
 	s.RegisterService(&_CloudAgent_serviceDesc, srv)
@@ -335,6 +641,24 @@ func _CloudAgent_RegisterCloudAgent_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CloudAgent_RotateCloudAgentKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(common.RotateCloudAgentKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CloudAgentServer).RotateCloudAgentKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/didcomm.CloudAgent/RotateCloudAgentKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CloudAgentServer).RotateCloudAgentKey(ctx, req.(*common.RotateCloudAgentKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CloudAgent_GetEndpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(common.EndpointRequest)
 	if err := dec(in); err != nil {
@@ -389,6 +713,96 @@ func _CloudAgent_AcceptCredential_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CloudAgent_ProposeCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(common.ProposeCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CloudAgentServer).ProposeCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/didcomm.CloudAgent/ProposeCredential",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CloudAgentServer).ProposeCredential(ctx, req.(*common.ProposeCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CloudAgent_CreateInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(common.CreateInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CloudAgentServer).CreateInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/didcomm.CloudAgent/CreateInvitation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CloudAgentServer).CreateInvitation(ctx, req.(*common.CreateInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CloudAgent_CreateInvitationImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(common.CreateInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CloudAgentServer).CreateInvitationImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/didcomm.CloudAgent/CreateInvitationImage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CloudAgentServer).CreateInvitationImage(ctx, req.(*common.CreateInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CloudAgent_DeleteConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(common.DeleteConnectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CloudAgentServer).DeleteConnection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/didcomm.CloudAgent/DeleteConnection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CloudAgentServer).DeleteConnection(ctx, req.(*common.DeleteConnectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CloudAgent_UpdateConnectionLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(common.UpdateConnectionLabelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CloudAgentServer).UpdateConnectionLabel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/didcomm.CloudAgent/UpdateConnectionLabel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CloudAgentServer).UpdateConnectionLabel(ctx, req.(*common.UpdateConnectionLabelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CloudAgent_ListConnections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(common.ListConnectionsRequest)
 	if err := dec(in); err != nil {
@@ -443,6 +857,42 @@ func _CloudAgent_ListProofRequests_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CloudAgent_ProposePresentation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(common.ProposePresentationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CloudAgentServer).ProposePresentation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/didcomm.CloudAgent/ProposePresentation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CloudAgentServer).ProposePresentation(ctx, req.(*common.ProposePresentationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CloudAgent_GetProofRequestCandidates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(common.GetProofRequestCandidatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CloudAgentServer).GetProofRequestCandidates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/didcomm.CloudAgent/GetProofRequestCandidates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CloudAgentServer).GetProofRequestCandidates(ctx, req.(*common.GetProofRequestCandidatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CloudAgent_PresentProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(common.PresentProofRequest)
 	if err := dec(in); err != nil {
@@ -461,6 +911,63 @@ func _CloudAgent_PresentProof_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CloudAgent_ExportWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(common.ExportWalletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CloudAgentServer).ExportWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/didcomm.CloudAgent/ExportWallet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CloudAgentServer).ExportWallet(ctx, req.(*common.ExportWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CloudAgent_ImportWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(common.ImportWalletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CloudAgentServer).ImportWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/didcomm.CloudAgent/ImportWallet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CloudAgentServer).ImportWallet(ctx, req.(*common.ImportWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CloudAgent_Events_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(common.EventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CloudAgentServer).Events(m, &cloudAgentEventsServer{stream})
+}
+
+type CloudAgent_EventsServer interface {
+	Send(*common.CloudAgentEvent) error
+	grpc.ServerStream
+}
+
+type cloudAgentEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *cloudAgentEventsServer) Send(m *common.CloudAgentEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _CloudAgent_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "didcomm.CloudAgent",
 	HandlerType: (*CloudAgentServer)(nil),
@@ -469,6 +976,10 @@ var _CloudAgent_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RegisterCloudAgent",
 			Handler:    _CloudAgent_RegisterCloudAgent_Handler,
 		},
+		{
+			MethodName: "RotateCloudAgentKey",
+			Handler:    _CloudAgent_RotateCloudAgentKey_Handler,
+		},
 		{
 			MethodName: "GetEndpoint",
 			Handler:    _CloudAgent_GetEndpoint_Handler,
@@ -481,6 +992,26 @@ var _CloudAgent_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AcceptCredential",
 			Handler:    _CloudAgent_AcceptCredential_Handler,
 		},
+		{
+			MethodName: "ProposeCredential",
+			Handler:    _CloudAgent_ProposeCredential_Handler,
+		},
+		{
+			MethodName: "CreateInvitation",
+			Handler:    _CloudAgent_CreateInvitation_Handler,
+		},
+		{
+			MethodName: "CreateInvitationImage",
+			Handler:    _CloudAgent_CreateInvitationImage_Handler,
+		},
+		{
+			MethodName: "DeleteConnection",
+			Handler:    _CloudAgent_DeleteConnection_Handler,
+		},
+		{
+			MethodName: "UpdateConnectionLabel",
+			Handler:    _CloudAgent_UpdateConnectionLabel_Handler,
+		},
 		{
 			MethodName: "ListConnections",
 			Handler:    _CloudAgent_ListConnections_Handler,
@@ -493,11 +1024,33 @@ var _CloudAgent_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListProofRequests",
 			Handler:    _CloudAgent_ListProofRequests_Handler,
 		},
+		{
+			MethodName: "ProposePresentation",
+			Handler:    _CloudAgent_ProposePresentation_Handler,
+		},
+		{
+			MethodName: "GetProofRequestCandidates",
+			Handler:    _CloudAgent_GetProofRequestCandidates_Handler,
+		},
 		{
 			MethodName: "PresentProof",
 			Handler:    _CloudAgent_PresentProof_Handler,
 		},
+		{
+			MethodName: "ExportWallet",
+			Handler:    _CloudAgent_ExportWallet_Handler,
+		},
+		{
+			MethodName: "ImportWallet",
+			Handler:    _CloudAgent_ImportWallet_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Events",
+			Handler:       _CloudAgent_Events_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "canis-didcomm-cloudagent.proto",
 }
@@ -0,0 +1,288 @@
+package cloudagent
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/scoir/canis/pkg/datastore"
+	"github.com/scoir/canis/pkg/protogen/common"
+)
+
+// MaxClockSkew is how far a signed request timestamp may be from the server clock
+const MaxClockSkew = 5 * time.Minute
+
+const gatewayHeaderKey = "x-canis-cloud-agent-gateway"
+
+// unsigned GRPC methods that do not act on behalf of a registered cloud agent
+var unsigned = map[string]bool{
+	"/didcomm.CloudAgent/RegisterCloudAgent": true,
+	"/didcomm.CloudAgent/GetEndpoint":        true,
+}
+
+// SignatureMessage returns the canonical message a cloud agent signs for each request.  For HTTP
+// requests path is the escaped path and query, for GRPC requests the method is POST, path is the full
+// GRPC method name and body is the deterministic protobuf encoding of the request.  Timestamp is in
+// unix seconds and the nonce must not be reused within the allowed clock skew.
+func SignatureMessage(method, path, timestamp, nonce string, body []byte) []byte {
+	digest := sha256.Sum256(body)
+	return []byte(strings.Join([]string{method, path, timestamp, nonce, hex.EncodeToString(digest[:])}, "\n"))
+}
+
+type signedRequest struct {
+	agentID   string
+	signature string
+	timestamp string
+	nonce     string
+	method    string
+	path      string
+	body      []byte
+}
+
+// verify checks the request signature with the cloud agent's public key, or the key returned by rotate
+// for key rotation requests, and rejects stale timestamps and reused nonces
+func (r *CloudAgent) verify(sr *signedRequest, rotate func(*datastore.CloudAgent) (ed25519.PublicKey, error)) error {
+	if sr.agentID == "" || sr.signature == "" || sr.timestamp == "" || sr.nonce == "" {
+		return errors.New("missing signature headers")
+	}
+
+	secs, err := strconv.ParseInt(sr.timestamp, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid timestamp")
+	}
+
+	ts := time.Unix(secs, 0)
+	now := time.Now()
+	if ts.Before(now.Add(-MaxClockSkew)) || ts.After(now.Add(MaxClockSkew)) {
+		return errors.Errorf("timestamp %s outside allowed clock skew", sr.timestamp)
+	}
+
+	cloudAgent, err := r.store.GetCloudAgent(sr.agentID)
+	if err != nil {
+		return errors.Wrapf(err, "error loading cloud agent %s", sr.agentID)
+	}
+
+	key := ed25519.PublicKey(cloudAgent.PublicKey)
+	if rotate != nil {
+		key, err = rotate(cloudAgent)
+		if err != nil {
+			return err
+		}
+	}
+
+	sig, err := base64.URLEncoding.DecodeString(sr.signature)
+	if err != nil {
+		return errors.Wrap(err, "invalid signature encoding")
+	}
+
+	if !ed25519.Verify(key, SignatureMessage(sr.method, sr.path, sr.timestamp, sr.nonce, sr.body), sig) {
+		return errors.New("invalid signature")
+	}
+
+	if !r.nonces.add(sr.agentID+":"+sr.nonce, ts.Add(MaxClockSkew), now) {
+		return errors.Errorf("nonce %s has already been used", sr.nonce)
+	}
+
+	return nil
+}
+
+func (r *CloudAgent) signedTokenAuth(h http.Handler) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+
+		if req.URL.Path == "/" && req.Method == http.MethodGet {
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte("ok"))
+			return
+		}
+
+		if req.URL.Path == "/cloudagents" && req.Method == http.MethodPost {
+			h.ServeHTTP(w, req)
+			return
+		}
+
+		body, err := ioutil.ReadAll(req.Body)
+		if err != nil {
+			http.Error(w, "Not authorized", 401)
+			return
+		}
+		req.Body = ioutil.NopCloser(bytes.NewBuffer(body))
+
+		path := req.URL.EscapedPath()
+		if req.URL.RawQuery != "" {
+			path += "?" + req.URL.RawQuery
+		}
+
+		sr := &signedRequest{
+			agentID:   req.Header.Get(CanisCloudAgentIDHeaderKey),
+			signature: req.Header.Get(CanisCloudAgentSigHeaderKey),
+			timestamp: req.Header.Get(CanisCloudAgentTimestampHeaderKey),
+			nonce:     req.Header.Get(CanisCloudAgentNonceHeaderKey),
+			method:    req.Method,
+			path:      path,
+			body:      body,
+		}
+
+		var rotate func(*datastore.CloudAgent) (ed25519.PublicKey, error)
+		if req.URL.Path == "/cloudagents/keys" && req.Method == http.MethodPost {
+			rr := &common.RotateCloudAgentKeyRequest{}
+			err = (&runtime.JSONPb{}).Unmarshal(body, rr)
+			if err != nil {
+				http.Error(w, "Not authorized", 401)
+				return
+			}
+			rotate = rotationKey(rr)
+		}
+
+		err = r.verify(sr, rotate)
+		if err != nil {
+			log.Println("unauthorized cloud agent request", sr.agentID, err)
+			http.Error(w, "Not authorized", 401)
+			return
+		}
+
+		h.ServeHTTP(w, req)
+	}
+}
+
+// signedRequestInterceptor applies the signed request scheme to GRPC calls that do not come from the web bridge
+func (r *CloudAgent) signedRequestInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+
+	if unsigned[info.FullMethod] {
+		return handler(ctx, req)
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	if token := md.Get(gatewayHeaderKey); len(token) == 1 &&
+		subtle.ConstantTimeCompare([]byte(token[0]), []byte(r.gatewayToken)) == 1 {
+		return handler(ctx, req)
+	}
+
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "not authorized")
+	}
+
+	body, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "not authorized")
+	}
+
+	sr := &signedRequest{
+		agentID:   first(md.Get(CanisCloudAgentIDHeaderKey)),
+		signature: first(md.Get(CanisCloudAgentSigHeaderKey)),
+		timestamp: first(md.Get(CanisCloudAgentTimestampHeaderKey)),
+		nonce:     first(md.Get(CanisCloudAgentNonceHeaderKey)),
+		method:    http.MethodPost,
+		path:      info.FullMethod,
+		body:      body,
+	}
+
+	var rotate func(*datastore.CloudAgent) (ed25519.PublicKey, error)
+	if rr, ok := req.(*common.RotateCloudAgentKeyRequest); ok {
+		rotate = rotationKey(rr)
+	}
+
+	err = r.verify(sr, rotate)
+	if err != nil {
+		log.Println("unauthorized cloud agent request", sr.agentID, err)
+		return nil, status.Error(codes.Unauthenticated, "not authorized")
+	}
+
+	return handler(ctx, req)
+}
+
+// rotationKey returns the key a rotation request must be signed with, the new public key it reveals
+// which has to match the next key committed to by the cloud agent
+func rotationKey(rotate *common.RotateCloudAgentKeyRequest) func(*datastore.CloudAgent) (ed25519.PublicKey, error) {
+	return func(cloudAgent *datastore.CloudAgent) (ed25519.PublicKey, error) {
+		if !nextKeyMatches(cloudAgent.NextKey, rotate.PublicKey) {
+			return nil, errors.New("public key does not match committed next key")
+		}
+
+		return rotate.PublicKey, nil
+	}
+}
+
+// nextKeyMatches checks a revealed key against the next key committed at registration or last rotation.  The
+// commitment is the SHA-256 hash of the key, clients that registered the raw next key are also accepted
+func nextKeyMatches(commitment, key []byte) bool {
+	if len(commitment) == 0 || len(key) != ed25519.PublicKeySize {
+		return false
+	}
+
+	digest := sha256.Sum256(key)
+	return bytes.Equal(digest[:], commitment) || bytes.Equal(key, commitment)
+}
+
+func first(vals []string) string {
+	if len(vals) != 1 {
+		return ""
+	}
+	return vals[0]
+}
+
+// gatewayCredentials marks calls made by the web bridge, which has already verified the request signature
+type gatewayCredentials string
+
+func (r gatewayCredentials) GetRequestMetadata(_ context.Context, _ ...string) (map[string]string, error) {
+	return map[string]string{gatewayHeaderKey: string(r)}, nil
+}
+
+func (r gatewayCredentials) RequireTransportSecurity() bool {
+	return false
+}
+
+// nonceCache remembers nonces until the timestamp they were signed with falls outside the allowed clock skew
+type nonceCache struct {
+	lock      sync.Mutex
+	seen      map[string]time.Time
+	lastPrune time.Time
+}
+
+func newNonceCache() *nonceCache {
+	return &nonceCache{
+		seen: map[string]time.Time{},
+	}
+}
+
+// add records nonce until expires, returning false if it has already been seen
+func (r *nonceCache) add(nonce string, expires, now time.Time) bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if now.Sub(r.lastPrune) > MaxClockSkew {
+		for k, exp := range r.seen {
+			if now.After(exp) {
+				delete(r.seen, k)
+			}
+		}
+		r.lastPrune = now
+	}
+
+	if exp, ok := r.seen[nonce]; ok && !now.After(exp) {
+		return false
+	}
+
+	r.seen[nonce] = expires
+	return true
+}
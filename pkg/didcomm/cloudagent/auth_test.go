@@ -0,0 +1,248 @@
+package cloudagent
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/scoir/canis/pkg/datastore"
+	"github.com/scoir/canis/pkg/datastore/mocks"
+	"github.com/scoir/canis/pkg/protogen/common"
+)
+
+func setupAuth(t *testing.T) (*CloudAgent, ed25519.PrivateKey, ed25519.PrivateKey) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	nextPub, nextPriv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	commit := sha256.Sum256(nextPub)
+	store := &mocks.Store{}
+	store.On("GetCloudAgent", "agent-id").Return(&datastore.CloudAgent{
+		ID:        "agent-id",
+		PublicKey: pub,
+		NextKey:   commit[:],
+	}, nil)
+
+	r := &CloudAgent{store: store, nonces: newNonceCache(), gatewayToken: "gateway-token"}
+	return r, priv, nextPriv
+}
+
+func signHTTP(key ed25519.PrivateKey, req *http.Request, body, nonce string, ts time.Time) {
+	stamp := strconv.FormatInt(ts.Unix(), 10)
+	sig := ed25519.Sign(key, SignatureMessage(req.Method, req.URL.EscapedPath(), stamp, nonce, []byte(body)))
+	req.Header.Set(CanisCloudAgentIDHeaderKey, "agent-id")
+	req.Header.Set(CanisCloudAgentSigHeaderKey, base64.URLEncoding.EncodeToString(sig))
+	req.Header.Set(CanisCloudAgentTimestampHeaderKey, stamp)
+	req.Header.Set(CanisCloudAgentNonceHeaderKey, nonce)
+}
+
+func TestSignedTokenAuth(t *testing.T) {
+	r, priv, nextPriv := setupAuth(t)
+	h := r.signedTokenAuth(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	serve := func(req *http.Request) int {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	t.Run("valid then replayed", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/cloudagents/credentials", nil)
+		signHTTP(priv, req, "", "nonce-1", time.Now())
+		require.Equal(t, http.StatusOK, serve(req))
+
+		replay := httptest.NewRequest(http.MethodPost, "/cloudagents/credentials", nil)
+		replay.Header = req.Header
+		require.Equal(t, http.StatusUnauthorized, serve(replay))
+	})
+
+	t.Run("signature for another path", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/cloudagents/credentials", nil)
+		signHTTP(priv, req, "", "nonce-2", time.Now())
+
+		other := httptest.NewRequest(http.MethodPost, "/cloudagents/connections", nil)
+		other.Header = req.Header
+		require.Equal(t, http.StatusUnauthorized, serve(other))
+	})
+
+	t.Run("body tampered", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/cloudagents/invitation", strings.NewReader(`{"invitation":"abc"}`))
+		signHTTP(priv, req, `{"invitation":"xyz"}`, "nonce-3", time.Now())
+		require.Equal(t, http.StatusUnauthorized, serve(req))
+	})
+
+	t.Run("stale timestamp", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/cloudagents/credentials", nil)
+		signHTTP(priv, req, "", "nonce-4", time.Now().Add(-2*MaxClockSkew))
+		require.Equal(t, http.StatusUnauthorized, serve(req))
+	})
+
+	t.Run("rotation signed by next key", func(t *testing.T) {
+		body := `{"public_key":"` + base64.StdEncoding.EncodeToString(nextPriv.Public().(ed25519.PublicKey)) + `","next_key":"YWJj"}`
+		req := httptest.NewRequest(http.MethodPost, "/cloudagents/keys", strings.NewReader(body))
+		signHTTP(nextPriv, req, body, "nonce-5", time.Now())
+		require.Equal(t, http.StatusOK, serve(req))
+
+		req = httptest.NewRequest(http.MethodPost, "/cloudagents/keys", strings.NewReader(body))
+		signHTTP(priv, req, body, "nonce-6", time.Now())
+		require.Equal(t, http.StatusUnauthorized, serve(req))
+	})
+}
+
+func TestSignedRequestInterceptor(t *testing.T) {
+	r, priv, _ := setupAuth(t)
+	info := &grpc.UnaryServerInfo{FullMethod: "/didcomm.CloudAgent/ListConnections"}
+	handler := func(_ context.Context, _ interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+	req := &common.ListConnectionsRequest{}
+
+	sign := func(nonce string) context.Context {
+		body, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+		require.NoError(t, err)
+		stamp := strconv.FormatInt(time.Now().Unix(), 10)
+		sig := ed25519.Sign(priv, SignatureMessage(http.MethodPost, info.FullMethod, stamp, nonce, body))
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+			CanisCloudAgentIDHeaderKey, "agent-id",
+			CanisCloudAgentSigHeaderKey, base64.URLEncoding.EncodeToString(sig),
+			CanisCloudAgentTimestampHeaderKey, stamp,
+			CanisCloudAgentNonceHeaderKey, nonce,
+		))
+	}
+
+	t.Run("signed", func(t *testing.T) {
+		ctx := sign("grpc-1")
+		resp, err := r.signedRequestInterceptor(ctx, req, info, handler)
+		require.NoError(t, err)
+		require.Equal(t, "ok", resp)
+
+		_, err = r.signedRequestInterceptor(ctx, req, info, handler)
+		require.Error(t, err)
+	})
+
+	t.Run("unsigned", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(CanisCloudAgentIDHeaderKey, "agent-id"))
+		_, err := r.signedRequestInterceptor(ctx, req, info, handler)
+		require.Error(t, err)
+	})
+
+	t.Run("from gateway", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(gatewayHeaderKey, "gateway-token"))
+		resp, err := r.signedRequestInterceptor(ctx, req, info, handler)
+		require.NoError(t, err)
+		require.Equal(t, "ok", resp)
+
+		ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(gatewayHeaderKey, "guess"))
+		_, err = r.signedRequestInterceptor(ctx, req, info, handler)
+		require.Error(t, err)
+	})
+
+	t.Run("registration is not signed", func(t *testing.T) {
+		reg := &grpc.UnaryServerInfo{FullMethod: "/didcomm.CloudAgent/RegisterCloudAgent"}
+		_, err := r.signedRequestInterceptor(context.Background(), &common.RegisterCloudAgentRequest{}, reg, handler)
+		require.NoError(t, err)
+	})
+}
+
+func TestRotateCloudAgentKey(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(CanisCloudAgentIDHeaderKey, "agent-id"))
+	setup := func() (*CloudAgent, *mocks.Store, ed25519.PublicKey) {
+		pub, _, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+		nextPub, _, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+
+		commit := sha256.Sum256(nextPub)
+		store := &mocks.Store{}
+		store.On("GetCloudAgent", "agent-id").Return(&datastore.CloudAgent{
+			ID:        "agent-id",
+			PublicKey: pub,
+			NextKey:   commit[:],
+		}, nil)
+
+		return &CloudAgent{store: store}, store, nextPub
+	}
+	newCommitment := func() []byte {
+		pub, _, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+		commit := sha256.Sum256(pub)
+		return commit[:]
+	}
+
+	t.Run("valid rotation then replayed", func(t *testing.T) {
+		r, store, nextPub := setup()
+		req := &common.RotateCloudAgentKeyRequest{PublicKey: nextPub, NextKey: newCommitment()}
+		update := func(agent *datastore.CloudAgent) bool {
+			return bytes.Equal(agent.PublicKey, nextPub) && bytes.Equal(agent.NextKey, req.NextKey)
+		}
+		store.On("UpdateCloudAgent", mock.MatchedBy(update)).Return(nil).Once()
+
+		_, err := r.RotateCloudAgentKey(ctx, req)
+		require.NoError(t, err)
+
+		_, err = r.RotateCloudAgentKey(ctx, req)
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+		store.AssertExpectations(t)
+	})
+
+	t.Run("wrong next key", func(t *testing.T) {
+		r, store, _ := setup()
+		other, _, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+
+		_, err = r.RotateCloudAgentKey(ctx, &common.RotateCloudAgentKeyRequest{PublicKey: other, NextKey: newCommitment()})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+		store.AssertNotCalled(t, "UpdateCloudAgent", mock.Anything)
+	})
+
+	t.Run("missing next key", func(t *testing.T) {
+		r, store, nextPub := setup()
+
+		_, err := r.RotateCloudAgentKey(ctx, &common.RotateCloudAgentKeyRequest{PublicKey: nextPub})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		store.AssertNotCalled(t, "UpdateCloudAgent", mock.Anything)
+	})
+
+	t.Run("store error", func(t *testing.T) {
+		r, store, nextPub := setup()
+		store.On("UpdateCloudAgent", mock.Anything).Return(errors.New("boom"))
+
+		_, err := r.RotateCloudAgentKey(ctx, &common.RotateCloudAgentKeyRequest{PublicKey: nextPub, NextKey: newCommitment()})
+		require.Equal(t, codes.Internal, status.Code(err))
+	})
+}
+
+func TestNextKeyMatches(t *testing.T) {
+	pub, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	other, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	commit := sha256.Sum256(pub)
+
+	require.True(t, nextKeyMatches(commit[:], pub))
+	require.True(t, nextKeyMatches(pub, pub))
+	require.False(t, nextKeyMatches(commit[:], other))
+	require.False(t, nextKeyMatches(nil, pub))
+	require.False(t, nextKeyMatches(commit[:], commit[:16]))
+}
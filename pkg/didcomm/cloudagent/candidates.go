@@ -0,0 +1,460 @@
+package cloudagent
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/scoir/canis/pkg/credential/engine/indy"
+	"github.com/scoir/canis/pkg/credential/engine/lds"
+	"github.com/scoir/canis/pkg/datastore"
+	ppindy "github.com/scoir/canis/pkg/presentproof/engine/indy"
+	"github.com/scoir/canis/pkg/presentproof/engine/jsonld"
+	"github.com/scoir/canis/pkg/protogen/common"
+	"github.com/scoir/canis/pkg/schema"
+)
+
+// heldCredential is an issued credential of a cloud agent decoded for matching against proof requests
+type heldCredential struct {
+	*datastore.CloudAgentCredential
+	indy *schema.IndyCredential
+	vc   map[string]interface{}
+}
+
+// candidates are the credentials that satisfy each referent of a proof request
+type candidates map[string][]*heldCredential
+
+func (r *CloudAgent) GetProofRequestCandidates(ctx context.Context, request *common.GetProofRequestCandidatesRequest) (*common.GetProofRequestCandidatesResponse, error) {
+	cloudAgentID := r.getAgentID(ctx)
+
+	cloudAgent, err := r.store.GetCloudAgent(cloudAgentID)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "agent with id %s not found", cloudAgentID)
+	}
+
+	pr, err := r.store.GetCloudAgentProofRequest(cloudAgent, request.ProofRequestId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "proof request with id %s not found", request.ProofRequestId)
+	}
+
+	creds, err := r.store.ListCloudAgentCredentials(cloudAgent)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to load credentials for agent with id %s", cloudAgentID)
+	}
+
+	held := heldCredentials(creds)
+
+	out := &common.GetProofRequestCandidatesResponse{
+		ProofRequestId: pr.ID,
+		Format:         pr.Format,
+	}
+
+	switch pr.Format {
+	case ppindy.Format:
+		proofReq, err := indyProofRequest(pr.RequestPresentation)
+		if err != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "invalid indy proof request: (%v)", err)
+		}
+
+		out.RequestedAttributes, out.RequestedPredicates = indyCandidates(proofReq, held)
+	case jsonld.DIFPresentationExchange:
+		d, err := requestAttachment(pr.RequestPresentation, jsonld.DIFPresentationExchange)
+		if err != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "invalid presentation request: (%v)", err)
+		}
+
+		difReq := &jsonld.RequestPresentation{}
+		err = json.Unmarshal(d, difReq)
+		if err != nil || difReq.Definitions == nil {
+			return nil, status.Error(codes.FailedPrecondition, "invalid presentation definitions")
+		}
+
+		out.InputDescriptors = difCandidates(difReq, held)
+	default:
+		return nil, status.Errorf(codes.Unimplemented, "proof request format %s not supported", pr.Format)
+	}
+
+	return out, nil
+}
+
+// heldCredentials decodes the issued credentials in creds, skipping offers and credentials that can not be read
+func heldCredentials(creds []*datastore.CloudAgentCredential) []*heldCredential {
+	var out []*heldCredential
+	for _, cred := range creds {
+		if cred.Credential == nil {
+			continue
+		}
+
+		h := &heldCredential{CloudAgentCredential: cred}
+		switch cred.Format {
+		case indy.Indy:
+			h.indy = &schema.IndyCredential{}
+			if json.Unmarshal(cred.Credential.Data, h.indy) != nil {
+				continue
+			}
+		case lds.LinkedDataSignature:
+			if json.Unmarshal(cred.Credential.Data, &h.vc) != nil {
+				continue
+			}
+		default:
+			continue
+		}
+
+		out = append(out, h)
+	}
+
+	return out
+}
+
+func indyCandidates(proofReq *schema.IndyProofRequest, held []*heldCredential) ([]*common.ReferentCandidates, []*common.ReferentCandidates) {
+	attrs := candidates{}
+	for referent, attr := range proofReq.RequestedAttributes {
+		names := attrNames(attr.Name, attr.Names)
+		for _, h := range held {
+			if h.indy != nil && h.hasAttrs(names) && matchesRestrictions(attr.Restrictions, h.indyTags()) {
+				attrs[referent] = append(attrs[referent], h)
+			}
+		}
+	}
+
+	preds := candidates{}
+	for referent, pred := range proofReq.RequestedPredicates {
+		for _, h := range held {
+			if h.indy != nil && h.satisfies(pred) && matchesRestrictions(pred.Restrictions, h.indyTags()) {
+				preds[referent] = append(preds[referent], h)
+			}
+		}
+	}
+
+	scores := score(attrs, preds)
+
+	var referents []string
+	for referent := range proofReq.RequestedAttributes {
+		referents = append(referents, referent)
+	}
+	sort.Strings(referents)
+
+	var outAttrs []*common.ReferentCandidates
+	for _, referent := range referents {
+		attr := proofReq.RequestedAttributes[referent]
+		names := attrNames(attr.Name, attr.Names)
+		outAttrs = append(outAttrs, &common.ReferentCandidates{
+			Referent:       referent,
+			Name:           attr.Name,
+			Names:          attr.Names,
+			SelfAttestable: attr.Restrictions == nil,
+			Candidates:     ranked(attrs[referent], scores, names),
+		})
+	}
+
+	referents = nil
+	for referent := range proofReq.RequestedPredicates {
+		referents = append(referents, referent)
+	}
+	sort.Strings(referents)
+
+	var outPreds []*common.ReferentCandidates
+	for _, referent := range referents {
+		pred := proofReq.RequestedPredicates[referent]
+		outPreds = append(outPreds, &common.ReferentCandidates{
+			Referent:   referent,
+			Name:       pred.Name,
+			Predicate:  fmt.Sprintf("%s %s %d", pred.Name, pred.PType, pred.PValue),
+			Candidates: ranked(preds[referent], scores, nil),
+		})
+	}
+
+	return outAttrs, outPreds
+}
+
+func difCandidates(req *jsonld.RequestPresentation, held []*heldCredential) []*common.ReferentCandidates {
+	descriptors := candidates{}
+	for _, desc := range req.Definitions.InputDescriptors {
+		for _, h := range held {
+			if h.vc != nil && desc.Schema != nil && h.hasSchema(desc.Schema.URI) {
+				descriptors[desc.ID] = append(descriptors[desc.ID], h)
+			}
+		}
+	}
+
+	scores := score(descriptors)
+
+	var out []*common.ReferentCandidates
+	for _, desc := range req.Definitions.InputDescriptors {
+		rc := &common.ReferentCandidates{
+			Referent:   desc.ID,
+			Candidates: ranked(descriptors[desc.ID], scores, nil),
+		}
+
+		if desc.Schema != nil {
+			rc.Name = desc.Schema.Name
+			rc.Purpose = desc.Schema.Purpose
+		}
+
+		out = append(out, rc)
+	}
+
+	return out
+}
+
+// score counts the referents each credential can satisfy so that a credential answering more of a request
+// ranks ahead of one that would need to be combined with others
+func score(all ...candidates) map[string]int32 {
+	out := map[string]int32{}
+	for _, c := range all {
+		for _, held := range c {
+			for _, h := range held {
+				out[h.ID]++
+			}
+		}
+	}
+
+	return out
+}
+
+// ranked orders held by score and then by most recently issued
+func ranked(held []*heldCredential, scores map[string]int32, names []string) []*common.CandidateCredential {
+	sort.SliceStable(held, func(i, j int) bool {
+		if scores[held[i].ID] != scores[held[j].ID] {
+			return scores[held[i].ID] > scores[held[j].ID]
+		}
+
+		return held[i].Credential.LastModTime.After(held[j].Credential.LastModTime)
+	})
+
+	out := make([]*common.CandidateCredential, len(held))
+	for i, h := range held {
+		out[i] = &common.CandidateCredential{
+			CredentialId: h.ID,
+			Score:        scores[h.ID],
+		}
+
+		if h.IssuerConnection != nil {
+			out[i].IssuerLabel = h.IssuerConnection.Name
+		}
+
+		if h.Offer != nil {
+			out[i].Comment = h.Offer.Comment
+		}
+
+		if len(names) > 0 {
+			out[i].Values = map[string]string{}
+			for _, name := range names {
+				if val, ok := h.indyValue(name); ok {
+					out[i].Values[name] = val.Raw
+				}
+			}
+		}
+	}
+
+	return out
+}
+
+func (r *heldCredential) indyValue(name string) (*schema.IndyAttributeValue, bool) {
+	for attr, val := range r.indy.Values {
+		if normalizeAttr(attr) == normalizeAttr(name) {
+			return val, true
+		}
+	}
+
+	return nil, false
+}
+
+func (r *heldCredential) hasAttrs(names []string) bool {
+	for _, name := range names {
+		if _, ok := r.indyValue(name); !ok {
+			return false
+		}
+	}
+
+	return len(names) > 0
+}
+
+func (r *heldCredential) satisfies(pred schema.IndyProofRequestPredicate) bool {
+	val, ok := r.indyValue(pred.Name)
+	if !ok {
+		return false
+	}
+
+	v, err := strconv.ParseInt(val.Raw, 10, 32)
+	if err != nil {
+		return false
+	}
+
+	target := int64(pred.PValue)
+	switch pred.PType {
+	case ">=":
+		return v >= target
+	case ">":
+		return v > target
+	case "<=":
+		return v <= target
+	case "<":
+		return v < target
+	}
+
+	return false
+}
+
+// indyTags are the WQL tags an Indy wallet would record for the credential
+func (r *heldCredential) indyTags() map[string]string {
+	tags := map[string]string{
+		"schema_id":   r.indy.SchemaID,
+		"cred_def_id": r.indy.CredDefID,
+	}
+
+	parts := strings.Split(r.indy.SchemaID, ":")
+	if len(parts) == 4 {
+		tags["schema_issuer_did"] = parts[0]
+		tags["schema_name"] = parts[2]
+		tags["schema_version"] = parts[3]
+	}
+
+	parts = strings.Split(r.indy.CredDefID, ":")
+	if len(parts) >= 5 {
+		tags["issuer_did"] = parts[0]
+	}
+
+	for name, val := range r.indy.Values {
+		tags[fmt.Sprintf("attr::%s::marker", normalizeAttr(name))] = "1"
+		tags[fmt.Sprintf("attr::%s::value", normalizeAttr(name))] = val.Raw
+	}
+
+	return tags
+}
+
+// hasSchema reports whether uri is the credential schema, a type or a context of the verifiable credential
+func (r *heldCredential) hasSchema(uri string) bool {
+	if uri == "" {
+		return false
+	}
+
+	for _, key := range []string{"credentialSchema", "type", "@context"} {
+		for _, v := range jsonValues(r.vc[key]) {
+			if v == uri {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// matchesRestrictions evaluates Indy proof request restrictions against tags.  A list of restrictions is
+// satisfied by any of them and a single restriction by all of its fields.  $or, $and and $not are supported and
+// fields that are not known never match
+func matchesRestrictions(restrictions interface{}, tags map[string]string) bool {
+	switch r := restrictions.(type) {
+	case nil:
+		return true
+	case []interface{}:
+		if len(r) == 0 {
+			return true
+		}
+
+		for _, restriction := range r {
+			if matchesRestrictions(restriction, tags) {
+				return true
+			}
+		}
+
+		return false
+	case map[string]interface{}:
+		for field, val := range r {
+			var ok bool
+			switch field {
+			case "$or":
+				ok = matchesAny(val, tags)
+			case "$and":
+				ok = matchesAll(val, tags)
+			case "$not":
+				ok = !matchesRestrictions(val, tags)
+			default:
+				var tag string
+				tag, ok = tags[normalizeTag(field)]
+				ok = ok && tag == fmt.Sprint(val)
+			}
+
+			if !ok {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	return false
+}
+
+func matchesAny(restrictions interface{}, tags map[string]string) bool {
+	list, ok := restrictions.([]interface{})
+	if !ok {
+		return false
+	}
+
+	return matchesRestrictions(list, tags)
+}
+
+func matchesAll(restrictions interface{}, tags map[string]string) bool {
+	list, ok := restrictions.([]interface{})
+	if !ok {
+		return false
+	}
+
+	for _, restriction := range list {
+		if !matchesRestrictions(restriction, tags) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func normalizeTag(field string) string {
+	parts := strings.Split(field, "::")
+	if len(parts) == 3 && parts[0] == "attr" {
+		parts[1] = normalizeAttr(parts[1])
+		return strings.Join(parts, "::")
+	}
+
+	return field
+}
+
+// normalizeAttr compares attribute names the way Indy does, ignoring case and spaces
+func normalizeAttr(name string) string {
+	return strings.ToLower(strings.ReplaceAll(name, " ", ""))
+}
+
+func attrNames(name string, names []string) []string {
+	if name != "" {
+		return []string{name}
+	}
+
+	return names
+}
+
+// jsonValues returns the strings in v, which may be a string, an object with an id or a list of either
+func jsonValues(v interface{}) []string {
+	switch val := v.(type) {
+	case string:
+		return []string{val}
+	case map[string]interface{}:
+		if id, ok := val["id"].(string); ok {
+			return []string{id}
+		}
+	case []interface{}:
+		var out []string
+		for _, item := range val {
+			out = append(out, jsonValues(item)...)
+		}
+		return out
+	}
+
+	return nil
+}
@@ -0,0 +1,149 @@
+package cloudagent
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/aries-framework-go/pkg/doc/presexch"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scoir/canis/pkg/credential/engine/indy"
+	"github.com/scoir/canis/pkg/credential/engine/lds"
+	"github.com/scoir/canis/pkg/datastore"
+	"github.com/scoir/canis/pkg/presentproof/engine/jsonld"
+	"github.com/scoir/canis/pkg/schema"
+)
+
+func indyHeld(t *testing.T, id, schemaID, credDefID string, issued time.Time, values map[string]string) *datastore.CloudAgentCredential {
+	cred := &schema.IndyCredential{
+		SchemaID:  schemaID,
+		CredDefID: credDefID,
+		Values:    schema.IndyCredentialValues{},
+	}
+	for k, v := range values {
+		cred.Values[k] = &schema.IndyAttributeValue{Raw: v}
+	}
+
+	d, err := json.Marshal(cred)
+	require.NoError(t, err)
+
+	return &datastore.CloudAgentCredential{
+		ID:               id,
+		Format:           indy.Indy,
+		IssuerConnection: &datastore.IDName{Name: "Issuer " + id},
+		Credential:       &datastore.Credential{LastModTime: issued, Data: d},
+	}
+}
+
+func TestIndyCandidates(t *testing.T) {
+	now := time.Now()
+	held := heldCredentials([]*datastore.CloudAgentCredential{
+		indyHeld(t, "license", "Vnd9TKYd3VRqLCJEuiUUNu:2:license:1.0", "Vnd9TKYd3VRqLCJEuiUUNu:3:CL:10:default",
+			now.Add(-time.Hour), map[string]string{"name": "Alice", "age": "30"}),
+		indyHeld(t, "student", "7Ty4qaC8XZPmF7N1A7YnDS:2:student:1.0", "7Ty4qaC8XZPmF7N1A7YnDS:3:CL:12:default",
+			now, map[string]string{"Name": "Alice", "age": "17"}),
+		{ID: "offered", Format: indy.Indy},
+	})
+	require.Len(t, held, 2)
+
+	var restrictions interface{}
+	require.NoError(t, json.Unmarshal([]byte(`[{"issuer_did":"Vnd9TKYd3VRqLCJEuiUUNu"},{"schema_name":"passport"}]`), &restrictions))
+
+	proofReq := &schema.IndyProofRequest{
+		RequestedAttributes: map[string]schema.IndyProofRequestAttr{
+			"attr1_referent": {Name: "name"},
+			"attr2_referent": {Name: "age", Restrictions: restrictions},
+		},
+		RequestedPredicates: map[string]schema.IndyProofRequestPredicate{
+			"pred1_referent": {Name: "age", PType: ">=", PValue: 18},
+		},
+	}
+
+	attrs, preds := indyCandidates(proofReq, held)
+	require.Len(t, attrs, 2)
+
+	require.Equal(t, "attr1_referent", attrs[0].Referent)
+	require.True(t, attrs[0].SelfAttestable)
+	require.Len(t, attrs[0].Candidates, 2)
+	require.Equal(t, "license", attrs[0].Candidates[0].CredentialId)
+	require.Equal(t, int32(3), attrs[0].Candidates[0].Score)
+	require.Equal(t, "Alice", attrs[0].Candidates[0].Values["name"])
+	require.Equal(t, "student", attrs[0].Candidates[1].CredentialId)
+
+	require.False(t, attrs[1].SelfAttestable)
+	require.Len(t, attrs[1].Candidates, 1)
+	require.Equal(t, "license", attrs[1].Candidates[0].CredentialId)
+
+	require.Len(t, preds, 1)
+	require.Equal(t, "age >= 18", preds[0].Predicate)
+	require.Len(t, preds[0].Candidates, 1)
+	require.Equal(t, "license", preds[0].Candidates[0].CredentialId)
+}
+
+func TestDIFCandidates(t *testing.T) {
+	vc := func(id string, issued time.Time, typ string) *datastore.CloudAgentCredential {
+		return &datastore.CloudAgentCredential{
+			ID:     id,
+			Format: lds.LinkedDataSignature,
+			Credential: &datastore.Credential{
+				LastModTime: issued,
+				Data:        []byte(`{"@context":["https://www.w3.org/2018/credentials/v1"],"type":["VerifiableCredential","` + typ + `"]}`),
+			},
+		}
+	}
+
+	now := time.Now()
+	held := heldCredentials([]*datastore.CloudAgentCredential{
+		vc("old", now.Add(-time.Hour), "Clr"),
+		vc("new", now, "Clr"),
+		vc("other", now, "Badge"),
+	})
+
+	req := &jsonld.RequestPresentation{
+		Definitions: &presexch.PresentationDefinitions{
+			InputDescriptors: []*presexch.InputDescriptor{
+				{ID: "transcript", Schema: &presexch.Schema{URI: "Clr", Name: "Transcript"}},
+				{ID: "diploma", Schema: &presexch.Schema{URI: "Diploma"}},
+			},
+		},
+	}
+
+	out := difCandidates(req, held)
+	require.Len(t, out, 2)
+	require.Equal(t, "Transcript", out[0].Name)
+	require.Len(t, out[0].Candidates, 2)
+	require.Equal(t, "new", out[0].Candidates[0].CredentialId)
+	require.Equal(t, "old", out[0].Candidates[1].CredentialId)
+	require.Empty(t, out[1].Candidates)
+}
+
+func TestMatchesRestrictions(t *testing.T) {
+	tags := map[string]string{
+		"schema_name":             "license",
+		"issuer_did":              "Vnd9TKYd3VRqLCJEuiUUNu",
+		"attr::firstname::marker": "1",
+		"attr::firstname::value":  "Alice",
+	}
+
+	tests := []struct {
+		restrictions string
+		match        bool
+	}{
+		{`null`, true},
+		{`[]`, true},
+		{`{"schema_name":"license","issuer_did":"Vnd9TKYd3VRqLCJEuiUUNu"}`, true},
+		{`{"schema_name":"license","issuer_did":"other"}`, false},
+		{`[{"schema_name":"passport"},{"schema_name":"license"}]`, true},
+		{`{"$or":[{"schema_name":"passport"},{"issuer_did":"Vnd9TKYd3VRqLCJEuiUUNu"}]}`, true},
+		{`{"$not":{"schema_name":"license"}}`, false},
+		{`{"attr::First Name::value":"Alice"}`, true},
+		{`{"rev_reg_id":"unknown"}`, false},
+	}
+
+	for _, test := range tests {
+		var restrictions interface{}
+		require.NoError(t, json.Unmarshal([]byte(test.restrictions), &restrictions))
+		require.Equal(t, test.match, matchesRestrictions(restrictions, tags), test.restrictions)
+	}
+}
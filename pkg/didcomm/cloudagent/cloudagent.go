@@ -1,13 +1,10 @@
 package cloudagent
 
 import (
-	"bytes"
 	"context"
-	"crypto/ed25519"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
-	"io/ioutil"
 	"log"
 	"net"
 	"net/http"
@@ -30,6 +27,8 @@ import (
 	vdriapi "github.com/hyperledger/aries-framework-go/pkg/framework/aries/api/vdri"
 	ariescontext "github.com/hyperledger/aries-framework-go/pkg/framework/context"
 	"github.com/hyperledger/aries-framework-go/pkg/kms"
+	"github.com/hyperledger/aries-framework-go/pkg/store/connection"
+	didstore "github.com/hyperledger/aries-framework-go/pkg/store/did"
 	"github.com/hyperledger/indy-vdr/wrappers/golang/vdr"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
@@ -44,13 +43,20 @@ import (
 	"github.com/scoir/canis/pkg/datastore"
 	api "github.com/scoir/canis/pkg/didcomm/cloudagent/api/protogen"
 	"github.com/scoir/canis/pkg/didexchange"
+	"github.com/scoir/canis/pkg/enrollment"
 	"github.com/scoir/canis/pkg/framework"
+	indywrapper "github.com/scoir/canis/pkg/indy"
+	ppindy "github.com/scoir/canis/pkg/presentproof/engine/indy"
 	"github.com/scoir/canis/pkg/protogen/common"
+	"github.com/scoir/canis/pkg/push"
+	cursa "github.com/scoir/canis/pkg/ursa"
 )
 
 const (
-	CanisCloudAgentIDHeaderKey  = "x-canis-cloud-agent-id"
-	CanisCloudAgentSigHeaderKey = "x-canis-cloud-agent-signature"
+	CanisCloudAgentIDHeaderKey        = "x-canis-cloud-agent-id"
+	CanisCloudAgentSigHeaderKey       = "x-canis-cloud-agent-signature"
+	CanisCloudAgentTimestampHeaderKey = "x-canis-cloud-agent-timestamp"
+	CanisCloudAgentNonceHeaderKey     = "x-canis-cloud-agent-nonce"
 )
 
 type CloudAgent struct {
@@ -59,14 +65,34 @@ type CloudAgent struct {
 	external         string
 	vdriReg          vdriapi.Registry
 	bouncer          didexchange.Bouncer
-	credcl           *issuecredential.Client
-	prespcli         *ppclient.Client
+	credcl           credentialClient
+	prespcli         presentationClient
+	prover           *cursa.Prover
+	ledgers          func(namespace string) (indywrapper.IndyVDRClient, error)
+	connections      connectionRecorder
+	didConnections   didConnectionStore
+	didKeys          didKeyStore
 	keyMgr           kms.KeyManager
 	cloudAgentSecret string
 	grpcHost         string
 	grpcPort         int
 	grpcBridgeHost   string
 	grpcBridgePort   int
+	tls              *framework.TLSConfig
+	nonces           *nonceCache
+	gatewayToken     string
+	events           *EventHub
+}
+
+type credentialClient interface {
+	SendProposal(proposal *issuecredential.ProposeCredential, myDID, theirDID string) (string, error)
+	AcceptOffer(piID string) error
+	AcceptOfferWithRequest(piID string, msg *icprotocol.RequestCredential) error
+}
+
+type presentationClient interface {
+	SendProposePresentation(proposal *ppclient.ProposePresentation, myDID, theirDID string) (string, error)
+	AcceptRequestPresentation(piID string, msg *ppclient.Presentation) error
 }
 
 //go:generate mockery -name=provider --structname=Provider
@@ -77,14 +103,21 @@ type provider interface {
 	GetExternal() string
 	GetGRPCEndpoint() (*framework.Endpoint, error)
 	GetBridgeEndpoint() (*framework.Endpoint, error)
+	GetTLSConfig() (*framework.TLSConfig, error)
 
 	GetVDRClient() (*vdr.Client, error)
+	IndyLedger(namespace string) (indywrapper.IndyVDRClient, error)
+	GetEventHub() *EventHub
+	GetPushNotifier() push.Notifier
 }
 
 func New(ctx provider) (*CloudAgent, error) {
 	r := &CloudAgent{
 		external:         ctx.GetExternal(),
 		cloudAgentSecret: ctx.GetCloudAgentSecret(),
+		nonces:           newNonceCache(),
+		gatewayToken:     uuid.New().String(),
+		events:           ctx.GetEventHub(),
 	}
 
 	e, err := ctx.GetGRPCEndpoint()
@@ -103,6 +136,11 @@ func New(ctx provider) (*CloudAgent, error) {
 	r.grpcBridgeHost = e.Host
 	r.grpcBridgePort = e.Port
 
+	r.tls, err = ctx.GetTLSConfig()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get tls config")
+	}
+
 	store, err := ctx.GetDatastore()
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to datastore to start ariesmediator")
@@ -125,6 +163,17 @@ func New(ctx provider) (*CloudAgent, error) {
 	r.bouncer = bouncer
 	r.keyMgr = actx.KMS()
 	r.vdriReg = actx.VDRIRegistry()
+	r.didConnections = actx.DIDConnectionStore()
+
+	r.didKeys, err = actx.StorageProvider().OpenStore(didstore.StoreName)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to open DID connection store for cloud agent")
+	}
+
+	r.connections, err = connection.NewRecorder(actx)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create connection recorder for cloud agent")
+	}
 
 	credcl, err := issuecredential.New(actx)
 	if err != nil {
@@ -133,6 +182,18 @@ func New(ctx provider) (*CloudAgent, error) {
 
 	r.credcl = credcl
 
+	r.prespcli, err = ppclient.New(actx)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create present proof client for cloud agent")
+	}
+
+	r.prover, err = cursa.NewProver(actx)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create Ursa prover")
+	}
+
+	r.ledgers = ctx.IndyLedger
+
 	return r, nil
 }
 
@@ -145,8 +206,8 @@ func (r *CloudAgent) GetEndpoint(_ context.Context, _ *common.EndpointRequest) (
 	return &common.EndpointResponse{Endpoint: r.external}, nil
 }
 
-func (r *CloudAgent) accepted(invitationID string, cloudAgent *datastore.CloudAgent) func(id string, conn *ariesdidex.Connection) {
-	return func(id string, conn *ariesdidex.Connection) {
+func (r *CloudAgent) accepted(cloudAgent *datastore.CloudAgent) func(invitationID string, conn *ariesdidex.Connection) {
+	return func(invitationID string, conn *ariesdidex.Connection) {
 
 		ca, err := r.store.GetCloudAgentConnection(cloudAgent, invitationID)
 		if err != nil {
@@ -167,7 +228,9 @@ func (r *CloudAgent) accepted(invitationID string, cloudAgent *datastore.CloudAg
 			return
 		}
 
-		log.Printf("Successfully connected to cloud agent %s to connection %s", id, "succeeded!")
+		r.events.Publish(cloudAgent.ID, ConnectionCompletedEvent, conn.ConnectionID)
+
+		log.Printf("Successfully connected to cloud agent %s to connection %s", invitationID, "succeeded!")
 	}
 }
 
@@ -207,7 +270,14 @@ func (r *CloudAgent) launchGRPC() error {
 		log.Fatalf("failed to listen: %v", err)
 	}
 
-	grpcServer := grpc.NewServer()
+	opts, err := r.tls.ServerOptions()
+	if err != nil {
+		return errors.Wrap(err, "unable to configure grpc tls")
+	}
+
+	opts = append(opts, grpc.UnaryInterceptor(r.signedRequestInterceptor), grpc.StreamInterceptor(r.signedStreamInterceptor))
+
+	grpcServer := grpc.NewServer(opts...)
 	api.RegisterCloudAgentServer(grpcServer, r)
 	log.Println("GRPC Listening on ", addr)
 	return grpcServer.Serve(lis)
@@ -216,7 +286,7 @@ func (r *CloudAgent) launchGRPC() error {
 func (r *CloudAgent) launchWebBridge() error {
 	rmux := runtime.NewServeMux(
 		runtime.WithIncomingHeaderMatcher(func(h string) (string, bool) {
-			if strings.HasPrefix(h, "X-Canis") {
+			if strings.HasPrefix(h, "X-Canis") && !strings.EqualFold(h, gatewayHeaderKey) {
 				return h, true
 			}
 
@@ -231,9 +301,13 @@ func (r *CloudAgent) launchWebBridge() error {
 	}
 
 	endpoint := fmt.Sprintf("%s:%d", r.grpcHost, r.grpcPort)
-	opts := []grpc.DialOption{grpc.WithInsecure()}
+	creds, err := r.tls.DialOption()
+	if err != nil {
+		return errors.Wrap(err, "unable to configure grpc gateway tls")
+	}
 
-	err := api.RegisterCloudAgentHandlerFromEndpoint(context.Background(), rmux, endpoint, opts)
+	opts := []grpc.DialOption{creds, grpc.WithPerRPCCredentials(gatewayCredentials(r.gatewayToken))}
+	err = api.RegisterCloudAgentHandlerFromEndpoint(context.Background(), rmux, endpoint, opts)
 	if err != nil {
 		log.Println("unable to register admin gateway", err)
 	}
@@ -242,69 +316,12 @@ func (r *CloudAgent) launchWebBridge() error {
 	var h http.Handler = rmux
 	h = r.signedTokenAuth(rmux)
 	mux.Handle("/", h)
+	mux.Handle("/cloudagents/events", r.signedTokenAuth(http.HandlerFunc(r.eventsWebSocket)))
 
 	log.Printf("GRPC Web Gateway listening on %s\n", u)
 	return http.ListenAndServe(u, mux)
 }
 
-func (r *CloudAgent) signedTokenAuth(h http.Handler) http.HandlerFunc {
-	return func(w http.ResponseWriter, req *http.Request) {
-
-		if req.URL.Path == "/" && req.Method == http.MethodGet {
-			w.WriteHeader(http.StatusOK)
-			w.Write([]byte("ok"))
-			return
-		}
-
-		if req.URL.Path == "/cloudagents" && req.Method == http.MethodPost {
-			h.ServeHTTP(w, req)
-			return
-		}
-
-		cloudAgentID := req.Header.Get(CanisCloudAgentIDHeaderKey)
-		if cloudAgentID == "" {
-			log.Println("no id key")
-			http.Error(w, "Not authorized", 401)
-			return
-		}
-
-		sig := req.Header.Get(CanisCloudAgentSigHeaderKey)
-		if sig == "" {
-			log.Println("no sig key")
-			http.Error(w, "Not authorized", 401)
-			return
-		}
-
-		cloudAgent, err := r.store.GetCloudAgent(cloudAgentID)
-		if err != nil {
-			log.Println("error loading cloud agent", cloudAgentID, err)
-			http.Error(w, "Not authorized", 401)
-			return
-		}
-
-		var msg []byte
-		if req.Method == http.MethodGet || req.Method == http.MethodDelete {
-			msg = []byte(req.URL.EscapedPath())
-		} else {
-			msg, _ = ioutil.ReadAll(req.Body)
-			req.Body = ioutil.NopCloser(bytes.NewBuffer(msg))
-		}
-
-		bsig, err := base64.URLEncoding.DecodeString(sig)
-		if err != nil {
-			http.Error(w, "Not authorized", 401)
-			return
-		}
-
-		if ed25519.Verify(cloudAgent.PublicKey, msg, bsig) {
-			h.ServeHTTP(w, req)
-			return
-		}
-		http.Error(w, "Not authorized", 401)
-		return
-	}
-}
-
 func (r *CloudAgent) getAgentID(ctx context.Context) string {
 	md, _ := metadata.FromIncomingContext(ctx)
 	cloudAgentID := md.Get(CanisCloudAgentIDHeaderKey)
@@ -316,11 +333,13 @@ func (r *CloudAgent) getAgentID(ctx context.Context) string {
 
 func (r *CloudAgent) RegisterCloudAgent(_ context.Context, request *common.RegisterCloudAgentRequest) (*common.RegisterCloudAgentResponse, error) {
 
-	if request.Secret != r.cloudAgentSecret {
-		return nil, status.Error(codes.Unauthenticated, fmt.Sprintf("invalid cloud agent secret"))
+	err := enrollment.Authorize(r.store, enrollment.CloudAgent, request.ExternalId, request.EnrollmentToken,
+		request.Secret, r.cloudAgentSecret)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
 	}
 
-	id, err := r.store.RegisterCloudAgent(request.ExternalId, request.PublicKey, request.NextKey)
+	id, err := r.store.RegisterCloudAgent(request.ExternalId, request.PublicKey, request.NextKey, request.PushToken)
 	if err != nil {
 		return nil, status.Error(codes.Internal, fmt.Sprintf("unable to register edge agent with ID %s", request.ExternalId))
 	}
@@ -331,6 +350,32 @@ func (r *CloudAgent) RegisterCloudAgent(_ context.Context, request *common.Regis
 	return out, nil
 }
 
+func (r *CloudAgent) RotateCloudAgentKey(ctx context.Context, req *common.RotateCloudAgentKeyRequest) (*common.RotateCloudAgentKeyResponse, error) {
+	cloudAgentID := r.getAgentID(ctx)
+	agent, err := r.store.GetCloudAgent(cloudAgentID)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "agent with id %s not found", cloudAgentID)
+	}
+
+	if len(req.NextKey) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "next key is required")
+	}
+
+	if !nextKeyMatches(agent.NextKey, req.PublicKey) {
+		return nil, status.Error(codes.PermissionDenied, "public key does not match committed next key")
+	}
+
+	agent.PublicKey = req.PublicKey
+	agent.NextKey = req.NextKey
+
+	err = r.store.UpdateCloudAgent(agent)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to rotate key for agent %s", cloudAgentID)
+	}
+
+	return &common.RotateCloudAgentKeyResponse{}, nil
+}
+
 func (r *CloudAgent) AcceptInvitation(ctx context.Context, req *common.HandleInvitationRequest) (*common.HandleInvitationResponse, error) {
 
 	cloudAgentID := r.getAgentID(ctx)
@@ -365,7 +410,7 @@ func (r *CloudAgent) AcceptInvitation(ctx context.Context, req *common.HandleInv
 		return nil, status.Errorf(codes.Internal, "unexpected error creating cloud agent connection: (%v)", err)
 	}
 
-	err = r.bouncer.EstablishConnectionNotify(invite, r.accepted(invite.ID, agent), failed)
+	err = r.bouncer.EstablishConnectionNotify(invite, r.accepted(agent), failed)
 	if err != nil {
 		return nil, status.Errorf(codes.AlreadyExists, "error creating invitation for agent %s:  (%v)", agent.ID, err)
 	}
@@ -444,8 +489,14 @@ func (r *CloudAgent) ListConnections(ctx context.Context, _ *common.ListConnecti
 	}
 
 	for _, connection := range connections {
+		// invitations that have not been accepted are identified by invitation ID
+		id := connection.ConnectionID
+		if id == "" {
+			id = connection.InvitationID
+		}
+
 		out.Connections = append(out.Connections, &common.Connection{
-			Id:          connection.ConnectionID,
+			Id:          id,
 			Name:        connection.TheirLabel,
 			TheirDid:    connection.TheirDID,
 			MyDid:       connection.MyDID,
@@ -496,6 +547,10 @@ func (r *CloudAgent) ListCredentials(ctx context.Context, _ *common.ListCredenti
 			}
 		}
 
+		if len(cred.Offer.Data) == 0 {
+			continue
+		}
+
 		body := map[string]interface{}{}
 		err = json.Unmarshal(cred.Offer.Data, &body)
 		if err != nil {
@@ -531,9 +586,33 @@ func (r *CloudAgent) ListProofRequests(ctx context.Context, request *common.List
 	for i, pr := range prs {
 		out.Requests[i] = &common.ProofRequest{
 			ProofRequestId: pr.ID,
-			RequestPresentation: &common.RequestPresentation{
-				Name: pr.RequestPresentation.Comment,
-			},
+		}
+
+		if pr.RequestPresentation == nil {
+			continue
+		}
+
+		out.Requests[i].RequestPresentation = &common.RequestPresentation{
+			Name:   pr.RequestPresentation.Comment,
+			Format: pr.Format,
+		}
+
+		if pr.Format != ppindy.Format {
+			continue
+		}
+
+		proofReq, err := indyProofRequest(pr.RequestPresentation)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "load indy proof request: (%v)", err)
+		}
+
+		body := map[string]interface{}{}
+		d, _ := json.Marshal(proofReq)
+		_ = json.Unmarshal(d, &body)
+
+		out.Requests[i].Body, err = structpb.NewStruct(body)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "process proof request body: (%v)", err)
 		}
 	}
 
@@ -553,6 +632,36 @@ func (r *CloudAgent) PresentProof(ctx context.Context, request *common.PresentPr
 		return nil, status.Errorf(codes.InvalidArgument, "proof request with id %s not found", request.ProofRequestId)
 	}
 
+	if pr.RequestPresentation == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "proof request %s has not been received", request.ProofRequestId)
+	}
+
+	var pres *ppclient.Presentation
+	switch pr.Format {
+	case ppindy.Format:
+		pres, err = r.indyPresentation(cloudAgent, pr, request)
+	default:
+		pres, err = r.ldsPresentation(cloudAgent, pr, request.CredentialId)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.prespcli.AcceptRequestPresentation(pr.ThreadID, pres)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to accept present presentation: (%v)", err)
+	}
+
+	pr.SystemState = "presented"
+	err = r.store.UpdateCloudAgentProofRequest(pr)
+	if err != nil {
+		log.Println("unable to update presented proof request", err)
+	}
+
+	return &common.PresentProofResponse{}, nil
+}
+
+func (r *CloudAgent) ldsPresentation(cloudAgent *datastore.CloudAgent, pr *datastore.CloudAgentProofRequest, credentialID string) (*ppclient.Presentation, error) {
 	//TODO: fix these hard coded values
 	vp := &verifiable.Presentation{
 		Context: []string{
@@ -564,22 +673,22 @@ func (r *CloudAgent) PresentProof(ctx context.Context, request *common.PresentPr
 
 	doc, err := r.vdriReg.Resolve(pr.MyDID)
 	if err != nil {
-		log.Fatalln("unable to load my did doc")
+		return nil, status.Errorf(codes.Internal, "unable to load my did doc: (%v)", err)
 	}
 
 	signer, err := r.newCryptoSigner(doc.PublicKey[0].ID[1:])
 	if err != nil {
-		log.Fatalln(err)
+		return nil, status.Errorf(codes.Internal, "unable to load signer: (%v)", err)
 	}
 
-	vc, err := r.store.GetCloudAgentCredential(cloudAgent, request.CredentialId)
+	vc, err := r.store.GetCloudAgentCredential(cloudAgent, credentialID)
 	if err != nil {
-		log.Fatalln("unable to get credential", err)
+		return nil, status.Errorf(codes.InvalidArgument, "credential with id %s not found", credentialID)
 	}
 
 	err = vp.SetCredentials(vc)
 	if err != nil {
-		log.Fatalln("unable to set credentials on the presentation", err)
+		return nil, status.Errorf(codes.Internal, "unable to set credentials on the presentation: (%v)", err)
 	}
 
 	sigSuite := ed25519signature2018.New(
@@ -595,10 +704,10 @@ func (r *CloudAgent) PresentProof(ctx context.Context, request *common.PresentPr
 
 	err = vp.AddLinkedDataProof(ldpContext)
 	if err != nil {
-		log.Fatalln("unable to add linked data proof", err)
+		return nil, status.Errorf(codes.Internal, "unable to add linked data proof: (%v)", err)
 	}
 
-	pres := &ppclient.Presentation{
+	return &ppclient.Presentation{
 		PresentationsAttach: []decorator.Attachment{
 			{
 				Data: decorator.AttachmentData{
@@ -606,14 +715,7 @@ func (r *CloudAgent) PresentProof(ctx context.Context, request *common.PresentPr
 				},
 			},
 		},
-	}
-
-	err = r.prespcli.AcceptRequestPresentation(pr.ThreadID, pres)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "unable to accept present presentation: (%v)", err)
-	}
-
-	return &common.PresentProofResponse{}, nil
+	}, nil
 }
 
 func (r *CloudAgent) newCryptoSigner(kid string) (*subtle.ED25519Signer, error) {
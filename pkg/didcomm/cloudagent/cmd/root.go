@@ -8,10 +8,12 @@ package cmd
 
 import (
 	"fmt"
+	"io"
 	"io/ioutil"
 	"log"
 	"os"
 	"strings"
+	"sync"
 
 	"github.com/hyperledger/aries-framework-go/pkg/didcomm/transport/ws"
 	"github.com/hyperledger/aries-framework-go/pkg/framework/aries"
@@ -25,8 +27,11 @@ import (
 
 	"github.com/scoir/canis/pkg/config"
 	"github.com/scoir/canis/pkg/datastore"
+	"github.com/scoir/canis/pkg/didcomm/cloudagent"
 	"github.com/scoir/canis/pkg/framework"
 	"github.com/scoir/canis/pkg/framework/context"
+	indywrapper "github.com/scoir/canis/pkg/indy"
+	"github.com/scoir/canis/pkg/push"
 )
 
 var (
@@ -48,6 +53,11 @@ type Provider struct {
 	ariesStorageProvider storage.Provider
 	conf                 config.Config
 	actx                 *ariescontext.Provider
+	events               *cloudagent.EventHub
+	push                 push.Notifier
+	poolsOnce            sync.Once
+	pools                *indywrapper.Pools
+	ledgerCache          *indywrapper.LedgerCache
 }
 
 func (r *Provider) GetVDRClient() (*vdr.Client, error) {
@@ -61,6 +71,50 @@ func (r *Provider) GetVDRClient() (*vdr.Client, error) {
 	return cl, nil
 }
 
+// IndyLedger returns the client for the ledger with the did:indy namespace, or the default ledger if empty
+func (r *Provider) IndyLedger(namespace string) (indywrapper.IndyVDRClient, error) {
+	cl, err := r.LedgerPools().Client(namespace)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get indy vdr client")
+	}
+
+	return cl, nil
+}
+
+// LedgerPools returns the configured ledger pools, refreshed on the configured schedule
+func (r *Provider) LedgerPools() *indywrapper.Pools {
+	r.poolsOnce.Do(func() {
+		ledgers, err := r.conf.Ledgers()
+		if err != nil {
+			log.Fatalln("invalid ledgers configuration", err)
+		}
+
+		lc, err := r.conf.LedgerCache()
+		if err != nil {
+			log.Fatalln("invalid ledgerCache configuration", err)
+		}
+		r.ledgerCache = indywrapper.NewLedgerCache(lc)
+
+		pc, err := r.conf.PoolRefresh()
+		if err != nil {
+			log.Fatalln("invalid poolRefresh configuration", err)
+		}
+		r.pools = indywrapper.NewPools(ledgers, r.conf.GetString("registry.indy.genesisFile"), r.openLedger)
+		r.pools.Monitor(pc)
+	})
+
+	return r.pools
+}
+
+func (r *Provider) openLedger(namespace string, genesis io.ReadCloser) (indywrapper.IndyVDRClient, error) {
+	cl, err := vdr.New(genesis)
+	if err != nil {
+		return nil, err
+	}
+
+	return indywrapper.NewCachingClient(indywrapper.NewTracedClient(cl), r.ledgerCache, namespace), nil
+}
+
 func Execute() {
 	if err := rootCmd.Execute(); err != nil {
 		fmt.Println(err)
@@ -106,11 +160,18 @@ func initConfig() {
 		log.Fatalln("invalid ledgerstore key in configuration")
 	}
 
+	pc, err := conf.Push()
+	if err != nil {
+		log.Fatalln("invalid push key in configuration", err)
+	}
+
 	ls := mongodbstore.NewProvider(lc.URL, mongodbstore.WithDBPrefix("canis-cloudagent"))
 	ctx = &Provider{
 		store:                store,
 		ariesStorageProvider: ls,
 		conf:                 conf,
+		events:               cloudagent.NewEventHub(),
+		push:                 push.New(pc),
 	}
 }
 
@@ -137,6 +198,21 @@ func (r *Provider) GetBridgeEndpoint() (*framework.Endpoint, error) {
 	return r.conf.Endpoint("cloudagent.grpcBridge")
 }
 
+// GetTLSConfig returns the mutual TLS settings for the GRPC server
+func (r *Provider) GetTLSConfig() (*framework.TLSConfig, error) {
+	return r.conf.TLS()
+}
+
+// GetEventHub returns the hub shared by the handlers that persist protocol messages and the clients streaming events
+func (r *Provider) GetEventHub() *cloudagent.EventHub {
+	return r.events
+}
+
+// GetPushNotifier returns the notifier that wakes up devices when an offer or proof request arrives
+func (r *Provider) GetPushNotifier() push.Notifier {
+	return r.push
+}
+
 // GetExternal returns the external endpoint
 func (r *Provider) GetExternal() string {
 	return r.conf.GetString("inbound.external")
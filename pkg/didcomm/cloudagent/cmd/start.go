@@ -31,7 +31,7 @@ func runStart(_ *cobra.Command, _ []string) {
 	}
 
 	actx, _ := ctx.GetAriesContext()
-	pofHandler, err := cloudagent.NewProofHandler(actx)
+	pofHandler, err := cloudagent.NewProofHandler(ctx)
 	if err != nil {
 		log.Fatalln("unable to create proof handler", err)
 	}
@@ -0,0 +1,270 @@
+package cloudagent
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"github.com/btcsuite/btcutil/base58"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/did"
+	"github.com/hyperledger/aries-framework-go/pkg/storage"
+	"github.com/pkg/errors"
+	"github.com/skip2/go-qrcode"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/scoir/canis/pkg/datastore"
+	"github.com/scoir/canis/pkg/protogen/common"
+)
+
+// didKeyStore is the Aries store of DIDComm keys to the DID they belong to, populated by SaveDIDFromDoc
+type didKeyStore interface {
+	Get(k string) ([]byte, error)
+	Delete(k string) error
+}
+
+// CreateInvitation creates a DIDComm invitation from the calling cloud agent that any peer can accept
+func (r *CloudAgent) CreateInvitation(ctx context.Context, request *common.CreateInvitationRequest) (*common.CreateInvitationResponse, error) {
+	cloudAgentID := r.getAgentID(ctx)
+	agent, err := r.store.GetCloudAgent(cloudAgentID)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "agent with id %s not found", cloudAgentID)
+	}
+
+	invite, err := r.bouncer.CreateInvitationNotify(request.Label, r.accepted(agent), failed)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error creating invitation for agent %s: (%v)", agent.ID, err)
+	}
+
+	ac := &datastore.CloudAgentConnection{
+		CloudAgentID: cloudAgentID,
+		InvitationID: invite.ID,
+		MyLabel:      request.Label,
+		Status:       "invited",
+		LastUpdated:  time.Now(),
+	}
+
+	err = r.store.InsertCloudAgentConnection(ac)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unexpected error creating cloud agent connection: (%v)", err)
+	}
+
+	d, err := json.Marshal(invite)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to marshal invitation: (%v)", err)
+	}
+
+	return &common.CreateInvitationResponse{
+		InvitationId: invite.ID,
+		Invitation:   base64.URLEncoding.EncodeToString(d),
+	}, nil
+}
+
+// CreateInvitationImage creates an invitation and returns it as a QR code
+func (r *CloudAgent) CreateInvitationImage(ctx context.Context, request *common.CreateInvitationRequest) (*httpbody.HttpBody, error) {
+	resp, err := r.CreateInvitation(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	qr, err := qrcode.Encode(resp.Invitation, qrcode.Medium, 256)
+	if err != nil {
+		return nil, status.Error(codes.Internal, errors.Wrapf(err, "unable to encode invitation QR code").Error())
+	}
+
+	return &httpbody.HttpBody{
+		ContentType: "image/png",
+		Data:        qr,
+	}, nil
+}
+
+// DeleteConnection deletes a connection of the calling cloud agent and, depending on the retention requested,
+// the credentials and proof requests exchanged over it.  Invitations that have not been accepted are
+// deleted by invitation ID
+func (r *CloudAgent) DeleteConnection(ctx context.Context, request *common.DeleteConnectionRequest) (*common.DeleteConnectionResponse, error) {
+	cloudAgentID := r.getAgentID(ctx)
+	cloudAgent, err := r.store.GetCloudAgent(cloudAgentID)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "agent with id %s not found", cloudAgentID)
+	}
+
+	conn, err := r.getConnectionOrInvitation(cloudAgent, request.ConnectionId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	// an invitation that has not been accepted has no Aries connection and nothing exchanged over it
+	if conn.ConnectionID == "" {
+		err = r.store.DeleteCloudAgentInvitation(cloudAgent, conn.InvitationID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "unable to delete invitation %s: (%v)", conn.InvitationID, err)
+		}
+
+		return &common.DeleteConnectionResponse{}, nil
+	}
+
+	// the Aries record and keys go first, so a failure leaves the connection listed for the delete to be retried
+	err = r.removeConnection(conn)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to remove connection %s: (%v)", conn.ConnectionID, err)
+	}
+
+	out := &common.DeleteConnectionResponse{}
+	if request.Retention != common.DeleteConnectionRequest_KEEP_ALL {
+		keepIssued := request.Retention == common.DeleteConnectionRequest_KEEP_ISSUED
+
+		creds, err := r.store.ListCloudAgentCredentials(cloudAgent)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "unable to load credentials: (%v)", err)
+		}
+
+		for _, cred := range creds {
+			if cred.MyDID != conn.MyDID || cred.TheirDID != conn.TheirDID {
+				continue
+			}
+			if keepIssued && cred.SystemState == "issued" {
+				continue
+			}
+
+			err = r.store.DeleteCloudAgentCredential(cloudAgent, cred.ID)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "unable to delete credential %s: (%v)", cred.ID, err)
+			}
+			out.CredentialsDeleted++
+		}
+
+		prs, err := r.store.ListCloudAgentProofRequests(cloudAgent)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "unable to load proof requests: (%v)", err)
+		}
+
+		for _, pr := range prs {
+			if pr.MyDID != conn.MyDID || pr.TheirDID != conn.TheirDID {
+				continue
+			}
+			if keepIssued && pr.SystemState == "presented" {
+				continue
+			}
+
+			err = r.store.DeleteCloudAgentProofRequest(cloudAgent, pr.ID)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "unable to delete proof request %s: (%v)", pr.ID, err)
+			}
+			out.ProofRequestsDeleted++
+		}
+	}
+
+	err = r.store.DeleteCloudAgentConnection(cloudAgent, conn.ConnectionID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to delete connection %s: (%v)", conn.ConnectionID, err)
+	}
+
+	return out, nil
+}
+
+// getConnectionOrInvitation finds a connection of cloudAgent by connection ID, or an invitation that has not
+// been accepted yet by invitation ID
+func (r *CloudAgent) getConnectionOrInvitation(cloudAgent *datastore.CloudAgent, id string) (*datastore.CloudAgentConnection, error) {
+	conns, err := r.store.ListCloudAgentConnections(cloudAgent)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to load connections")
+	}
+
+	for _, conn := range conns {
+		if conn.ConnectionID != "" && conn.ConnectionID == id {
+			return conn, nil
+		}
+		if conn.ConnectionID == "" && conn.InvitationID != "" && conn.InvitationID == id {
+			return conn, nil
+		}
+	}
+
+	return nil, errors.Errorf("connection with id %s not found", id)
+}
+
+// removeConnection deletes the Aries connection record of conn and the key mappings of both of its DIDs
+func (r *CloudAgent) removeConnection(conn *datastore.CloudAgentConnection) error {
+	if conn.ConnectionID == "" {
+		return nil
+	}
+
+	for _, d := range []string{conn.MyDID, conn.TheirDID} {
+		if d == "" {
+			continue
+		}
+
+		doc, err := r.vdriReg.Resolve(d)
+		if err != nil {
+			return errors.Wrapf(err, "unable to resolve %s", d)
+		}
+
+		err = r.removeDIDKeys(doc)
+		if err != nil {
+			return err
+		}
+	}
+
+	err := r.connections.RemoveConnection(conn.ConnectionID)
+	if err != nil && !errors.Is(err, storage.ErrDataNotFound) {
+		return errors.Wrap(err, "unable to remove connection record")
+	}
+
+	return nil
+}
+
+// removeDIDKeys deletes the keys saved for doc by SaveDIDFromDoc
+func (r *CloudAgent) removeDIDKeys(doc *did.Doc) error {
+	return r.deleteDIDKeys(doc.ID, docKeys(doc))
+}
+
+func (r *CloudAgent) deleteDIDKeys(didID string, keys []string) error {
+	for _, key := range keys {
+		err := r.didKeys.Delete(key)
+		if err != nil && !errors.Is(err, storage.ErrDataNotFound) {
+			return errors.Wrapf(err, "unable to remove key of %s", didID)
+		}
+	}
+
+	return nil
+}
+
+// docKeys returns the keys SaveDIDFromDoc saves for doc
+func docKeys(doc *did.Doc) []string {
+	var keys []string
+	for _, svc := range doc.Service {
+		keys = append(keys, svc.RecipientKeys...)
+	}
+	for _, pk := range doc.PublicKey {
+		keys = append(keys, base58.Encode(pk.Value))
+	}
+
+	return keys
+}
+
+// UpdateConnectionLabel renames a connection of the calling cloud agent
+func (r *CloudAgent) UpdateConnectionLabel(ctx context.Context, request *common.UpdateConnectionLabelRequest) (*common.UpdateConnectionLabelResponse, error) {
+	cloudAgentID := r.getAgentID(ctx)
+	cloudAgent, err := r.store.GetCloudAgent(cloudAgentID)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "agent with id %s not found", cloudAgentID)
+	}
+
+	if request.Label == "" {
+		return nil, status.Error(codes.InvalidArgument, "label is required")
+	}
+
+	conn, err := r.getConnection(cloudAgent, request.ConnectionId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	conn.TheirLabel = request.Label
+	err = r.store.UpdateCloudAgentConnection(conn)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to update connection %s: (%v)", conn.ConnectionID, err)
+	}
+
+	return &common.UpdateConnectionLabelResponse{}, nil
+}
@@ -0,0 +1,336 @@
+package cloudagent
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+
+	"github.com/btcsuite/btcutil/base58"
+	ariesdidex "github.com/hyperledger/aries-framework-go/pkg/client/didexchange"
+	protocoldidex "github.com/hyperledger/aries-framework-go/pkg/didcomm/protocol/didexchange"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/did"
+	vdriMock "github.com/hyperledger/aries-framework-go/pkg/mock/vdri"
+	"github.com/hyperledger/aries-framework-go/pkg/storage"
+	"github.com/hyperledger/aries-framework-go/pkg/store/connection"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/scoir/canis/pkg/datastore"
+	"github.com/scoir/canis/pkg/datastore/mocks"
+	"github.com/scoir/canis/pkg/didexchange"
+	dmocks "github.com/scoir/canis/pkg/didexchange/mocks"
+	"github.com/scoir/canis/pkg/protogen/common"
+)
+
+type testConnectionRecorder struct {
+	records map[string]*connection.Record
+	removed []string
+	saveErr error
+}
+
+func (r *testConnectionRecorder) GetConnectionRecord(connectionID string) (*connection.Record, error) {
+	rec, ok := r.records[connectionID]
+	if !ok {
+		return nil, storage.ErrDataNotFound
+	}
+	return rec, nil
+}
+
+func (r *testConnectionRecorder) SaveConnectionRecord(record *connection.Record) error {
+	if r.saveErr != nil {
+		return r.saveErr
+	}
+	if r.records == nil {
+		r.records = map[string]*connection.Record{}
+	}
+	r.records[record.ConnectionID] = record
+	return nil
+}
+
+func (r *testConnectionRecorder) RemoveConnection(connectionID string) error {
+	if _, ok := r.records[connectionID]; !ok {
+		return storage.ErrDataNotFound
+	}
+	delete(r.records, connectionID)
+	r.removed = append(r.removed, connectionID)
+	return nil
+}
+
+type testDIDKeys struct {
+	existing map[string]bool
+	deleted  []string
+}
+
+func (r *testDIDKeys) Get(k string) ([]byte, error) {
+	if !r.existing[k] {
+		return nil, storage.ErrDataNotFound
+	}
+	return []byte("did"), nil
+}
+
+func (r *testDIDKeys) Delete(k string) error {
+	r.deleted = append(r.deleted, k)
+	return nil
+}
+
+func TestDeleteConnection(t *testing.T) {
+	agent := &datastore.CloudAgent{ID: "agent-id"}
+	conn := &datastore.CloudAgentConnection{CloudAgentID: "agent-id", ConnectionID: "conn-1", MyDID: "did:mine", TheirDID: "did:theirs"}
+	creds := []*datastore.CloudAgentCredential{
+		{ID: "issued", SystemState: "issued", MyDID: "did:mine", TheirDID: "did:theirs"},
+		{ID: "offered", SystemState: "offered", MyDID: "did:mine", TheirDID: "did:theirs"},
+		{ID: "other", SystemState: "offered", MyDID: "did:mine", TheirDID: "did:other"},
+	}
+	prs := []*datastore.CloudAgentProofRequest{
+		{ID: "presented", SystemState: "presented", MyDID: "did:mine", TheirDID: "did:theirs"},
+		{ID: "requested", SystemState: "requested", MyDID: "did:mine", TheirDID: "did:theirs"},
+	}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(CanisCloudAgentIDHeaderKey, "agent-id"))
+
+	doc := &did.Doc{
+		ID:        "did:mine",
+		Service:   []did.Service{{ID: "#agent", RecipientKeys: []string{"recipient-key"}}},
+		PublicKey: []did.PublicKey{{ID: "#key-1", Value: []byte("public-key")}},
+	}
+
+	setup := func() (*CloudAgent, *mocks.Store) {
+		store := &mocks.Store{}
+		store.On("GetCloudAgent", "agent-id").Return(agent, nil)
+		store.On("ListCloudAgentConnections", agent).Return([]*datastore.CloudAgentConnection{conn}, nil)
+		store.On("ListCloudAgentCredentials", agent).Return(creds, nil)
+		store.On("ListCloudAgentProofRequests", agent).Return(prs, nil)
+		store.On("DeleteCloudAgentConnection", agent, "conn-1").Return(nil)
+		return &CloudAgent{
+			store:       store,
+			vdriReg:     &vdriMock.MockVDRIRegistry{ResolveValue: doc},
+			connections: &testConnectionRecorder{records: map[string]*connection.Record{"conn-1": {ConnectionID: "conn-1"}}},
+			didKeys:     &testDIDKeys{},
+		}, store
+	}
+
+	t.Run("keep all", func(t *testing.T) {
+		target, store := setup()
+		resp, err := target.DeleteConnection(ctx, &common.DeleteConnectionRequest{ConnectionId: "conn-1"})
+		require.NoError(t, err)
+		require.Zero(t, resp.CredentialsDeleted)
+		store.AssertNotCalled(t, "ListCloudAgentCredentials", agent)
+		store.AssertCalled(t, "DeleteCloudAgentConnection", agent, "conn-1")
+	})
+
+	t.Run("removes aries connection and DID keys", func(t *testing.T) {
+		target, _ := setup()
+		_, err := target.DeleteConnection(ctx, &common.DeleteConnectionRequest{ConnectionId: "conn-1"})
+		require.NoError(t, err)
+		require.Equal(t, []string{"conn-1"}, target.connections.(*testConnectionRecorder).removed)
+
+		key := base58.Encode([]byte("public-key"))
+		require.Equal(t, []string{"recipient-key", key, "recipient-key", key}, target.didKeys.(*testDIDKeys).deleted)
+	})
+
+	t.Run("unresolvable DID", func(t *testing.T) {
+		target, store := setup()
+		target.vdriReg = &vdriMock.MockVDRIRegistry{ResolveErr: errors.New("not found")}
+		_, err := target.DeleteConnection(ctx, &common.DeleteConnectionRequest{
+			ConnectionId: "conn-1",
+			Retention:    common.DeleteConnectionRequest_DELETE_ALL,
+		})
+		require.Equal(t, codes.Internal, status.Code(err))
+		require.Empty(t, target.connections.(*testConnectionRecorder).removed)
+		store.AssertNotCalled(t, "DeleteCloudAgentConnection", agent, "conn-1")
+		store.AssertNotCalled(t, "ListCloudAgentCredentials", agent)
+	})
+
+	t.Run("pending invitation", func(t *testing.T) {
+		invite := &datastore.CloudAgentConnection{CloudAgentID: "agent-id", InvitationID: "invite-1", Status: "invited"}
+		store := &mocks.Store{}
+		store.On("GetCloudAgent", "agent-id").Return(agent, nil)
+		store.On("ListCloudAgentConnections", agent).Return([]*datastore.CloudAgentConnection{conn, invite}, nil)
+		store.On("DeleteCloudAgentInvitation", agent, "invite-1").Return(nil)
+		target := &CloudAgent{store: store, connections: &testConnectionRecorder{}, didKeys: &testDIDKeys{}}
+
+		resp, err := target.DeleteConnection(ctx, &common.DeleteConnectionRequest{
+			ConnectionId: "invite-1",
+			Retention:    common.DeleteConnectionRequest_DELETE_ALL,
+		})
+		require.NoError(t, err)
+		require.Zero(t, resp.CredentialsDeleted)
+		require.Empty(t, target.connections.(*testConnectionRecorder).removed)
+		store.AssertExpectations(t)
+		store.AssertNotCalled(t, "DeleteCloudAgentConnection", agent, mock.Anything)
+	})
+
+	t.Run("unknown connection", func(t *testing.T) {
+		target, _ := setup()
+		_, err := target.DeleteConnection(ctx, &common.DeleteConnectionRequest{ConnectionId: "conn-2"})
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("keep issued", func(t *testing.T) {
+		target, store := setup()
+		store.On("DeleteCloudAgentCredential", agent, "offered").Return(nil)
+		store.On("DeleteCloudAgentProofRequest", agent, "requested").Return(nil)
+
+		resp, err := target.DeleteConnection(ctx, &common.DeleteConnectionRequest{
+			ConnectionId: "conn-1",
+			Retention:    common.DeleteConnectionRequest_KEEP_ISSUED,
+		})
+		require.NoError(t, err)
+		require.Equal(t, int64(1), resp.CredentialsDeleted)
+		require.Equal(t, int64(1), resp.ProofRequestsDeleted)
+		store.AssertExpectations(t)
+	})
+
+	t.Run("delete all", func(t *testing.T) {
+		target, store := setup()
+		store.On("DeleteCloudAgentCredential", agent, "issued").Return(nil)
+		store.On("DeleteCloudAgentCredential", agent, "offered").Return(nil)
+		store.On("DeleteCloudAgentProofRequest", agent, "presented").Return(nil)
+		store.On("DeleteCloudAgentProofRequest", agent, "requested").Return(nil)
+
+		resp, err := target.DeleteConnection(ctx, &common.DeleteConnectionRequest{
+			ConnectionId: "conn-1",
+			Retention:    common.DeleteConnectionRequest_DELETE_ALL,
+		})
+		require.NoError(t, err)
+		require.Equal(t, int64(2), resp.CredentialsDeleted)
+		require.Equal(t, int64(2), resp.ProofRequestsDeleted)
+		store.AssertExpectations(t)
+	})
+}
+
+func TestCreateInvitation(t *testing.T) {
+	agent := &datastore.CloudAgent{ID: "agent-id"}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(CanisCloudAgentIDHeaderKey, "agent-id"))
+	invite := &ariesdidex.Invitation{Invitation: &protocoldidex.Invitation{ID: "invitation-1", Label: "my label"}}
+
+	t.Run("happy path", func(t *testing.T) {
+		store := &mocks.Store{}
+		store.On("GetCloudAgent", "agent-id").Return(agent, nil)
+		insert := func(ac *datastore.CloudAgentConnection) bool {
+			return ac.CloudAgentID == "agent-id" && ac.InvitationID == "invitation-1" && ac.MyLabel == "my label" &&
+				ac.Status == "invited"
+		}
+		store.On("InsertCloudAgentConnection", mock.MatchedBy(insert)).Return(nil)
+
+		var success didexchange.NotifySuccess
+		bouncer := &dmocks.Bouncer{}
+		bouncer.On("CreateInvitationNotify", "my label", mock.Anything, mock.Anything).Return(invite, nil).Run(func(args mock.Arguments) {
+			success = args.Get(1).(didexchange.NotifySuccess)
+		})
+
+		events := NewEventHub()
+		sub, cancel := events.Subscribe("agent-id")
+		defer cancel()
+
+		target := &CloudAgent{store: store, bouncer: bouncer, events: events}
+		resp, err := target.CreateInvitation(ctx, &common.CreateInvitationRequest{Label: "my label"})
+		require.NoError(t, err)
+		require.Equal(t, "invitation-1", resp.InvitationId)
+
+		d, err := base64.URLEncoding.DecodeString(resp.Invitation)
+		require.NoError(t, err)
+		decoded := &ariesdidex.Invitation{}
+		require.NoError(t, json.Unmarshal(d, decoded))
+		require.Equal(t, "invitation-1", decoded.ID)
+
+		ac := &datastore.CloudAgentConnection{CloudAgentID: "agent-id", InvitationID: "invitation-1", Status: "invited"}
+		store.On("GetCloudAgentConnection", agent, "invitation-1").Return(ac, nil)
+		update := func(ac *datastore.CloudAgentConnection) bool {
+			return ac.Status == "accepted" && ac.ConnectionID == "conn-1" && ac.TheirDID == "did:theirs"
+		}
+		store.On("UpdateCloudAgentConnection", mock.MatchedBy(update)).Return(nil)
+
+		conn := &ariesdidex.Connection{Record: &connection.Record{ConnectionID: "conn-1", TheirDID: "did:theirs"}}
+		success("invitation-1", conn)
+
+		evt := <-sub
+		require.Equal(t, ConnectionCompletedEvent, evt.Type)
+		require.Equal(t, "conn-1", evt.Id)
+		store.AssertExpectations(t)
+	})
+
+	t.Run("unknown agent", func(t *testing.T) {
+		store := &mocks.Store{}
+		store.On("GetCloudAgent", "agent-id").Return(nil, errors.New("not found"))
+
+		target := &CloudAgent{store: store}
+		_, err := target.CreateInvitation(ctx, &common.CreateInvitationRequest{Label: "my label"})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("bouncer error", func(t *testing.T) {
+		store := &mocks.Store{}
+		store.On("GetCloudAgent", "agent-id").Return(agent, nil)
+		bouncer := &dmocks.Bouncer{}
+		bouncer.On("CreateInvitationNotify", "my label", mock.Anything, mock.Anything).Return(nil, errors.New("boom"))
+
+		target := &CloudAgent{store: store, bouncer: bouncer}
+		_, err := target.CreateInvitation(ctx, &common.CreateInvitationRequest{Label: "my label"})
+		require.Equal(t, codes.Internal, status.Code(err))
+		store.AssertNotCalled(t, "InsertCloudAgentConnection", mock.Anything)
+	})
+
+	t.Run("store error", func(t *testing.T) {
+		store := &mocks.Store{}
+		store.On("GetCloudAgent", "agent-id").Return(agent, nil)
+		store.On("InsertCloudAgentConnection", mock.Anything).Return(errors.New("boom"))
+		bouncer := &dmocks.Bouncer{}
+		bouncer.On("CreateInvitationNotify", "my label", mock.Anything, mock.Anything).Return(invite, nil)
+
+		target := &CloudAgent{store: store, bouncer: bouncer}
+		_, err := target.CreateInvitation(ctx, &common.CreateInvitationRequest{Label: "my label"})
+		require.Equal(t, codes.Internal, status.Code(err))
+	})
+}
+
+func TestUpdateConnectionLabel(t *testing.T) {
+	agent := &datastore.CloudAgent{ID: "agent-id"}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(CanisCloudAgentIDHeaderKey, "agent-id"))
+
+	setup := func() *mocks.Store {
+		conn := &datastore.CloudAgentConnection{CloudAgentID: "agent-id", ConnectionID: "conn-1", TheirLabel: "old"}
+		store := &mocks.Store{}
+		store.On("GetCloudAgent", "agent-id").Return(agent, nil)
+		store.On("ListCloudAgentConnections", agent).Return([]*datastore.CloudAgentConnection{conn}, nil)
+		return store
+	}
+
+	t.Run("renamed", func(t *testing.T) {
+		store := setup()
+		match := func(c *datastore.CloudAgentConnection) bool {
+			return c.ConnectionID == "conn-1" && c.TheirLabel == "new"
+		}
+		store.On("UpdateCloudAgentConnection", mock.MatchedBy(match)).Return(nil)
+
+		target := &CloudAgent{store: store}
+		_, err := target.UpdateConnectionLabel(ctx, &common.UpdateConnectionLabelRequest{ConnectionId: "conn-1", Label: "new"})
+		require.NoError(t, err)
+		store.AssertExpectations(t)
+	})
+
+	t.Run("missing label", func(t *testing.T) {
+		target := &CloudAgent{store: setup()}
+		_, err := target.UpdateConnectionLabel(ctx, &common.UpdateConnectionLabelRequest{ConnectionId: "conn-1"})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("unknown connection", func(t *testing.T) {
+		target := &CloudAgent{store: setup()}
+		_, err := target.UpdateConnectionLabel(ctx, &common.UpdateConnectionLabelRequest{ConnectionId: "conn-2", Label: "new"})
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("store error", func(t *testing.T) {
+		store := setup()
+		store.On("UpdateCloudAgentConnection", mock.Anything).Return(errors.New("boom"))
+
+		target := &CloudAgent{store: store}
+		_, err := target.UpdateConnectionLabel(ctx, &common.UpdateConnectionLabelRequest{ConnectionId: "conn-1", Label: "new"})
+		require.Equal(t, codes.Internal, status.Code(err))
+	})
+}
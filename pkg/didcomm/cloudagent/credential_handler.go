@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -17,6 +18,7 @@ import (
 	"github.com/scoir/canis/pkg/credential/engine/indy"
 	"github.com/scoir/canis/pkg/credential/engine/lds"
 	"github.com/scoir/canis/pkg/datastore"
+	indywrapper "github.com/scoir/canis/pkg/indy"
 	"github.com/scoir/canis/pkg/schema"
 	cursa "github.com/scoir/canis/pkg/ursa"
 )
@@ -26,10 +28,16 @@ const (
 )
 
 type credentialHandler struct {
-	credcl    *issuecredential.Client
+	credcl    credentialAcceptor
 	prover    *cursa.Prover
-	vdrclient *vdr.Client
+	vdrclient indywrapper.IndyVDRClient
 	store     datastore.Store
+	events    *EventHub
+	wakeup    *waker
+}
+
+type credentialAcceptor interface {
+	AcceptCredential(piID string) error
 }
 
 type eventProps interface {
@@ -69,13 +77,36 @@ func NewCredHandler(ctx provider) (*credentialHandler, error) {
 		store:     store,
 		vdrclient: vdrclient,
 		prover:    prover,
+		events:    ctx.GetEventHub(),
+		wakeup:    &waker{store: store, notifier: ctx.GetPushNotifier()},
 	}
 
 	return a, nil
 }
 
+// credentialMasterSecretID is the ID of the master secret cred is bound to.  Credentials requested before cloud
+// agents had their own master secret do not record one and are bound to the master secret of the deployment
+func credentialMasterSecretID(cred *datastore.CloudAgentCredential) string {
+	md := cred.CredentialRequestMetadata
+	if md != nil && strings.HasPrefix(md.MasterSecretName, masterSecretID+":") {
+		return md.MasterSecretName
+	}
+
+	return masterSecretID
+}
+
+// ownMasterSecretID is the ID of the master secret only used by cloudAgentID
+func ownMasterSecretID(cloudAgentID string) string {
+	return masterSecretID + ":" + cloudAgentID
+}
+
 func (r *credentialHandler) GetCredentialClient() (*issuecredential.Client, error) {
-	return r.credcl, nil
+	credcl, ok := r.credcl.(*issuecredential.Client)
+	if !ok {
+		return nil, errors.New("cloud agent credential handler has no aries credential client")
+	}
+
+	return credcl, nil
 }
 
 func (r *credentialHandler) OfferCredentialMsg(e service.DIDCommAction, d *icprotocol.OfferCredential) {
@@ -145,12 +176,15 @@ func (r *credentialHandler) saveLDSOffer(e service.DIDCommAction, offer *icproto
 		},
 	}
 
-	err = r.store.InsertCloudAgentCredential(cloudAgentCredential)
+	err = r.saveOffer(cloudAgentCredential)
 	if err != nil {
 		log.Println("unable to save cloud agent credential", err)
 		return
 	}
 
+	r.events.Publish(cloudAgentCredential.CloudAgentID, CredentialOfferedEvent, cloudAgentCredential.ID)
+	r.wakeup.wake(cloudAgentCredential.CloudAgentID)
+
 	log.Println("Credential offer saved from", theirDID, "to", myDID)
 
 }
@@ -168,7 +202,8 @@ func (r *credentialHandler) saveIndyOffer(e service.DIDCommAction, d *icprotocol
 	}
 
 	fmt.Println("Accepting credential offer", d.Comment)
-	ms, err := r.prover.CreateMasterSecret(masterSecretID)
+	msID := ownMasterSecretID(cloudAgentConnection.CloudAgentID)
+	ms, err := r.prover.CreateMasterSecret(msID)
 	if err != nil {
 		log.Println("error creating master secret", err)
 		return
@@ -200,6 +235,7 @@ func (r *credentialHandler) saveIndyOffer(e service.DIDCommAction, d *icprotocol
 		log.Println("unable to create ursa credential request", err)
 		return
 	}
+	credReqMeta.MasterSecretName = msID
 
 	vals := map[string]interface{}{}
 	attrs := make([]icprotocol.Attribute, len(d.CredentialPreview.Attributes))
@@ -232,12 +268,15 @@ func (r *credentialHandler) saveIndyOffer(e service.DIDCommAction, d *icprotocol
 		},
 	}
 
-	err = r.store.InsertCloudAgentCredential(cloudAgentCredential)
+	err = r.saveOffer(cloudAgentCredential)
 	if err != nil {
 		log.Println("unable to save cloud agent credential", err)
 		return
 	}
 
+	r.events.Publish(cloudAgentCredential.CloudAgentID, CredentialOfferedEvent, cloudAgentCredential.ID)
+	r.wakeup.wake(cloudAgentCredential.CloudAgentID)
+
 	log.Println("Credential offer saved from", theirDID, "to", myDID)
 
 }
@@ -285,8 +324,11 @@ func (r *credentialHandler) acceptLDSOffer(e service.DIDCommAction, issue *icpro
 	err = r.store.UpdateCloudAgentCredential(cloudAgentCredential)
 	if err != nil {
 		log.Println("unable to update issued cloud agent credential", err)
+		return
 	}
 
+	r.events.Publish(cloudAgentCredential.CloudAgentID, CredentialReceivedEvent, cloudAgentCredential.ID)
+
 }
 
 func (r *credentialHandler) acceptIndyOffer(e service.DIDCommAction, issue *icprotocol.IssueCredential, attachment decorator.Attachment) {
@@ -342,7 +384,7 @@ func (r *credentialHandler) acceptIndyOffer(e service.DIDCommAction, issue *icpr
 		return
 	}
 
-	ms, err := r.prover.GetMasterSecret(masterSecretID)
+	ms, err := r.prover.GetMasterSecret(credentialMasterSecretID(cloudAgentCredential))
 	if err != nil {
 		log.Println("unable to get master secret", err)
 		return
@@ -366,15 +408,41 @@ func (r *credentialHandler) acceptIndyOffer(e service.DIDCommAction, issue *icpr
 	err = r.store.UpdateCloudAgentCredential(cloudAgentCredential)
 	if err != nil {
 		log.Println("unable to update issued cloud agent credential", err)
+		return
 	}
 
+	if cloudAgentCredential.Replaces != "" {
+		cloudAgent := &datastore.CloudAgent{ID: cloudAgentCredential.CloudAgentID}
+		err = r.store.DeleteCloudAgentCredential(cloudAgent, cloudAgentCredential.Replaces)
+		if err != nil {
+			log.Println("unable to delete reissued cloud agent credential", cloudAgentCredential.Replaces, err)
+		}
+	}
+
+	r.events.Publish(cloudAgentCredential.CloudAgentID, CredentialReceivedEvent, cloudAgentCredential.ID)
+
 }
 
-//No-ops
-func (r *credentialHandler) RequestCredentialMsg(_ service.DIDCommAction, _ *icprotocol.RequestCredential) {
+// saveOffer saves an offered credential, replacing the proposal that started the thread if the holder sent one
+func (r *credentialHandler) saveOffer(cloudAgentCredential *datastore.CloudAgentCredential) error {
+	proposed, err := r.store.GetCloudAgentCredentialFromThread(cloudAgentCredential.CloudAgentID, cloudAgentCredential.ThreadID)
+	if err != nil {
+		return r.store.InsertCloudAgentCredential(cloudAgentCredential)
+	}
 
+	cloudAgentCredential.ID = proposed.ID
+	cloudAgentCredential.Replaces = proposed.Replaces
+	return r.store.UpdateCloudAgentCredential(cloudAgentCredential)
 }
 
-func (r *credentialHandler) ProposeCredentialMsg(_ service.DIDCommAction, _ *icprotocol.ProposeCredential) {
+// RequestCredentialMsg is only sent to issuers
+func (r *credentialHandler) RequestCredentialMsg(e service.DIDCommAction, _ *icprotocol.RequestCredential) {
+	log.Println("cloud agent received unexpected credential request")
+	e.Stop(errors.New("cloud agents do not issue credentials"))
+}
 
+// ProposeCredentialMsg is only sent to issuers
+func (r *credentialHandler) ProposeCredentialMsg(e service.DIDCommAction, _ *icprotocol.ProposeCredential) {
+	log.Println("cloud agent received unexpected credential proposal")
+	e.Stop(errors.New("cloud agents do not issue credentials"))
 }
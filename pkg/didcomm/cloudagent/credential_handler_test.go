@@ -0,0 +1,206 @@
+package cloudagent
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/btcsuite/btcutil/base58"
+	"github.com/golang/protobuf/proto"
+	"github.com/google/tink/go/insecurecleartextkeyset"
+	"github.com/google/tink/go/keyset"
+	ed25519pb "github.com/google/tink/go/proto/ed25519_go_proto"
+	"github.com/hyperledger/aries-framework-go/pkg/didcomm/common/service"
+	"github.com/hyperledger/aries-framework-go/pkg/didcomm/protocol/decorator"
+	icprotocol "github.com/hyperledger/aries-framework-go/pkg/didcomm/protocol/issuecredential"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/did"
+	kmsMock "github.com/hyperledger/aries-framework-go/pkg/mock/kms"
+	vdriMock "github.com/hyperledger/aries-framework-go/pkg/mock/vdri"
+	"github.com/hyperledger/aries-framework-go/pkg/storage/mem"
+	"github.com/hyperledger/aries-framework-go/pkg/store/connection"
+	"github.com/hyperledger/indy-vdr/wrappers/golang/identifiers"
+	"github.com/hyperledger/indy-vdr/wrappers/golang/vdr"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+
+	indyengine "github.com/scoir/canis/pkg/credential/engine/indy"
+	"github.com/scoir/canis/pkg/datastore"
+	"github.com/scoir/canis/pkg/datastore/mocks"
+	"github.com/scoir/canis/pkg/indy/sim"
+	"github.com/scoir/canis/pkg/protogen/common"
+	cursa "github.com/scoir/canis/pkg/ursa"
+)
+
+func TestSaveOffer(t *testing.T) {
+	t.Run("new offer", func(t *testing.T) {
+		store := &mocks.Store{}
+		target := &credentialHandler{store: store}
+		cred := &datastore.CloudAgentCredential{ID: "new", CloudAgentID: "agent", ThreadID: "thread"}
+
+		store.On("GetCloudAgentCredentialFromThread", "agent", "thread").Return(nil, errors.New("not found"))
+		store.On("InsertCloudAgentCredential", cred).Return(nil)
+
+		require.NoError(t, target.saveOffer(cred))
+		store.AssertExpectations(t)
+	})
+	t.Run("offer for proposal", func(t *testing.T) {
+		store := &mocks.Store{}
+		target := &credentialHandler{store: store}
+		cred := &datastore.CloudAgentCredential{ID: "new", CloudAgentID: "agent", ThreadID: "thread"}
+
+		store.On("GetCloudAgentCredentialFromThread", "agent", "thread").Return(&datastore.CloudAgentCredential{ID: "proposed"}, nil)
+		store.On("UpdateCloudAgentCredential", cred).Return(nil)
+
+		require.NoError(t, target.saveOffer(cred))
+		require.Equal(t, "proposed", cred.ID)
+		store.AssertNotCalled(t, "InsertCloudAgentCredential", cred)
+	})
+}
+
+type testCredentialAcceptor struct {
+	accepted []string
+}
+
+func (r *testCredentialAcceptor) AcceptCredential(piID string) error {
+	r.accepted = append(r.accepted, piID)
+	return nil
+}
+
+type testEventProps struct {
+	myDID, theirDID string
+}
+
+func (r testEventProps) MyDID() string {
+	return r.myDID
+}
+
+func (r testEventProps) TheirDID() string {
+	return r.theirDID
+}
+
+func TestIndyIssuanceExport(t *testing.T) {
+	trustee := sim.NewIdentity("000000000000000000000000Trustee1")
+	ledger := sim.New(sim.WithTrustee(trustee))
+
+	kh, err := kmsMock.CreateMockED25519KeyHandle()
+	require.NoError(t, err)
+	issuerDID := ledgerDID(t, ledger, trustee, kh)
+
+	prov := &indyengine.MockProvider{}
+	prov.On("IndyVDR").Return(ledger, nil)
+	prov.On("KMS").Return(&kmsMock.KeyManager{GetKeyValue: kh})
+	prov.On("StorageProvider").Return(mem.NewProvider())
+	prov.On("Oracle").Return(&cursa.CryptoOracle{})
+
+	engine, err := indyengine.New(prov)
+	require.NoError(t, err)
+
+	s := &datastore.Schema{Name: "degree", Version: "1.0", Attributes: []*datastore.Attribute{{Name: "name"}}}
+	s.ExternalSchemaID, err = engine.CreateSchema(context.Background(), issuerDID, s)
+	require.NoError(t, err)
+	require.NoError(t, engine.RegisterSchema(context.Background(), issuerDID, s))
+
+	offerID, offerAttachment, err := engine.CreateCredentialOffer(context.Background(), issuerDID, "", s, nil)
+	require.NoError(t, err)
+
+	agent := &datastore.CloudAgent{ID: "agent-id"}
+	conn := &datastore.CloudAgentConnection{CloudAgentID: agent.ID, ConnectionID: "conn-1", MyDID: "did:peer:mine",
+		TheirDID: "did:peer:issuer", TheirLabel: "Issuer"}
+
+	var cred *datastore.CloudAgentCredential
+	store := &mocks.Store{}
+	store.On("GetCloudAgent", agent.ID).Return(agent, nil)
+	store.On("GetCloudAgentConnectionForDIDs", conn.MyDID, conn.TheirDID).Return(conn, nil)
+	store.On("GetCloudAgentCredentialFromThread", agent.ID, "thread-1").Return(nil, errors.New("not found")).Once()
+	store.On("InsertCloudAgentCredential", mock.Anything).Run(func(args mock.Arguments) {
+		cred = args.Get(0).(*datastore.CloudAgentCredential)
+	}).Return(nil)
+	store.On("UpdateCloudAgentCredential", mock.Anything).Return(nil)
+
+	prover := newTestProver(t)
+	handler := &credentialHandler{
+		credcl:    &testCredentialAcceptor{},
+		prover:    prover,
+		vdrclient: ledger,
+		store:     store,
+		wakeup:    &waker{store: store},
+	}
+
+	offer := &icprotocol.OfferCredential{
+		ID:   "thread-1",
+		Type: icprotocol.OfferCredentialMsgType,
+		CredentialPreview: icprotocol.PreviewCredential{
+			Attributes: []icprotocol.Attribute{{Name: "name", Value: "Alice"}},
+		},
+	}
+	e := service.DIDCommAction{
+		Message:    service.NewDIDCommMsgMap(offer),
+		Properties: testEventProps{myDID: conn.MyDID, theirDID: conn.TheirDID},
+	}
+
+	handler.saveIndyOffer(e, offer, decorator.Attachment{Data: *offerAttachment})
+	require.NotNil(t, cred)
+	require.Equal(t, ownMasterSecretID(agent.ID), credentialMasterSecretID(cred))
+
+	credAttachment, err := engine.IssueCredential(context.Background(), issuerDID, s, offerID, decorator.AttachmentData{JSON: cred.CredentialRequest},
+		map[string]interface{}{"name": "Alice"})
+	require.NoError(t, err)
+
+	store.On("GetCloudAgentCredentialFromThread", agent.ID, "thread-1").Return(cred, nil)
+	handler.acceptIndyOffer(e, &icprotocol.IssueCredential{Comment: "degree"}, decorator.Attachment{Data: *credAttachment})
+	require.NotNil(t, cred.Credential)
+
+	t.Run("export", func(t *testing.T) {
+		store.On("ListCloudAgentConnections", agent).Return([]*datastore.CloudAgentConnection{conn}, nil)
+		store.On("ListCloudAgentCredentials", agent).Return([]*datastore.CloudAgentCredential{cred}, nil)
+		store.On("ListCloudAgentProofRequests", agent).Return(nil, nil)
+
+		target := &CloudAgent{
+			store:  store,
+			prover: prover,
+			connections: &testConnectionRecorder{records: map[string]*connection.Record{
+				"conn-1": {ConnectionID: "conn-1", MyDID: conn.MyDID, TheirDID: conn.TheirDID},
+			}},
+			vdriReg: &vdriMock.MockVDRIRegistry{ResolveValue: &did.Doc{Context: []string{did.Context}, ID: conn.MyDID}},
+		}
+
+		key := bytes.Repeat([]byte{1}, WalletKeySize)
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(CanisCloudAgentIDHeaderKey, agent.ID))
+		exported, err := target.ExportWallet(ctx, &common.ExportWalletRequest{Key: key})
+		require.NoError(t, err)
+
+		d, err := openWallet(key, exported.Archive)
+		require.NoError(t, err)
+		archive := &walletArchive{}
+		require.NoError(t, json.Unmarshal(d, archive))
+
+		ms, err := prover.GetMasterSecret(ownMasterSecretID(agent.ID))
+		require.NoError(t, err)
+		require.Equal(t, ms, archive.MasterSecret)
+		require.Len(t, archive.Credentials, 1)
+	})
+}
+
+// ledgerDID writes a NYM for the ED25519 key in kh to ledger so the engine can sign writes with it
+func ledgerDID(t *testing.T, ledger *sim.Ledger, trustee *sim.Identity, kh *keyset.Handle) *datastore.DID {
+	ks := insecurecleartextkeyset.KeysetMaterial(kh)
+	priv := &ed25519pb.Ed25519PrivateKey{}
+	require.NoError(t, proto.Unmarshal(ks.Key[0].KeyData.Value, priv))
+
+	pub := priv.PublicKey.KeyValue
+	id := base58.Encode(pub[:16])
+	require.NoError(t, ledger.CreateNym(id, base58.Encode(pub), vdr.EndorserRole, trustee.DID, trustee))
+
+	return &datastore.DID{
+		DID: &identifiers.DID{
+			DIDVal: identifiers.DIDValue{
+				MethodSpecificID: id,
+				Method:           "sov",
+			},
+		},
+		KeyPair: &datastore.KeyPair{ID: "issuer-key"},
+	}
+}
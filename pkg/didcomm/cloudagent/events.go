@@ -0,0 +1,189 @@
+package cloudagent
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"nhooyr.io/websocket"
+
+	api "github.com/scoir/canis/pkg/didcomm/cloudagent/api/protogen"
+	"github.com/scoir/canis/pkg/protogen/common"
+)
+
+// Cloud agent event types
+const (
+	ConnectionCompletedEvent = "connection_completed"
+	CredentialOfferedEvent   = "credential_offered"
+	CredentialReceivedEvent  = "credential_received"
+	ProofRequestedEvent      = "proof_requested"
+)
+
+// subscriberBuffer is how many events a slow subscriber can fall behind before events are dropped
+const subscriberBuffer = 32
+
+// EventHub fans out events for a cloud agent to the clients subscribed to it
+type EventHub struct {
+	lock sync.RWMutex
+	subs map[string]map[chan *common.CloudAgentEvent]struct{}
+}
+
+func NewEventHub() *EventHub {
+	return &EventHub{
+		subs: map[string]map[chan *common.CloudAgentEvent]struct{}{},
+	}
+}
+
+// Publish sends an event of type typ about the connection, credential or proof request with id to the
+// subscribers of cloudAgentID.  Subscribers that are not keeping up miss the event
+func (r *EventHub) Publish(cloudAgentID, typ, id string) {
+	if r == nil {
+		return
+	}
+
+	evt := &common.CloudAgentEvent{
+		Type:      typ,
+		Id:        id,
+		Timestamp: timestamppb.Now(),
+	}
+
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	for ch := range r.subs[cloudAgentID] {
+		select {
+		case ch <- evt:
+		default:
+			log.Printf("dropping %s event for slow subscriber of cloud agent %s\n", typ, cloudAgentID)
+		}
+	}
+}
+
+// Subscribe returns a channel of events for cloudAgentID and a function to cancel the subscription
+func (r *EventHub) Subscribe(cloudAgentID string) (<-chan *common.CloudAgentEvent, func()) {
+	ch := make(chan *common.CloudAgentEvent, subscriberBuffer)
+
+	r.lock.Lock()
+	if r.subs[cloudAgentID] == nil {
+		r.subs[cloudAgentID] = map[chan *common.CloudAgentEvent]struct{}{}
+	}
+	r.subs[cloudAgentID][ch] = struct{}{}
+	r.lock.Unlock()
+
+	return ch, func() {
+		r.lock.Lock()
+		defer r.lock.Unlock()
+
+		delete(r.subs[cloudAgentID], ch)
+		if len(r.subs[cloudAgentID]) == 0 {
+			delete(r.subs, cloudAgentID)
+		}
+	}
+}
+
+// Events streams events for the calling cloud agent until the client goes away
+func (r *CloudAgent) Events(_ *common.EventsRequest, stream api.CloudAgent_EventsServer) error {
+	cloudAgentID := r.getAgentID(stream.Context())
+	cloudAgent, err := r.store.GetCloudAgent(cloudAgentID)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "agent with id %s not found", cloudAgentID)
+	}
+
+	events, cancel := r.events.Subscribe(cloudAgent.ID)
+	defer cancel()
+
+	for {
+		select {
+		case evt := <-events:
+			err = stream.Send(evt)
+			if err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
+
+// eventsWebSocket streams events for the cloud agent that signed the upgrade request as JSON messages
+func (r *CloudAgent) eventsWebSocket(w http.ResponseWriter, req *http.Request) {
+	cloudAgentID := req.Header.Get(CanisCloudAgentIDHeaderKey)
+	cloudAgent, err := r.store.GetCloudAgent(cloudAgentID)
+	if err != nil {
+		http.Error(w, "Not authorized", http.StatusUnauthorized)
+		return
+	}
+
+	conn, err := websocket.Accept(w, req, &websocket.AcceptOptions{
+		CompressionMode: websocket.CompressionDisabled,
+	})
+	if err != nil {
+		log.Println("unable to upgrade events connection", err)
+		return
+	}
+	defer conn.Close(websocket.StatusNormalClosure, "closing the connection")
+
+	events, cancel := r.events.Subscribe(cloudAgent.ID)
+	defer cancel()
+
+	// the client does not send anything, reading detects when it goes away
+	ctx := conn.CloseRead(req.Context())
+	for {
+		select {
+		case evt := <-events:
+			msg, err := protojson.Marshal(evt)
+			if err != nil {
+				log.Println("unable to marshal cloud agent event", err)
+				continue
+			}
+
+			err = conn.Write(ctx, websocket.MessageText, msg)
+			if err != nil {
+				return
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// signedStream verifies the signature of the first message received on a GRPC stream
+type signedStream struct {
+	grpc.ServerStream
+	agent    *CloudAgent
+	method   string
+	verified bool
+}
+
+func (r *signedStream) RecvMsg(m interface{}) error {
+	err := r.ServerStream.RecvMsg(m)
+	if err != nil || r.verified {
+		return err
+	}
+
+	_, err = r.agent.signedRequestInterceptor(r.Context(), m, &grpc.UnaryServerInfo{FullMethod: r.method},
+		func(context.Context, interface{}) (interface{}, error) { return nil, nil })
+	if err != nil {
+		return err
+	}
+
+	r.verified = true
+	return nil
+}
+
+// signedStreamInterceptor applies the signed request scheme to server streaming GRPC calls
+func (r *CloudAgent) signedStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+
+	if !info.IsServerStream || info.IsClientStream {
+		return status.Error(codes.Unimplemented, "only server streaming calls are supported")
+	}
+
+	return handler(srv, &signedStream{ServerStream: ss, agent: r, method: info.FullMethod})
+}
@@ -0,0 +1,98 @@
+package cloudagent
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/scoir/canis/pkg/protogen/common"
+)
+
+func TestEventHub(t *testing.T) {
+	hub := NewEventHub()
+
+	events, cancel := hub.Subscribe("agent-1")
+	other, cancelOther := hub.Subscribe("agent-2")
+	defer cancelOther()
+
+	hub.Publish("agent-1", CredentialOfferedEvent, "cred-1")
+
+	select {
+	case evt := <-events:
+		require.Equal(t, CredentialOfferedEvent, evt.Type)
+		require.Equal(t, "cred-1", evt.Id)
+		require.NotNil(t, evt.Timestamp)
+	case <-time.After(time.Second):
+		require.Fail(t, "event not received")
+	}
+	require.Empty(t, other)
+
+	cancel()
+	hub.Publish("agent-1", ProofRequestedEvent, "pr-1")
+	require.Empty(t, events)
+	require.NotContains(t, hub.subs, "agent-1")
+
+	for i := 0; i < subscriberBuffer+1; i++ {
+		hub.Publish("agent-2", ProofRequestedEvent, "pr-1")
+	}
+	require.Len(t, other, subscriberBuffer)
+
+	var nilHub *EventHub
+	nilHub.Publish("agent-1", ProofRequestedEvent, "pr-1")
+}
+
+type testStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (r *testStream) Context() context.Context {
+	return r.ctx
+}
+
+func (r *testStream) RecvMsg(_ interface{}) error {
+	return nil
+}
+
+func TestSignedStreamInterceptor(t *testing.T) {
+	r, priv, _ := setupAuth(t)
+	info := &grpc.StreamServerInfo{FullMethod: "/didcomm.CloudAgent/Events", IsServerStream: true}
+	handler := func(_ interface{}, stream grpc.ServerStream) error {
+		return stream.RecvMsg(&common.EventsRequest{})
+	}
+
+	sign := func(nonce string) context.Context {
+		body, err := proto.MarshalOptions{Deterministic: true}.Marshal(&common.EventsRequest{})
+		require.NoError(t, err)
+		stamp := strconv.FormatInt(time.Now().Unix(), 10)
+		sig := ed25519.Sign(priv, SignatureMessage(http.MethodPost, info.FullMethod, stamp, nonce, body))
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+			CanisCloudAgentIDHeaderKey, "agent-id",
+			CanisCloudAgentSigHeaderKey, base64.URLEncoding.EncodeToString(sig),
+			CanisCloudAgentTimestampHeaderKey, stamp,
+			CanisCloudAgentNonceHeaderKey, nonce,
+		))
+	}
+
+	t.Run("signed", func(t *testing.T) {
+		err := r.signedStreamInterceptor(nil, &testStream{ctx: sign("stream-1")}, info, handler)
+		require.NoError(t, err)
+	})
+
+	t.Run("unsigned", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(CanisCloudAgentIDHeaderKey, "agent-id"))
+		err := r.signedStreamInterceptor(nil, &testStream{ctx: ctx}, info, handler)
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+}
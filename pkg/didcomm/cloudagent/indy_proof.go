@@ -0,0 +1,294 @@
+package cloudagent
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/google/uuid"
+	ppclient "github.com/hyperledger/aries-framework-go/pkg/client/presentproof"
+	"github.com/hyperledger/aries-framework-go/pkg/didcomm/protocol/decorator"
+	"github.com/hyperledger/aries-framework-go/pkg/didcomm/protocol/presentproof"
+	"github.com/hyperledger/indy-vdr/wrappers/golang/vdr"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/scoir/canis/pkg/credential/engine/indy"
+	"github.com/scoir/canis/pkg/datastore"
+	indywrapper "github.com/scoir/canis/pkg/indy"
+	ppindy "github.com/scoir/canis/pkg/presentproof/engine/indy"
+	"github.com/scoir/canis/pkg/protogen/common"
+	"github.com/scoir/canis/pkg/schema"
+)
+
+// indyPresentation builds an AnonCreds proof for the Indy request in pr from the credentials the holder chose
+// for each requested attribute and predicate referent
+func (r *CloudAgent) indyPresentation(cloudAgent *datastore.CloudAgent, pr *datastore.CloudAgentProofRequest,
+	request *common.PresentProofRequest) (*ppclient.Presentation, error) {
+
+	proofReq, err := indyProofRequest(pr.RequestPresentation)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "invalid indy proof request: (%v)", err)
+	}
+
+	requestedCreds, err := requestedCredentials(proofReq, request)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	credentials := map[string]*schema.IndyCredential{}
+	schemas := map[string]*datastore.Schema{}
+	credDefs := map[string]*vdr.ClaimDefData{}
+	msID := ""
+	for _, credID := range requestedCredIDs(requestedCreds) {
+		cred, credMSID, err := r.indyCredential(cloudAgent, credID)
+		if err != nil {
+			return nil, err
+		}
+		credentials[credID] = cred
+
+		if msID != "" && msID != credMSID {
+			return nil, status.Error(codes.FailedPrecondition,
+				"the chosen credentials are bound to different master secrets and can not be presented together")
+		}
+		msID = credMSID
+
+		if _, ok := schemas[cred.SchemaID]; !ok {
+			schemas[cred.SchemaID], err = r.indySchema(cred.SchemaID)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "unable to load schema %s: (%v)", cred.SchemaID, err)
+			}
+		}
+
+		if _, ok := credDefs[cred.CredDefID]; !ok {
+			credDefs[cred.CredDefID], err = r.indyCredDef(cred.CredDefID)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "unable to load cred def %s: (%v)", cred.CredDefID, err)
+			}
+		}
+	}
+
+	ms, err := r.prover.GetMasterSecret(msID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to get master secret: (%v)", err)
+	}
+
+	proof, err := r.prover.CreateProof(credentials, proofReq, requestedCreds, ms, schemas, credDefs)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to create indy proof: (%v)", err)
+	}
+
+	d, err := json.Marshal(proof)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to marshal indy proof: (%v)", err)
+	}
+
+	attachID := uuid.New().String()
+	return &ppclient.Presentation{
+		Formats: []presentproof.Format{
+			{AttachID: attachID, Format: ppindy.Format},
+		},
+		PresentationsAttach: []decorator.Attachment{
+			{
+				ID:       attachID,
+				MimeType: "application/json",
+				Data: decorator.AttachmentData{
+					Base64: base64.StdEncoding.EncodeToString(d),
+				},
+			},
+		},
+	}, nil
+}
+
+// indyCredential loads an issued Indy credential belonging to cloudAgent and the ID of the master secret it is
+// bound to
+func (r *CloudAgent) indyCredential(cloudAgent *datastore.CloudAgent, credID string) (*schema.IndyCredential, string, error) {
+	cred, err := r.store.GetCloudAgentCredential(cloudAgent, credID)
+	if err != nil {
+		return nil, "", status.Errorf(codes.InvalidArgument, "credential with id %s not found", credID)
+	}
+
+	if cred.Format != indy.Indy || cred.Credential == nil {
+		return nil, "", status.Errorf(codes.InvalidArgument, "credential with id %s is not an issued indy credential", credID)
+	}
+
+	out := &schema.IndyCredential{}
+	err = json.Unmarshal(cred.Credential.Data, out)
+	if err != nil {
+		return nil, "", status.Errorf(codes.Internal, "invalid indy credential %s: (%v)", credID, err)
+	}
+
+	return out, credentialMasterSecretID(cred), nil
+}
+
+func (r *CloudAgent) indySchema(schemaID string) (*datastore.Schema, error) {
+	namespace, ledgerID, err := indywrapper.LedgerID(schemaID)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid schema ID")
+	}
+
+	cl, err := r.ledger(namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := indywrapper.GetSchema(cl, ledgerID)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &datastore.Schema{
+		ID:               schemaID,
+		Format:           indy.Indy,
+		Name:             s.Name,
+		Version:          s.Version,
+		ExternalSchemaID: schemaID,
+		Attributes:       make([]*datastore.Attribute, len(s.AttrNames)),
+	}
+
+	for i, name := range s.AttrNames {
+		out.Attributes[i] = &datastore.Attribute{Name: name}
+	}
+
+	return out, nil
+}
+
+func (r *CloudAgent) indyCredDef(credDefID string) (*vdr.ClaimDefData, error) {
+	namespace, ledgerID, err := indywrapper.LedgerID(credDefID)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid cred def ID")
+	}
+
+	cl, err := r.ledger(namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	rply, err := cl.GetCredDef(ledgerID)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to retrieve cred def from ledger")
+	}
+
+	credDef := &vdr.ClaimDefData{ID: credDefID}
+	err = credDef.UnmarshalReadReply(rply)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid cred def reply from ledger")
+	}
+
+	return credDef, nil
+}
+
+// ledger returns the client for the ledger with the did:indy namespace, or the default ledger for unqualified IDs
+func (r *CloudAgent) ledger(namespace string) (indywrapper.IndyVDRClient, error) {
+	cl, err := r.ledgers(namespace)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unknown ledger namespace %s", namespace)
+	}
+
+	return cl, nil
+}
+
+// indyProofRequest extracts the Indy proof request attachment from a request-presentation message
+func indyProofRequest(req *presentproof.RequestPresentation) (*schema.IndyProofRequest, error) {
+	d, err := requestAttachment(req, ppindy.Format)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &schema.IndyProofRequest{}
+	err = json.Unmarshal(d, out)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to decode indy proof request")
+	}
+
+	return out, nil
+}
+
+// requestAttachment fetches the data of the attachment of req with the given format
+func requestAttachment(req *presentproof.RequestPresentation, format string) ([]byte, error) {
+	if req == nil {
+		return nil, errors.New("proof request has no request presentation")
+	}
+
+	for _, f := range req.Formats {
+		if f.Format != format {
+			continue
+		}
+
+		for _, attach := range req.RequestPresentationsAttach {
+			if attach.ID != f.AttachID {
+				continue
+			}
+
+			d, err := attach.Data.Fetch()
+			if err != nil {
+				return nil, errors.Wrap(err, "unable to fetch request presentation data")
+			}
+
+			return d, nil
+		}
+	}
+
+	return nil, errors.Errorf("no %s request presentation attached", format)
+}
+
+// requestedCredentials maps the holder's choice of credential for each referent in proofReq onto the
+// structure expected by the Ursa prover.  Every referent must be satisfied
+func requestedCredentials(proofReq *schema.IndyProofRequest, request *common.PresentProofRequest) (*schema.IndyRequestedCredentials, error) {
+	out := &schema.IndyRequestedCredentials{
+		SelfAttestedAttrs:   map[string]string{},
+		RequestedAttributes: map[string]*schema.IndyRequestedAttribute{},
+		RequestedPredicates: map[string]schema.ProvingCredentialKey{},
+	}
+
+	for referent := range proofReq.RequestedAttributes {
+		if val, ok := request.SelfAttestedAttributes[referent]; ok {
+			out.SelfAttestedAttrs[referent] = val
+			continue
+		}
+
+		rc, ok := request.RequestedAttributes[referent]
+		if !ok || rc.CredentialId == "" {
+			return nil, errors.Errorf("no credential selected for requested attribute %s", referent)
+		}
+
+		out.RequestedAttributes[referent] = &schema.IndyRequestedAttribute{
+			CredID:   rc.CredentialId,
+			Revealed: !rc.Unrevealed,
+		}
+	}
+
+	for referent := range proofReq.RequestedPredicates {
+		rc, ok := request.RequestedPredicates[referent]
+		if !ok || rc.CredentialId == "" {
+			return nil, errors.Errorf("no credential selected for requested predicate %s", referent)
+		}
+
+		out.RequestedPredicates[referent] = schema.ProvingCredentialKey{
+			CredID: rc.CredentialId,
+		}
+	}
+
+	return out, nil
+}
+
+func requestedCredIDs(requestedCreds *schema.IndyRequestedCredentials) []string {
+	seen := map[string]bool{}
+	var out []string
+	add := func(id string) {
+		if !seen[id] {
+			seen[id] = true
+			out = append(out, id)
+		}
+	}
+
+	for _, attr := range requestedCreds.RequestedAttributes {
+		add(attr.CredID)
+	}
+
+	for _, pred := range requestedCreds.RequestedPredicates {
+		add(pred.CredID)
+	}
+
+	return out
+}
@@ -0,0 +1,153 @@
+package cloudagent
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/hyperledger/aries-framework-go/pkg/didcomm/protocol/decorator"
+	"github.com/hyperledger/aries-framework-go/pkg/didcomm/protocol/presentproof"
+	"github.com/hyperledger/indy-vdr/wrappers/golang/vdr"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	indywrapper "github.com/scoir/canis/pkg/indy"
+	"github.com/scoir/canis/pkg/indy/sim"
+	ppindy "github.com/scoir/canis/pkg/presentproof/engine/indy"
+	"github.com/scoir/canis/pkg/presentproof/engine/jsonld"
+	"github.com/scoir/canis/pkg/protogen/common"
+	"github.com/scoir/canis/pkg/schema"
+)
+
+func TestIndyProofRequest(t *testing.T) {
+	t.Run("indy attachment", func(t *testing.T) {
+		req := &presentproof.RequestPresentation{
+			Formats: []presentproof.Format{{AttachID: "other", Format: jsonld.DIFPresentationExchange}, {AttachID: "indy", Format: ppindy.Format}},
+			RequestPresentationsAttach: []decorator.Attachment{
+				{ID: "other", Data: decorator.AttachmentData{Base64: base64.StdEncoding.EncodeToString([]byte(`{}`))}},
+				{ID: "indy", Data: decorator.AttachmentData{Base64: base64.StdEncoding.EncodeToString(
+					[]byte(`{"name":"proof","nonce":"123","requested_attributes":{"attr1_referent":{"name":"name"}}}`))}},
+			},
+		}
+
+		proofReq, err := indyProofRequest(req)
+		require.NoError(t, err)
+		require.Equal(t, "proof", proofReq.Name)
+		require.Equal(t, "123", proofReq.Nonce)
+		require.Contains(t, proofReq.RequestedAttributes, "attr1_referent")
+	})
+	t.Run("no indy attachment", func(t *testing.T) {
+		_, err := indyProofRequest(&presentproof.RequestPresentation{})
+		require.Error(t, err)
+	})
+}
+
+func TestRequestedCredentials(t *testing.T) {
+	proofReq := &schema.IndyProofRequest{
+		RequestedAttributes: map[string]schema.IndyProofRequestAttr{
+			"attr1_referent": {Name: "name"},
+			"attr2_referent": {Name: "email"},
+			"attr3_referent": {Name: "phone"},
+		},
+		RequestedPredicates: map[string]schema.IndyProofRequestPredicate{
+			"pred1_referent": {Name: "age", PType: ">=", PValue: 18},
+		},
+	}
+
+	t.Run("all referents satisfied", func(t *testing.T) {
+		request := &common.PresentProofRequest{
+			RequestedAttributes: map[string]*common.RequestedCredential{
+				"attr1_referent": {CredentialId: "cred-1"},
+				"attr2_referent": {CredentialId: "cred-2", Unrevealed: true},
+			},
+			RequestedPredicates: map[string]*common.RequestedCredential{
+				"pred1_referent": {CredentialId: "cred-1"},
+			},
+			SelfAttestedAttributes: map[string]string{
+				"attr3_referent": "555-1234",
+			},
+		}
+
+		out, err := requestedCredentials(proofReq, request)
+		require.NoError(t, err)
+		require.Equal(t, &schema.IndyRequestedAttribute{CredID: "cred-1", Revealed: true}, out.RequestedAttributes["attr1_referent"])
+		require.Equal(t, &schema.IndyRequestedAttribute{CredID: "cred-2", Revealed: false}, out.RequestedAttributes["attr2_referent"])
+		require.Equal(t, "cred-1", out.RequestedPredicates["pred1_referent"].CredID)
+		require.Equal(t, "555-1234", out.SelfAttestedAttrs["attr3_referent"])
+		require.ElementsMatch(t, []string{"cred-1", "cred-2"}, requestedCredIDs(out))
+	})
+	t.Run("missing attribute", func(t *testing.T) {
+		request := &common.PresentProofRequest{
+			RequestedPredicates: map[string]*common.RequestedCredential{
+				"pred1_referent": {CredentialId: "cred-1"},
+			},
+		}
+
+		_, err := requestedCredentials(proofReq, request)
+		require.Error(t, err)
+	})
+	t.Run("missing predicate", func(t *testing.T) {
+		request := &common.PresentProofRequest{
+			RequestedAttributes: map[string]*common.RequestedCredential{
+				"attr1_referent": {CredentialId: "cred-1"},
+				"attr2_referent": {CredentialId: "cred-1"},
+				"attr3_referent": {CredentialId: "cred-1"},
+			},
+		}
+
+		_, err := requestedCredentials(proofReq, request)
+		require.Error(t, err)
+	})
+}
+
+func TestIndyLedgerObjects(t *testing.T) {
+	trustee := sim.NewIdentity("trustee")
+	ledgers := map[string]*sim.Ledger{"": sim.New(sim.WithTrustee(trustee)), "test": sim.New(sim.WithTrustee(trustee))}
+	issuers := map[string]*sim.Identity{"": sim.NewIdentity("default-issuer"), "test": sim.NewIdentity("test-issuer")}
+
+	schemaIDs := map[string]string{}
+	credDefIDs := map[string]string{}
+	for namespace, ledger := range ledgers {
+		issuer := issuers[namespace]
+		require.NoError(t, ledger.CreateNym(issuer.DID, issuer.Verkey, vdr.EndorserRole, trustee.DID, trustee))
+
+		_, err := ledger.CreateSchema(issuer.DID, "degree", "1.0", []string{namespace + "-attr"}, issuer)
+		require.NoError(t, err)
+		schemaIDs[namespace] = indywrapper.SchemaID(namespace, issuer.DID, "degree", "1.0")
+
+		rply, err := ledger.GetSchema(indywrapper.SchemaID("", issuer.DID, "degree", "1.0"))
+		require.NoError(t, err)
+		_, err = ledger.CreateClaimDef(issuer.DID, rply.SeqNo, map[string]interface{}{"n": "123"}, nil, issuer)
+		require.NoError(t, err)
+		credDefIDs[namespace] = indywrapper.CredDefID(namespace, issuer.DID, rply.SeqNo, "default")
+	}
+
+	target := &CloudAgent{ledgers: func(namespace string) (indywrapper.IndyVDRClient, error) {
+		if ledger, ok := ledgers[namespace]; ok {
+			return ledger, nil
+		}
+		return nil, errors.Errorf("no ledger configured for namespace %s", namespace)
+	}}
+
+	t.Run("objects are read from the ledger of their namespace", func(t *testing.T) {
+		for namespace := range ledgers {
+			s, err := target.indySchema(schemaIDs[namespace])
+			require.NoError(t, err)
+			require.Equal(t, schemaIDs[namespace], s.ID)
+			require.Equal(t, namespace+"-attr", s.Attributes[0].Name)
+
+			credDef, err := target.indyCredDef(credDefIDs[namespace])
+			require.NoError(t, err)
+			require.Equal(t, credDefIDs[namespace], credDef.ID)
+		}
+	})
+	t.Run("unknown namespace", func(t *testing.T) {
+		issuer := issuers["test"]
+		_, err := target.indySchema(indywrapper.SchemaID("other", issuer.DID, "degree", "1.0"))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "unknown ledger namespace other")
+
+		_, err = target.indyCredDef(indywrapper.CredDefID("other", issuer.DID, 1, "default"))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "unknown ledger namespace other")
+	})
+}
@@ -8,12 +8,12 @@ import (
 	"github.com/hyperledger/aries-framework-go/pkg/didcomm/common/service"
 	"github.com/hyperledger/aries-framework-go/pkg/didcomm/protocol/presentproof"
 	vdriapi "github.com/hyperledger/aries-framework-go/pkg/framework/aries/api/vdri"
-	ariescontext "github.com/hyperledger/aries-framework-go/pkg/framework/context"
 	"github.com/hyperledger/aries-framework-go/pkg/kms"
 	vstore "github.com/hyperledger/aries-framework-go/pkg/store/verifiable"
 	"github.com/pkg/errors"
 
 	"github.com/scoir/canis/pkg/datastore"
+	"github.com/scoir/canis/pkg/presentproof/engine/jsonld"
 )
 
 type evtProps interface {
@@ -29,16 +29,28 @@ type PresentationHandler struct {
 	kms     kms.KeyManager
 	vdr     vdriapi.Registry
 	store   datastore.Store
+	events  *EventHub
+	wakeup  *waker
 }
 
-func NewProofHandler(ctx *ariescontext.Provider) (*PresentationHandler, error) {
+func NewProofHandler(ctx provider) (*PresentationHandler, error) {
 
-	ppcl, err := ppclient.New(ctx)
+	actx, err := ctx.GetAriesContext()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get aries provider for cloud agent proof handler")
+	}
+
+	store, err := ctx.GetDatastore()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get canis datastore for cloud agent")
+	}
+
+	ppcl, err := ppclient.New(actx)
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to create issue credential client in steward init")
 	}
 
-	vc, err := vstore.New(ctx)
+	vc, err := vstore.New(actx)
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to create credential store in steward init")
 	}
@@ -46,21 +58,32 @@ func NewProofHandler(ctx *ariescontext.Provider) (*PresentationHandler, error) {
 	return &PresentationHandler{
 		ppcl:    ppcl,
 		vcstore: vc,
-		kms:     ctx.KMS(),
-		vdr:     ctx.VDRIRegistry(),
+		kms:     actx.KMS(),
+		vdr:     actx.VDRIRegistry(),
+		store:   store,
+		events:  ctx.GetEventHub(),
+		wakeup:  &waker{store: store, notifier: ctx.GetPushNotifier()},
 	}, nil
 }
 
-func (r *PresentationHandler) ProposePresentationMsg(_ service.DIDCommAction, _ *presentproof.ProposePresentation) {
-
+// ProposePresentationMsg is only sent to verifiers
+func (r *PresentationHandler) ProposePresentationMsg(e service.DIDCommAction, _ *presentproof.ProposePresentation) {
+	log.Println("cloud agent received unexpected presentation proposal")
+	e.Stop(errors.New("cloud agents do not request presentations"))
 }
 
 func (r *PresentationHandler) RequestPresentationMsg(e service.DIDCommAction, req *presentproof.RequestPresentation) {
-	props := e.Properties.(evtProps)
+	props, ok := e.Properties.(evtProps)
+	if !ok {
+		log.Println("presentation request properties invalid")
+		e.Stop(errors.New("presentation request properties invalid"))
+		return
+	}
 
 	cloudAgent, err := r.store.GetCloudAgentForDID(props.MyDID())
 	if err != nil {
 		log.Println("unable to get cloud agent for presentation request", err)
+		e.Stop(errors.Wrap(err, "unable to get cloud agent for presentation request"))
 		return
 	}
 
@@ -68,22 +91,58 @@ func (r *PresentationHandler) RequestPresentationMsg(e service.DIDCommAction, re
 		ID:                  uuid.New().String(),
 		CloudAgentID:        cloudAgent.ID,
 		SystemState:         "requested",
+		Format:              requestFormat(req),
 		MyDID:               props.MyDID(),
 		TheirDID:            props.TheirDID(),
 		ThreadID:            props.PIID(),
 		RequestPresentation: req,
 	}
 
-	err = r.store.InsertCloudAgentProofRequest(pr)
+	err = r.saveProofRequest(cloudAgent, pr)
 	if err != nil {
 		log.Println("unexpected error saving proof request for cloud agent", err)
+		return
+	}
+
+	r.events.Publish(cloudAgent.ID, ProofRequestedEvent, pr.ID)
+	r.wakeup.wake(cloudAgent.ID)
+}
+
+// saveProofRequest saves a received proof request, replacing the proposal that started the thread if the holder
+// sent one
+func (r *PresentationHandler) saveProofRequest(cloudAgent *datastore.CloudAgent, pr *datastore.CloudAgentProofRequest) error {
+	prs, err := r.store.ListCloudAgentProofRequests(cloudAgent)
+	if err != nil {
+		return errors.Wrap(err, "unable to load proof requests")
+	}
+
+	for _, proposed := range prs {
+		if proposed.ThreadID == pr.ThreadID {
+			pr.ID = proposed.ID
+			return r.store.UpdateCloudAgentProofRequest(pr)
+		}
 	}
+
+	return r.store.InsertCloudAgentProofRequest(pr)
+}
+
+// requestFormat is the format of the first attachment of req, or DIF presentation exchange when no format is given
+func requestFormat(req *presentproof.RequestPresentation) string {
+	if len(req.Formats) == 0 {
+		return jsonld.DIFPresentationExchange
+	}
+
+	return req.Formats[0].Format
 }
 
-func (r *PresentationHandler) PresentationMsg(_ service.DIDCommAction, _ *presentproof.Presentation) {
-	panic("implement me")
+// PresentationMsg is only sent to verifiers
+func (r *PresentationHandler) PresentationMsg(e service.DIDCommAction, _ *presentproof.Presentation) {
+	log.Println("cloud agent received unexpected presentation")
+	e.Stop(errors.New("cloud agents do not verify presentations"))
 }
 
-func (r *PresentationHandler) PresentationPreviewMsg(_ service.DIDCommAction, _ *presentproof.Presentation) {
-	panic("implement me")
+// PresentationPreviewMsg is only sent to verifiers
+func (r *PresentationHandler) PresentationPreviewMsg(e service.DIDCommAction, _ *presentproof.Presentation) {
+	log.Println("cloud agent received unexpected presentation preview")
+	e.Stop(errors.New("cloud agents do not verify presentations"))
 }
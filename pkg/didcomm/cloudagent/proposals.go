@@ -0,0 +1,209 @@
+package cloudagent
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"github.com/hyperledger/aries-framework-go/pkg/client/issuecredential"
+	ppclient "github.com/hyperledger/aries-framework-go/pkg/client/presentproof"
+	"github.com/hyperledger/aries-framework-go/pkg/didcomm/protocol/decorator"
+	icprotocol "github.com/hyperledger/aries-framework-go/pkg/didcomm/protocol/issuecredential"
+	"github.com/hyperledger/aries-framework-go/pkg/didcomm/protocol/presentproof"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/scoir/canis/pkg/credential/engine/indy"
+	"github.com/scoir/canis/pkg/datastore"
+	"github.com/scoir/canis/pkg/protogen/common"
+)
+
+// ProposeCredential asks the issuer on the other end of a connection to offer a credential of a schema
+func (r *CloudAgent) ProposeCredential(ctx context.Context, request *common.ProposeCredentialRequest) (*common.ProposeCredentialResponse, error) {
+	cloudAgentID := r.getAgentID(ctx)
+	cloudAgent, err := r.store.GetCloudAgent(cloudAgentID)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "agent with id %s not found", cloudAgentID)
+	}
+
+	if request.SchemaId == "" {
+		return nil, status.Error(codes.InvalidArgument, "schema ID is required")
+	}
+
+	format := request.Format
+	if format == "" {
+		format = indy.Indy
+	}
+
+	if format != indy.Indy {
+		return nil, status.Errorf(codes.Unimplemented, "credential proposals in format %s are not supported", format)
+	}
+
+	conn, err := r.getConnection(cloudAgent, request.ConnectionId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	attrs := make([]icprotocol.Attribute, len(request.Attributes))
+	for i, attr := range request.Attributes {
+		attrs[i] = icprotocol.Attribute{
+			Name:  attr.Name,
+			Value: attr.Value,
+		}
+	}
+
+	cloudAgentCredential, err := r.sendCredentialProposal(cloudAgent, conn, request.SchemaId, request.Comment, attrs)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	err = r.store.InsertCloudAgentCredential(cloudAgentCredential)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to save credential proposal: (%v)", err)
+	}
+
+	return &common.ProposeCredentialResponse{CredentialId: cloudAgentCredential.ID}, nil
+}
+
+// sendCredentialProposal proposes an Indy credential of schemaID with attrs over conn and returns the record of the
+// proposal for the caller to save
+func (r *CloudAgent) sendCredentialProposal(cloudAgent *datastore.CloudAgent, conn *datastore.CloudAgentConnection,
+	schemaID, comment string, attrs []icprotocol.Attribute) (*datastore.CloudAgentCredential, error) {
+
+	filter, err := json.Marshal(&indy.CredentialProposal{SchemaID: schemaID})
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to marshal credential proposal")
+	}
+
+	attachID := uuid.New().String()
+	proposal := &issuecredential.ProposeCredential{
+		Type:    icprotocol.ProposeCredentialMsgType,
+		Comment: comment,
+		CredentialProposal: icprotocol.PreviewCredential{
+			Type:       icprotocol.CredentialPreviewMsgType,
+			Attributes: attrs,
+		},
+		Formats: []icprotocol.Format{
+			{AttachID: attachID, Format: indy.Indy},
+		},
+		FilterAttach: []decorator.Attachment{
+			{
+				ID:       attachID,
+				MimeType: "application/json",
+				Data: decorator.AttachmentData{
+					Base64: base64.StdEncoding.EncodeToString(filter),
+				},
+			},
+		},
+	}
+
+	thid, err := r.credcl.SendProposal(proposal, conn.MyDID, conn.TheirDID)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to send credential proposal")
+	}
+
+	return &datastore.CloudAgentCredential{
+		ID:           uuid.New().String(),
+		CloudAgentID: cloudAgent.ID,
+		SystemState:  "proposed",
+		Format:       indy.Indy,
+		MyDID:        conn.MyDID,
+		TheirDID:     conn.TheirDID,
+		ThreadID:     thid,
+		IssuerConnection: &datastore.IDName{
+			ID:   conn.ConnectionID,
+			Name: conn.TheirLabel,
+		},
+		Offer: &datastore.Offer{
+			Comment: comment,
+			Type:    icprotocol.ProposeCredentialMsgType,
+			Preview: attrs,
+		},
+	}, nil
+}
+
+// ProposePresentation offers to present proof of the previewed attributes and predicates to a verifier
+func (r *CloudAgent) ProposePresentation(ctx context.Context, request *common.ProposePresentationRequest) (*common.ProposePresentationResponse, error) {
+	cloudAgentID := r.getAgentID(ctx)
+	cloudAgent, err := r.store.GetCloudAgent(cloudAgentID)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "agent with id %s not found", cloudAgentID)
+	}
+
+	if len(request.Attributes) == 0 && len(request.Predicates) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one attribute or predicate is required")
+	}
+
+	conn, err := r.getConnection(cloudAgent, request.ConnectionId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	preview := presentproof.PresentationPreview{
+		Type:       presentproof.PresentationPreviewMsgType,
+		Attributes: make([]presentproof.Attribute, len(request.Attributes)),
+		Predicates: make([]presentproof.Predicate, len(request.Predicates)),
+	}
+
+	for i, attr := range request.Attributes {
+		preview.Attributes[i] = presentproof.Attribute{
+			Name:      attr.Name,
+			CredDefID: attr.CredDefId,
+			Value:     attr.Value,
+			Referent:  attr.Referent,
+		}
+	}
+
+	for i, pred := range request.Predicates {
+		preview.Predicates[i] = presentproof.Predicate{
+			Name:      pred.Name,
+			CredDefID: pred.CredDefId,
+			Predicate: pred.Predicate,
+			Threshold: int(pred.Threshold),
+		}
+	}
+
+	proposal := &ppclient.ProposePresentation{
+		Type:                 presentproof.ProposePresentationMsgType,
+		Comment:              request.Comment,
+		PresentationProposal: preview,
+	}
+
+	thid, err := r.prespcli.SendProposePresentation(proposal, conn.MyDID, conn.TheirDID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to send presentation proposal: (%v)", err)
+	}
+
+	pr := &datastore.CloudAgentProofRequest{
+		ID:           uuid.New().String(),
+		CloudAgentID: cloudAgent.ID,
+		SystemState:  "proposed",
+		MyDID:        conn.MyDID,
+		TheirDID:     conn.TheirDID,
+		ThreadID:     thid,
+	}
+
+	err = r.store.InsertCloudAgentProofRequest(pr)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to save presentation proposal: (%v)", err)
+	}
+
+	return &common.ProposePresentationResponse{ProofRequestId: pr.ID}, nil
+}
+
+func (r *CloudAgent) getConnection(cloudAgent *datastore.CloudAgent, connectionID string) (*datastore.CloudAgentConnection, error) {
+	conns, err := r.store.ListCloudAgentConnections(cloudAgent)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to load connections")
+	}
+
+	for _, conn := range conns {
+		if conn.ConnectionID != "" && conn.ConnectionID == connectionID {
+			return conn, nil
+		}
+	}
+
+	return nil, errors.Errorf("connection with id %s not found", connectionID)
+}
@@ -0,0 +1,223 @@
+package cloudagent
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hyperledger/aries-framework-go/pkg/client/issuecredential"
+	ppclient "github.com/hyperledger/aries-framework-go/pkg/client/presentproof"
+	icprotocol "github.com/hyperledger/aries-framework-go/pkg/didcomm/protocol/issuecredential"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/scoir/canis/pkg/credential/engine/indy"
+	"github.com/scoir/canis/pkg/datastore"
+	"github.com/scoir/canis/pkg/datastore/mocks"
+	"github.com/scoir/canis/pkg/protogen/common"
+)
+
+type testCredentialClient struct {
+	proposal *issuecredential.ProposeCredential
+	myDID    string
+	theirDID string
+	err      error
+}
+
+func (r *testCredentialClient) SendProposal(proposal *issuecredential.ProposeCredential, myDID, theirDID string) (string, error) {
+	r.proposal, r.myDID, r.theirDID = proposal, myDID, theirDID
+	if r.err != nil {
+		return "", r.err
+	}
+
+	return "thread-1", nil
+}
+
+func (r *testCredentialClient) AcceptOffer(_ string) error {
+	return nil
+}
+
+func (r *testCredentialClient) AcceptOfferWithRequest(_ string, _ *icprotocol.RequestCredential) error {
+	return nil
+}
+
+type testPresentationClient struct {
+	proposal *ppclient.ProposePresentation
+	myDID    string
+	theirDID string
+	err      error
+}
+
+func (r *testPresentationClient) SendProposePresentation(proposal *ppclient.ProposePresentation, myDID, theirDID string) (string, error) {
+	r.proposal, r.myDID, r.theirDID = proposal, myDID, theirDID
+	if r.err != nil {
+		return "", r.err
+	}
+
+	return "thread-1", nil
+}
+
+func (r *testPresentationClient) AcceptRequestPresentation(_ string, _ *ppclient.Presentation) error {
+	return nil
+}
+
+func proposalStore(agent *datastore.CloudAgent) *mocks.Store {
+	store := &mocks.Store{}
+	store.On("GetCloudAgent", "agent-id").Return(agent, nil)
+	store.On("ListCloudAgentConnections", agent).Return([]*datastore.CloudAgentConnection{
+		{CloudAgentID: "agent-id", InvitationID: "invitation-1"},
+		{CloudAgentID: "agent-id", ConnectionID: "conn-1", MyDID: "did:mine", TheirDID: "did:theirs", TheirLabel: "Issuer"},
+	}, nil)
+
+	return store
+}
+
+func TestProposeCredential(t *testing.T) {
+	agent := &datastore.CloudAgent{ID: "agent-id"}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(CanisCloudAgentIDHeaderKey, "agent-id"))
+	request := &common.ProposeCredentialRequest{
+		ConnectionId: "conn-1",
+		SchemaId:     "Vnd9TKYd3VRqLCJEuiUUNu:2:license:1.0",
+		Comment:      "please",
+		Attributes:   []*common.CredentialAttribute{{Name: "name", Value: "Alice"}},
+	}
+
+	t.Run("happy path", func(t *testing.T) {
+		store := proposalStore(agent)
+		insert := func(cred *datastore.CloudAgentCredential) bool {
+			return cred.CloudAgentID == "agent-id" && cred.SystemState == "proposed" && cred.Format == indy.Indy &&
+				cred.ThreadID == "thread-1" && cred.TheirDID == "did:theirs" && cred.IssuerConnection.ID == "conn-1" &&
+				cred.Offer.Preview[0].Value == "Alice"
+		}
+		store.On("InsertCloudAgentCredential", mock.MatchedBy(insert)).Return(nil)
+		credcl := &testCredentialClient{}
+
+		target := &CloudAgent{store: store, credcl: credcl}
+		resp, err := target.ProposeCredential(ctx, request)
+		require.NoError(t, err)
+		require.NotEmpty(t, resp.CredentialId)
+
+		require.Equal(t, "did:mine", credcl.myDID)
+		require.Equal(t, "did:theirs", credcl.theirDID)
+		require.Equal(t, "please", credcl.proposal.Comment)
+		require.Equal(t, indy.Indy, credcl.proposal.Formats[0].Format)
+		require.Equal(t, credcl.proposal.Formats[0].AttachID, credcl.proposal.FilterAttach[0].ID)
+
+		d, err := credcl.proposal.FilterAttach[0].Data.Fetch()
+		require.NoError(t, err)
+		filter := &indy.CredentialProposal{}
+		require.NoError(t, json.Unmarshal(d, filter))
+		require.Equal(t, request.SchemaId, filter.SchemaID)
+		store.AssertExpectations(t)
+	})
+
+	t.Run("unknown connection", func(t *testing.T) {
+		store := proposalStore(agent)
+		credcl := &testCredentialClient{}
+
+		target := &CloudAgent{store: store, credcl: credcl}
+		_, err := target.ProposeCredential(ctx, &common.ProposeCredentialRequest{ConnectionId: "invitation-1", SchemaId: request.SchemaId})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		require.Nil(t, credcl.proposal)
+	})
+
+	t.Run("unsupported format", func(t *testing.T) {
+		store := proposalStore(agent)
+
+		target := &CloudAgent{store: store, credcl: &testCredentialClient{}}
+		_, err := target.ProposeCredential(ctx, &common.ProposeCredentialRequest{ConnectionId: "conn-1", SchemaId: request.SchemaId,
+			Format: "lds/ld-proof"})
+		require.Equal(t, codes.Unimplemented, status.Code(err))
+	})
+
+	t.Run("client error", func(t *testing.T) {
+		store := proposalStore(agent)
+
+		target := &CloudAgent{store: store, credcl: &testCredentialClient{err: errors.New("boom")}}
+		_, err := target.ProposeCredential(ctx, request)
+		require.Equal(t, codes.Internal, status.Code(err))
+		store.AssertNotCalled(t, "InsertCloudAgentCredential", mock.Anything)
+	})
+
+	t.Run("store error", func(t *testing.T) {
+		store := proposalStore(agent)
+		store.On("InsertCloudAgentCredential", mock.Anything).Return(errors.New("boom"))
+
+		target := &CloudAgent{store: store, credcl: &testCredentialClient{}}
+		_, err := target.ProposeCredential(ctx, request)
+		require.Equal(t, codes.Internal, status.Code(err))
+	})
+}
+
+func TestProposePresentation(t *testing.T) {
+	agent := &datastore.CloudAgent{ID: "agent-id"}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(CanisCloudAgentIDHeaderKey, "agent-id"))
+	request := &common.ProposePresentationRequest{
+		ConnectionId: "conn-1",
+		Comment:      "proof of age",
+		Attributes: []*common.PresentationPreviewAttribute{
+			{Name: "name", CredDefId: "Vnd9TKYd3VRqLCJEuiUUNu:3:CL:10:default", Referent: "0"},
+		},
+		Predicates: []*common.PresentationPreviewPredicate{
+			{Name: "age", CredDefId: "Vnd9TKYd3VRqLCJEuiUUNu:3:CL:10:default", Predicate: ">=", Threshold: 18},
+		},
+	}
+
+	t.Run("happy path", func(t *testing.T) {
+		store := proposalStore(agent)
+		insert := func(pr *datastore.CloudAgentProofRequest) bool {
+			return pr.CloudAgentID == "agent-id" && pr.SystemState == "proposed" && pr.ThreadID == "thread-1" &&
+				pr.MyDID == "did:mine" && pr.TheirDID == "did:theirs"
+		}
+		store.On("InsertCloudAgentProofRequest", mock.MatchedBy(insert)).Return(nil)
+		prespcli := &testPresentationClient{}
+
+		target := &CloudAgent{store: store, prespcli: prespcli}
+		resp, err := target.ProposePresentation(ctx, request)
+		require.NoError(t, err)
+		require.NotEmpty(t, resp.ProofRequestId)
+
+		require.Equal(t, "did:mine", prespcli.myDID)
+		require.Equal(t, "did:theirs", prespcli.theirDID)
+		preview := prespcli.proposal.PresentationProposal
+		require.Len(t, preview.Attributes, 1)
+		require.Equal(t, "name", preview.Attributes[0].Name)
+		require.Equal(t, "0", preview.Attributes[0].Referent)
+		require.Len(t, preview.Predicates, 1)
+		require.Equal(t, ">=", preview.Predicates[0].Predicate)
+		require.Equal(t, 18, preview.Predicates[0].Threshold)
+		store.AssertExpectations(t)
+	})
+
+	t.Run("no attributes or predicates", func(t *testing.T) {
+		store := proposalStore(agent)
+
+		target := &CloudAgent{store: store, prespcli: &testPresentationClient{}}
+		_, err := target.ProposePresentation(ctx, &common.ProposePresentationRequest{ConnectionId: "conn-1"})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("unknown connection", func(t *testing.T) {
+		store := proposalStore(agent)
+		prespcli := &testPresentationClient{}
+
+		target := &CloudAgent{store: store, prespcli: prespcli}
+		_, err := target.ProposePresentation(ctx, &common.ProposePresentationRequest{ConnectionId: "conn-2",
+			Attributes: request.Attributes})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		require.Nil(t, prespcli.proposal)
+	})
+
+	t.Run("client error", func(t *testing.T) {
+		store := proposalStore(agent)
+
+		target := &CloudAgent{store: store, prespcli: &testPresentationClient{err: errors.New("boom")}}
+		_, err := target.ProposePresentation(ctx, request)
+		require.Equal(t, codes.Internal, status.Code(err))
+		store.AssertNotCalled(t, "InsertCloudAgentProofRequest", mock.Anything)
+	})
+}
@@ -0,0 +1,34 @@
+package cloudagent
+
+import (
+	"log"
+
+	"github.com/scoir/canis/pkg/datastore"
+	"github.com/scoir/canis/pkg/push"
+)
+
+// waker sends content free push notifications to the device of a cloud agent when an offer or proof request
+// arrives for it, so it does not have to poll
+type waker struct {
+	store    datastore.Store
+	notifier push.Notifier
+}
+
+func (r *waker) wake(cloudAgentID string) {
+	agent, err := r.store.GetCloudAgent(cloudAgentID)
+	if err != nil {
+		log.Println("unable to load cloud agent to wake", cloudAgentID, err)
+		return
+	}
+
+	if agent.PushToken == "" {
+		return
+	}
+
+	go func() {
+		err := r.notifier.Wake(agent.PushToken)
+		if err != nil {
+			log.Println("unable to wake cloud agent", cloudAgentID, err)
+		}
+	}()
+}
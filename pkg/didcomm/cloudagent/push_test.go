@@ -0,0 +1,46 @@
+package cloudagent
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scoir/canis/pkg/datastore"
+	"github.com/scoir/canis/pkg/datastore/mocks"
+)
+
+type testNotifier struct {
+	tokens chan string
+}
+
+func (r *testNotifier) Wake(token string) error {
+	r.tokens <- token
+	return nil
+}
+
+func TestWake(t *testing.T) {
+	t.Run("registered device", func(t *testing.T) {
+		store := &mocks.Store{}
+		notifier := &testNotifier{tokens: make(chan string, 1)}
+		target := &waker{store: store, notifier: notifier}
+
+		store.On("GetCloudAgent", "agent").Return(&datastore.CloudAgent{ID: "agent", PushToken: "device-token"}, nil)
+
+		target.wake("agent")
+		require.Equal(t, "device-token", <-notifier.tokens)
+	})
+
+	t.Run("no push token", func(t *testing.T) {
+		store := &mocks.Store{}
+		notifier := &testNotifier{tokens: make(chan string, 1)}
+		target := &waker{store: store, notifier: notifier}
+
+		store.On("GetCloudAgent", "agent").Return(&datastore.CloudAgent{ID: "agent"}, nil)
+		store.On("GetCloudAgent", "missing").Return(nil, errors.New("not found"))
+
+		target.wake("agent")
+		target.wake("missing")
+		require.Empty(t, notifier.tokens)
+	})
+}
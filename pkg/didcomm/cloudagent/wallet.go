@@ -0,0 +1,625 @@
+package cloudagent
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/json"
+	"io"
+	"log"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/google/tink/go/insecurecleartextkeyset"
+	"github.com/google/tink/go/keyset"
+	ed25519pb "github.com/google/tink/go/proto/ed25519_go_proto"
+	"github.com/google/uuid"
+	icprotocol "github.com/hyperledger/aries-framework-go/pkg/didcomm/protocol/issuecredential"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/did"
+	"github.com/hyperledger/aries-framework-go/pkg/kms"
+	"github.com/hyperledger/aries-framework-go/pkg/kms/localkms"
+	"github.com/hyperledger/aries-framework-go/pkg/storage"
+	"github.com/hyperledger/aries-framework-go/pkg/store/connection"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/scoir/canis/pkg/credential/engine/indy"
+	"github.com/scoir/canis/pkg/datastore"
+	"github.com/scoir/canis/pkg/protogen/common"
+	"github.com/scoir/canis/pkg/schema"
+)
+
+// WalletKeySize is the size of the AES-256 key a wallet export is encrypted with
+const WalletKeySize = 32
+
+const walletArchiveVersion = 1
+
+// reissueRequested is the state of a credential bound to the shared master secret while its reissue is pending
+const reissueRequested = "reissue-requested"
+
+// walletAAD binds the ciphertext to the archive format so it can not be mistaken for other data encrypted
+// with the same key
+var walletAAD = []byte("canis-cloudagent-wallet-v1")
+
+// walletArchive is everything needed to restore a cloud agent in another deployment
+type walletArchive struct {
+	Version          int                                 `json:"version"`
+	MasterSecret     string                              `json:"master_secret,omitempty"`
+	Keys             []*walletKey                        `json:"keys"`
+	DIDDocs          []json.RawMessage                   `json:"did_docs"`
+	AriesConnections []*connection.Record                `json:"aries_connections"`
+	Connections      []*datastore.CloudAgentConnection   `json:"connections"`
+	Credentials      []*datastore.CloudAgentCredential   `json:"credentials"`
+	ProofRequests    []*datastore.CloudAgentProofRequest `json:"proof_requests"`
+}
+
+type walletKey struct {
+	ID   string `json:"id"`
+	Seed []byte `json:"seed"`
+}
+
+type connectionRecorder interface {
+	GetConnectionRecord(connectionID string) (*connection.Record, error)
+	SaveConnectionRecord(record *connection.Record) error
+	RemoveConnection(connectionID string) error
+}
+
+type didConnectionStore interface {
+	SaveDIDFromDoc(doc *did.Doc) error
+}
+
+// ExportWallet returns the keys, connections, credentials and proof requests of the calling cloud agent encrypted
+// with the key in the request
+func (r *CloudAgent) ExportWallet(ctx context.Context, request *common.ExportWalletRequest) (*common.ExportWalletResponse, error) {
+	if len(request.Key) != WalletKeySize {
+		return nil, status.Errorf(codes.InvalidArgument, "wallet key must be %d bytes", WalletKeySize)
+	}
+
+	cloudAgentID := r.getAgentID(ctx)
+	cloudAgent, err := r.store.GetCloudAgent(cloudAgentID)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "agent with id %s not found", cloudAgentID)
+	}
+
+	archive, err := r.exportWallet(cloudAgent)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to export wallet: (%v)", err)
+	}
+
+	shared := boundCredentials(archive.Credentials, masterSecretID)
+	if len(shared) > 0 {
+		r.requestReissue(cloudAgent, archive.Connections, shared)
+		return nil, status.Errorf(codes.FailedPrecondition,
+			"%d credentials are bound to the master secret of the deployment, export again once the issuers have "+
+				"reissued them", len(shared))
+	}
+
+	msID := ownMasterSecretID(cloudAgent.ID)
+	if len(boundCredentials(archive.Credentials, msID)) > 0 {
+		archive.MasterSecret, err = r.prover.GetMasterSecret(msID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "unable to load master secret: (%v)", err)
+		}
+	}
+
+	d, err := json.Marshal(archive)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to marshal wallet: (%v)", err)
+	}
+
+	sealed, err := sealWallet(request.Key, d)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to encrypt wallet: (%v)", err)
+	}
+
+	return &common.ExportWalletResponse{Archive: sealed}, nil
+}
+
+// ImportWallet restores a wallet export into the calling cloud agent
+func (r *CloudAgent) ImportWallet(ctx context.Context, request *common.ImportWalletRequest) (*common.ImportWalletResponse, error) {
+	if len(request.Key) != WalletKeySize {
+		return nil, status.Errorf(codes.InvalidArgument, "wallet key must be %d bytes", WalletKeySize)
+	}
+
+	cloudAgentID := r.getAgentID(ctx)
+	cloudAgent, err := r.store.GetCloudAgent(cloudAgentID)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "agent with id %s not found", cloudAgentID)
+	}
+
+	d, err := openWallet(request.Key, request.Archive)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "unable to decrypt wallet, wrong key or corrupt archive")
+	}
+
+	archive := &walletArchive{}
+	err = json.Unmarshal(d, archive)
+	if err != nil || archive.Version != walletArchiveVersion {
+		return nil, status.Error(codes.InvalidArgument, "unsupported wallet archive")
+	}
+
+	err = r.checkWalletArchive(archive)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.checkMasterSecret(cloudAgent, archive)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.importWallet(cloudAgent, archive)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to import wallet: (%v)", err)
+	}
+
+	return resp, nil
+}
+
+// checkWalletArchive makes sure the DID documents and Aries connection records of archive belong to its connections,
+// that its Indy credentials come with their master secret and that it does not replace any records already in this
+// deployment
+func (r *CloudAgent) checkWalletArchive(archive *walletArchive) error {
+	conns := map[string]*datastore.CloudAgentConnection{}
+	for _, conn := range archive.Connections {
+		if conn.ConnectionID != "" {
+			conns[conn.ConnectionID] = conn
+		}
+	}
+
+	dids := map[string]bool{}
+	for _, conn := range conns {
+		dids[conn.MyDID] = true
+		dids[conn.TheirDID] = true
+	}
+
+	for _, d := range archive.DIDDocs {
+		doc, err := did.ParseDocument(d)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid DID document: (%v)", err)
+		}
+
+		if !dids[doc.ID] {
+			return status.Errorf(codes.InvalidArgument, "DID document %s does not belong to a connection in the archive", doc.ID)
+		}
+	}
+
+	for _, cred := range archive.Credentials {
+		if archive.MasterSecret == "" && cred.Format == indy.Indy && cred.CredentialRequestMetadata != nil {
+			return status.Error(codes.InvalidArgument, "archive holds indy credentials but no master secret")
+		}
+	}
+
+	for _, rec := range archive.AriesConnections {
+		conn, ok := conns[rec.ConnectionID]
+		if !ok || conn.MyDID != rec.MyDID || conn.TheirDID != rec.TheirDID {
+			return status.Errorf(codes.InvalidArgument, "connection record %s does not belong to a connection in the archive", rec.ConnectionID)
+		}
+
+		_, err := r.connections.GetConnectionRecord(rec.ConnectionID)
+		if err == nil {
+			return status.Errorf(codes.AlreadyExists, "connection %s already exists", rec.ConnectionID)
+		}
+		if !errors.Is(err, storage.ErrDataNotFound) {
+			return status.Errorf(codes.Internal, "unable to load connection record %s: (%v)", rec.ConnectionID, err)
+		}
+	}
+
+	return nil
+}
+
+// checkMasterSecret makes sure importing archive does not replace a master secret cloudAgent already holds
+// credentials for
+func (r *CloudAgent) checkMasterSecret(cloudAgent *datastore.CloudAgent, archive *walletArchive) error {
+	if archive.MasterSecret == "" {
+		return nil
+	}
+
+	id := ownMasterSecretID(cloudAgent.ID)
+	existing, err := r.prover.GetMasterSecret(id)
+	if err != nil || existing == archive.MasterSecret {
+		return nil
+	}
+
+	creds, err := r.store.ListCloudAgentCredentials(cloudAgent)
+	if err != nil {
+		return status.Errorf(codes.Internal, "unable to load credentials: (%v)", err)
+	}
+
+	if len(boundCredentials(creds, id)) > 0 {
+		return status.Error(codes.FailedPrecondition,
+			"this agent holds credentials bound to its master secret, which the archive would replace")
+	}
+
+	return nil
+}
+
+func (r *CloudAgent) exportWallet(cloudAgent *datastore.CloudAgent) (*walletArchive, error) {
+	archive := &walletArchive{Version: walletArchiveVersion}
+
+	var err error
+	archive.Connections, err = r.store.ListCloudAgentConnections(cloudAgent)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to load connections")
+	}
+
+	archive.Credentials, err = r.store.ListCloudAgentCredentials(cloudAgent)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to load credentials")
+	}
+
+	archive.ProofRequests, err = r.store.ListCloudAgentProofRequests(cloudAgent)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to load proof requests")
+	}
+
+	for _, conn := range archive.Connections {
+		if conn.ConnectionID == "" {
+			continue
+		}
+
+		rec, err := r.connections.GetConnectionRecord(conn.ConnectionID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to load connection record %s", conn.ConnectionID)
+		}
+		archive.AriesConnections = append(archive.AriesConnections, rec)
+
+		myDoc, err := r.exportDIDDoc(archive, conn.MyDID)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = r.exportDIDDoc(archive, conn.TheirDID)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, pk := range myDoc.PublicKey {
+			key, err := r.exportKey(pk)
+			if err != nil {
+				return nil, errors.Wrapf(err, "unable to export key %s of %s", pk.ID, conn.MyDID)
+			}
+			archive.Keys = append(archive.Keys, key)
+		}
+	}
+
+	return archive, nil
+}
+
+func (r *CloudAgent) exportDIDDoc(archive *walletArchive, didID string) (*did.Doc, error) {
+	doc, err := r.vdriReg.Resolve(didID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to resolve %s", didID)
+	}
+
+	d, err := doc.JSONBytes()
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to marshal DID document of %s", didID)
+	}
+
+	archive.DIDDocs = append(archive.DIDDocs, d)
+	return doc, nil
+}
+
+// exportKey finds the private key for a public key of one of our DID documents.  Keys are looked up by the KMS
+// key ID derived from the public key and then by the fragment of the verification method ID
+func (r *CloudAgent) exportKey(pk did.PublicKey) (*walletKey, error) {
+	var kids []string
+	if kid, err := localkms.CreateKID(pk.Value, kms.ED25519Type); err == nil {
+		kids = append(kids, kid)
+	}
+	if i := strings.LastIndex(pk.ID, "#"); i >= 0 {
+		kids = append(kids, pk.ID[i+1:])
+	}
+
+	for _, kid := range kids {
+		h, err := r.keyMgr.Get(kid)
+		if err != nil {
+			continue
+		}
+
+		kh, ok := h.(*keyset.Handle)
+		if !ok {
+			return nil, errors.Errorf("unexpected key handle %T", h)
+		}
+
+		seed, err := ed25519Seed(kh)
+		if err != nil {
+			return nil, err
+		}
+
+		return &walletKey{ID: kid, Seed: seed}, nil
+	}
+
+	return nil, errors.New("key not found in KMS")
+}
+
+// missingDIDKeys returns the keys of doc that SaveDIDFromDoc would add, so a failed import only removes those
+func (r *CloudAgent) missingDIDKeys(doc *did.Doc) ([]string, error) {
+	var out []string
+	for _, key := range docKeys(doc) {
+		_, err := r.didKeys.Get(key)
+		if errors.Is(err, storage.ErrDataNotFound) {
+			out = append(out, key)
+			continue
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to load key of %s", doc.ID)
+		}
+	}
+
+	return out, nil
+}
+
+// importWallet saves the contents of archive for cloudAgent.  Credentials and proof requests get new IDs and
+// pending invitations are skipped.  Everything this import added but keys and DID documents, which the KMS and VDRI
+// can not remove, is rolled back if the import fails
+func (r *CloudAgent) importWallet(cloudAgent *datastore.CloudAgent, archive *walletArchive) (resp *common.ImportWalletResponse, err error) {
+	var undo []func() error
+	defer func() {
+		if err == nil {
+			return
+		}
+
+		for i := len(undo) - 1; i >= 0; i-- {
+			if uerr := undo[i](); uerr != nil {
+				log.Println("unable to roll back wallet import", uerr)
+			}
+		}
+	}()
+
+	for _, key := range archive.Keys {
+		if _, err := r.keyMgr.Get(key.ID); err == nil {
+			continue
+		}
+
+		if len(key.Seed) != ed25519.SeedSize {
+			return nil, errors.Errorf("invalid seed for key %s", key.ID)
+		}
+
+		_, _, err := r.keyMgr.ImportPrivateKey(ed25519.NewKeyFromSeed(key.Seed), kms.ED25519Type, kms.WithKeyID(key.ID))
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to import key %s", key.ID)
+		}
+	}
+
+	for _, d := range archive.DIDDocs {
+		doc, err := did.ParseDocument(d)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid DID document")
+		}
+
+		if _, err := r.vdriReg.Resolve(doc.ID); err != nil {
+			err = r.vdriReg.Store(doc)
+			if err != nil {
+				return nil, errors.Wrapf(err, "unable to store DID document %s", doc.ID)
+			}
+		}
+
+		added, err := r.missingDIDKeys(doc)
+		if err != nil {
+			return nil, err
+		}
+
+		err = r.didConnections.SaveDIDFromDoc(doc)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to save DID connection for %s", doc.ID)
+		}
+		undo = append(undo, func() error { return r.deleteDIDKeys(doc.ID, added) })
+	}
+
+	for _, rec := range archive.AriesConnections {
+		err := r.connections.SaveConnectionRecord(rec)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to save connection record %s", rec.ConnectionID)
+		}
+
+		id := rec.ConnectionID
+		undo = append(undo, func() error { return r.connections.RemoveConnection(id) })
+	}
+
+	if archive.MasterSecret != "" {
+		id := ownMasterSecretID(cloudAgent.ID)
+		previous, perr := r.prover.GetMasterSecret(id)
+		if perr == nil && previous != archive.MasterSecret {
+			err := r.prover.DeleteMasterSecret(id)
+			if err != nil {
+				return nil, errors.Wrap(err, "unable to replace master secret")
+			}
+		}
+
+		if perr != nil || previous != archive.MasterSecret {
+			undo = append(undo, func() error {
+				err := r.prover.DeleteMasterSecret(id)
+				if err != nil || perr != nil {
+					return err
+				}
+				return r.prover.ImportMasterSecret(id, previous)
+			})
+		}
+
+		err := r.prover.ImportMasterSecret(id, archive.MasterSecret)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to import master secret")
+		}
+	}
+
+	resp = &common.ImportWalletResponse{}
+	for _, conn := range archive.Connections {
+		if conn.ConnectionID == "" {
+			continue
+		}
+
+		conn.CloudAgentID = cloudAgent.ID
+		err := r.store.InsertCloudAgentConnection(conn)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to save connection %s", conn.ConnectionID)
+		}
+
+		id := conn.ConnectionID
+		undo = append(undo, func() error { return r.store.DeleteCloudAgentConnection(cloudAgent, id) })
+		resp.Connections++
+	}
+
+	for _, cred := range archive.Credentials {
+		cred.ID = uuid.New().String()
+		cred.CloudAgentID = cloudAgent.ID
+		cred.Replaces = ""
+		if cred.Format == indy.Indy && cred.CredentialRequestMetadata != nil {
+			cred.CredentialRequestMetadata.MasterSecretName = ownMasterSecretID(cloudAgent.ID)
+		}
+		err := r.store.InsertCloudAgentCredential(cred)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to save credential %s", cred.ID)
+		}
+
+		id := cred.ID
+		undo = append(undo, func() error { return r.store.DeleteCloudAgentCredential(cloudAgent, id) })
+		resp.Credentials++
+	}
+
+	for _, pr := range archive.ProofRequests {
+		pr.ID = uuid.New().String()
+		pr.CloudAgentID = cloudAgent.ID
+		err := r.store.InsertCloudAgentProofRequest(pr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to save proof request %s", pr.ID)
+		}
+
+		id := pr.ID
+		undo = append(undo, func() error { return r.store.DeleteCloudAgentProofRequest(cloudAgent, id) })
+		resp.ProofRequests++
+	}
+
+	return resp, nil
+}
+
+// requestReissue migrates credentials bound to the shared master secret by proposing each of them again to its
+// issuer.  Once the reissued credential arrives, bound to the master secret of the agent, it replaces the old one
+func (r *CloudAgent) requestReissue(cloudAgent *datastore.CloudAgent, conns []*datastore.CloudAgentConnection,
+	creds []*datastore.CloudAgentCredential) {
+
+	for _, cred := range creds {
+		if cred.SystemState == reissueRequested || cred.Credential == nil || cred.IssuerConnection == nil {
+			continue
+		}
+
+		err := r.reissueCredential(cloudAgent, conns, cred)
+		if err != nil {
+			log.Println("unable to request reissue of credential", cred.ID, err)
+		}
+	}
+}
+
+func (r *CloudAgent) reissueCredential(cloudAgent *datastore.CloudAgent, conns []*datastore.CloudAgentConnection,
+	cred *datastore.CloudAgentCredential) error {
+
+	var conn *datastore.CloudAgentConnection
+	for _, c := range conns {
+		if c.ConnectionID != "" && c.ConnectionID == cred.IssuerConnection.ID {
+			conn = c
+			break
+		}
+	}
+	if conn == nil {
+		return errors.Errorf("issuer connection %s not found", cred.IssuerConnection.ID)
+	}
+
+	issued := &schema.IndyCredential{}
+	err := json.Unmarshal(cred.Credential.Data, issued)
+	if err != nil {
+		return errors.Wrap(err, "invalid indy credential")
+	}
+
+	var attrs []icprotocol.Attribute
+	if cred.Offer != nil {
+		attrs = cred.Offer.Preview
+	}
+
+	proposal, err := r.sendCredentialProposal(cloudAgent, conn, issued.SchemaID, "reissue to a new master secret", attrs)
+	if err != nil {
+		return err
+	}
+
+	proposal.Replaces = cred.ID
+	err = r.store.InsertCloudAgentCredential(proposal)
+	if err != nil {
+		return errors.Wrap(err, "unable to save credential proposal")
+	}
+
+	cred.SystemState = reissueRequested
+	return r.store.UpdateCloudAgentCredential(cred)
+}
+
+// boundCredentials returns the Indy credentials in creds that are bound to the master secret msID
+func boundCredentials(creds []*datastore.CloudAgentCredential, msID string) []*datastore.CloudAgentCredential {
+	var out []*datastore.CloudAgentCredential
+	for _, cred := range creds {
+		if cred.Format == indy.Indy && cred.CredentialRequestMetadata != nil && credentialMasterSecretID(cred) == msID {
+			out = append(out, cred)
+		}
+	}
+
+	return out
+}
+
+// ed25519Seed extracts the seed of the primary ED25519 key of kh
+func ed25519Seed(kh *keyset.Handle) ([]byte, error) {
+	ks := insecurecleartextkeyset.KeysetMaterial(kh)
+	for _, key := range ks.Key {
+		if key.KeyId != ks.PrimaryKeyId {
+			continue
+		}
+
+		priv := &ed25519pb.Ed25519PrivateKey{}
+		err := proto.Unmarshal(key.KeyData.Value, priv)
+		if err != nil {
+			return nil, errors.Wrap(err, "key is not an ED25519 private key")
+		}
+
+		return priv.KeyValue, nil
+	}
+
+	return nil, errors.New("key set has no primary key")
+}
+
+func sealWallet(key, plaintext []byte) ([]byte, error) {
+	aead, err := walletAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	_, err = io.ReadFull(rand.Reader, nonce)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to generate nonce")
+	}
+
+	return aead.Seal(nonce, nonce, plaintext, walletAAD), nil
+}
+
+func openWallet(key, sealed []byte) ([]byte, error) {
+	aead, err := walletAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(sealed) < aead.NonceSize() {
+		return nil, errors.New("archive too short")
+	}
+
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	return aead.Open(nil, nonce, ciphertext, walletAAD)
+}
+
+func walletAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid wallet key")
+	}
+
+	return cipher.NewGCM(block)
+}
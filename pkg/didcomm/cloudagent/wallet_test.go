@@ -0,0 +1,290 @@
+package cloudagent
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	icprotocol "github.com/hyperledger/aries-framework-go/pkg/didcomm/protocol/issuecredential"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/did"
+	vdriMock "github.com/hyperledger/aries-framework-go/pkg/mock/vdri"
+	"github.com/hyperledger/aries-framework-go/pkg/storage"
+	"github.com/hyperledger/aries-framework-go/pkg/storage/mem"
+	"github.com/hyperledger/aries-framework-go/pkg/store/connection"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/scoir/canis/pkg/credential/engine/indy"
+	"github.com/scoir/canis/pkg/datastore"
+	"github.com/scoir/canis/pkg/datastore/mocks"
+	"github.com/scoir/canis/pkg/protogen/common"
+	cursa "github.com/scoir/canis/pkg/ursa"
+)
+
+func TestSealWallet(t *testing.T) {
+	key := bytes.Repeat([]byte{1}, WalletKeySize)
+	plaintext := []byte(`{"version":1}`)
+
+	sealed, err := sealWallet(key, plaintext)
+	require.NoError(t, err)
+	require.NotContains(t, string(sealed), "version")
+
+	opened, err := openWallet(key, sealed)
+	require.NoError(t, err)
+	require.Equal(t, plaintext, opened)
+
+	_, err = openWallet(bytes.Repeat([]byte{2}, WalletKeySize), sealed)
+	require.Error(t, err)
+
+	_, err = openWallet(key, sealed[:4])
+	require.Error(t, err)
+
+	sealed[len(sealed)-1] ^= 0xff
+	_, err = openWallet(key, sealed)
+	require.Error(t, err)
+}
+
+type testStorageProvider struct {
+	provider storage.Provider
+}
+
+func (r *testStorageProvider) StorageProvider() storage.Provider {
+	return r.provider
+}
+
+type testDIDConnections struct {
+	saved []string
+}
+
+func (r *testDIDConnections) SaveDIDFromDoc(doc *did.Doc) error {
+	r.saved = append(r.saved, doc.ID)
+	return nil
+}
+
+func newTestProver(t *testing.T) *cursa.Prover {
+	prover, err := cursa.NewProver(&testStorageProvider{provider: mem.NewProvider()})
+	require.NoError(t, err)
+	return prover
+}
+
+func TestWalletRoundTrip(t *testing.T) {
+	key := bytes.Repeat([]byte{1}, WalletKeySize)
+	source := &datastore.CloudAgent{ID: "source-agent"}
+	dest := &datastore.CloudAgent{ID: "dest-agent"}
+	sourceCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(CanisCloudAgentIDHeaderKey, source.ID))
+	destCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(CanisCloudAgentIDHeaderKey, dest.ID))
+	doc := &did.Doc{Context: []string{did.Context}, ID: "did:peer:mine", Service: []did.Service{
+		{ID: "did:peer:mine#didcomm", Type: "did-communication", RecipientKeys: []string{"key-existing", "key-new"},
+			ServiceEndpoint: "https://example.com"},
+	}}
+
+	sourceAgent := func(t *testing.T, cred *datastore.CloudAgentCredential) (*CloudAgent, *mocks.Store) {
+		store := &mocks.Store{}
+		store.On("GetCloudAgent", source.ID).Return(source, nil)
+		store.On("ListCloudAgentConnections", source).Return([]*datastore.CloudAgentConnection{
+			{CloudAgentID: source.ID, ConnectionID: "conn-1", MyDID: "did:peer:mine", TheirDID: "did:peer:theirs"},
+			{CloudAgentID: source.ID, InvitationID: "invitation-1"},
+		}, nil)
+		store.On("ListCloudAgentCredentials", source).Return([]*datastore.CloudAgentCredential{cred}, nil)
+		store.On("ListCloudAgentProofRequests", source).Return([]*datastore.CloudAgentProofRequest{
+			{ID: "pr-1", CloudAgentID: source.ID},
+		}, nil)
+
+		prover := newTestProver(t)
+		require.NoError(t, prover.ImportMasterSecret(masterSecretID, "shared-secret"))
+		require.NoError(t, prover.ImportMasterSecret(ownMasterSecretID(source.ID), "own-secret"))
+
+		target := &CloudAgent{
+			store:  store,
+			credcl: &testCredentialClient{},
+			prover: prover,
+			connections: &testConnectionRecorder{records: map[string]*connection.Record{
+				"conn-1": {ConnectionID: "conn-1", MyDID: "did:peer:mine", TheirDID: "did:peer:theirs"},
+			}},
+			vdriReg: &vdriMock.MockVDRIRegistry{ResolveValue: doc},
+		}
+		return target, store
+	}
+
+	ownCred := func() *datastore.CloudAgentCredential {
+		return &datastore.CloudAgentCredential{ID: "cred-1", CloudAgentID: source.ID, Format: indy.Indy,
+			CredentialRequestMetadata: &datastore.CredentialRequestMetadata{MasterSecretName: ownMasterSecretID(source.ID)}}
+	}
+
+	export := func(t *testing.T, cred *datastore.CloudAgentCredential) (*common.ExportWalletResponse, error) {
+		target, _ := sourceAgent(t, cred)
+		return target.ExportWallet(sourceCtx, &common.ExportWalletRequest{Key: key})
+	}
+
+	newDest := func(t *testing.T) (*CloudAgent, *mocks.Store) {
+		store := &mocks.Store{}
+		store.On("GetCloudAgent", dest.ID).Return(dest, nil)
+
+		return &CloudAgent{
+			store:          store,
+			prover:         newTestProver(t),
+			connections:    &testConnectionRecorder{},
+			didConnections: &testDIDConnections{},
+			didKeys:        &testDIDKeys{},
+			vdriReg:        &vdriMock.MockVDRIRegistry{ResolveErr: errors.New("not found")},
+		}, store
+	}
+
+	t.Run("round trip", func(t *testing.T) {
+		exported, err := export(t, ownCred())
+		require.NoError(t, err)
+
+		target, store := newDest(t)
+		store.On("InsertCloudAgentConnection", mock.MatchedBy(func(c *datastore.CloudAgentConnection) bool {
+			return c.CloudAgentID == dest.ID && c.ConnectionID == "conn-1"
+		})).Return(nil)
+		store.On("InsertCloudAgentCredential", mock.MatchedBy(func(c *datastore.CloudAgentCredential) bool {
+			return c.CloudAgentID == dest.ID && c.ID != "" && c.ID != "cred-1" &&
+				credentialMasterSecretID(c) == ownMasterSecretID(dest.ID)
+		})).Return(nil)
+		store.On("InsertCloudAgentProofRequest", mock.MatchedBy(func(pr *datastore.CloudAgentProofRequest) bool {
+			return pr.CloudAgentID == dest.ID && pr.ID != "" && pr.ID != "pr-1"
+		})).Return(nil)
+
+		resp, err := target.ImportWallet(destCtx, &common.ImportWalletRequest{Key: key, Archive: exported.Archive})
+		require.NoError(t, err)
+		require.Equal(t, int64(1), resp.Connections)
+		require.Equal(t, int64(1), resp.Credentials)
+		require.Equal(t, int64(1), resp.ProofRequests)
+		store.AssertExpectations(t)
+
+		rec, err := target.connections.GetConnectionRecord("conn-1")
+		require.NoError(t, err)
+		require.Equal(t, "did:peer:theirs", rec.TheirDID)
+
+		ms, err := target.prover.GetMasterSecret(ownMasterSecretID(dest.ID))
+		require.NoError(t, err)
+		require.Equal(t, "own-secret", ms)
+		require.Equal(t, []string{"did:peer:mine", "did:peer:mine"}, target.didConnections.(*testDIDConnections).saved)
+	})
+
+	t.Run("credentials bound to the shared master secret are reissued", func(t *testing.T) {
+		cred := &datastore.CloudAgentCredential{ID: "cred-1", CloudAgentID: source.ID, Format: indy.Indy,
+			IssuerConnection:          &datastore.IDName{ID: "conn-1"},
+			Offer:                     &datastore.Offer{Preview: []icprotocol.Attribute{{Name: "name", Value: "Alice"}}},
+			Credential:                &datastore.Credential{Data: []byte(`{"schema_id":"Vnd9TKYd3VRqLCJEuiUUNu:2:license:1.0"}`)},
+			CredentialRequestMetadata: &datastore.CredentialRequestMetadata{MasterSecretName: "master_secret"},
+		}
+		target, store := sourceAgent(t, cred)
+		store.On("InsertCloudAgentCredential", mock.MatchedBy(func(c *datastore.CloudAgentCredential) bool {
+			return c.Replaces == "cred-1" && c.SystemState == "proposed" && c.ThreadID == "thread-1"
+		})).Return(nil)
+		store.On("UpdateCloudAgentCredential", mock.MatchedBy(func(c *datastore.CloudAgentCredential) bool {
+			return c.ID == "cred-1" && c.SystemState == reissueRequested
+		})).Return(nil)
+
+		_, err := target.ExportWallet(sourceCtx, &common.ExportWalletRequest{Key: key})
+		require.Equal(t, codes.FailedPrecondition, status.Code(err))
+		store.AssertExpectations(t)
+
+		credcl := target.credcl.(*testCredentialClient)
+		require.Equal(t, "did:peer:mine", credcl.myDID)
+		require.Equal(t, "Alice", credcl.proposal.CredentialProposal.Attributes[0].Value)
+
+		_, err = target.ExportWallet(sourceCtx, &common.ExportWalletRequest{Key: key})
+		require.Equal(t, codes.FailedPrecondition, status.Code(err))
+		store.AssertNumberOfCalls(t, "InsertCloudAgentCredential", 1)
+	})
+
+	t.Run("existing connection is not replaced", func(t *testing.T) {
+		exported, err := export(t, ownCred())
+		require.NoError(t, err)
+
+		target, store := newDest(t)
+		existing := &connection.Record{ConnectionID: "conn-1", MyDID: "did:peer:other"}
+		target.connections = &testConnectionRecorder{records: map[string]*connection.Record{"conn-1": existing}}
+
+		_, err = target.ImportWallet(destCtx, &common.ImportWalletRequest{Key: key, Archive: exported.Archive})
+		require.Equal(t, codes.AlreadyExists, status.Code(err))
+
+		rec, err := target.connections.GetConnectionRecord("conn-1")
+		require.NoError(t, err)
+		require.Equal(t, existing, rec)
+		store.AssertNotCalled(t, "InsertCloudAgentConnection", mock.Anything)
+	})
+
+	t.Run("failed import is rolled back", func(t *testing.T) {
+		exported, err := export(t, ownCred())
+		require.NoError(t, err)
+
+		target, store := newDest(t)
+		target.didKeys = &testDIDKeys{existing: map[string]bool{"key-existing": true}}
+		store.On("InsertCloudAgentConnection", mock.Anything).Return(nil)
+		store.On("InsertCloudAgentCredential", mock.Anything).Return(nil)
+		store.On("InsertCloudAgentProofRequest", mock.Anything).Return(errors.New("boom"))
+		store.On("DeleteCloudAgentCredential", dest, mock.Anything).Return(nil)
+		store.On("DeleteCloudAgentConnection", dest, "conn-1").Return(nil)
+
+		_, err = target.ImportWallet(destCtx, &common.ImportWalletRequest{Key: key, Archive: exported.Archive})
+		require.Equal(t, codes.Internal, status.Code(err))
+		store.AssertExpectations(t)
+
+		require.Equal(t, []string{"conn-1"}, target.connections.(*testConnectionRecorder).removed)
+		_, err = target.prover.GetMasterSecret(ownMasterSecretID(dest.ID))
+		require.Error(t, err)
+
+		deleted := target.didKeys.(*testDIDKeys).deleted
+		require.Contains(t, deleted, "key-new")
+		require.NotContains(t, deleted, "key-existing")
+	})
+
+	t.Run("DID documents of other DIDs are rejected", func(t *testing.T) {
+		source, _ := sourceAgent(t, ownCred())
+		source.vdriReg = &vdriMock.MockVDRIRegistry{ResolveValue: &did.Doc{Context: []string{did.Context}, ID: "did:peer:stranger"}}
+		exported, err := source.ExportWallet(sourceCtx, &common.ExportWalletRequest{Key: key})
+		require.NoError(t, err)
+
+		target, store := newDest(t)
+		_, err = target.ImportWallet(destCtx, &common.ImportWalletRequest{Key: key, Archive: exported.Archive})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		require.Empty(t, target.didConnections.(*testDIDConnections).saved)
+		store.AssertNotCalled(t, "InsertCloudAgentConnection", mock.Anything)
+	})
+
+	t.Run("master secret in use is not replaced", func(t *testing.T) {
+		exported, err := export(t, ownCred())
+		require.NoError(t, err)
+
+		target, store := newDest(t)
+		require.NoError(t, target.prover.ImportMasterSecret(ownMasterSecretID(dest.ID), "dest-secret"))
+		store.On("ListCloudAgentCredentials", dest).Return([]*datastore.CloudAgentCredential{
+			{ID: "held", Format: indy.Indy,
+				CredentialRequestMetadata: &datastore.CredentialRequestMetadata{MasterSecretName: ownMasterSecretID(dest.ID)}},
+		}, nil)
+
+		_, err = target.ImportWallet(destCtx, &common.ImportWalletRequest{Key: key, Archive: exported.Archive})
+		require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+		ms, err := target.prover.GetMasterSecret(ownMasterSecretID(dest.ID))
+		require.NoError(t, err)
+		require.Equal(t, "dest-secret", ms)
+	})
+
+	t.Run("unused master secret is replaced", func(t *testing.T) {
+		exported, err := export(t, ownCred())
+		require.NoError(t, err)
+
+		target, store := newDest(t)
+		require.NoError(t, target.prover.ImportMasterSecret(ownMasterSecretID(dest.ID), "dest-secret"))
+		store.On("ListCloudAgentCredentials", dest).Return(nil, nil)
+		store.On("InsertCloudAgentConnection", mock.Anything).Return(nil)
+		store.On("InsertCloudAgentCredential", mock.Anything).Return(nil)
+		store.On("InsertCloudAgentProofRequest", mock.Anything).Return(nil)
+
+		_, err = target.ImportWallet(destCtx, &common.ImportWalletRequest{Key: key, Archive: exported.Archive})
+		require.NoError(t, err)
+
+		ms, err := target.prover.GetMasterSecret(ownMasterSecretID(dest.ID))
+		require.NoError(t, err)
+		require.Equal(t, "own-secret", ms)
+	})
+}
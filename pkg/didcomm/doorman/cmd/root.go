@@ -12,7 +12,6 @@ import (
 	"os"
 	"strings"
 
-	transportamqp "github.com/hyperledger/aries-framework-go-ext/component/didcomm/transport/amqp"
 	"github.com/hyperledger/aries-framework-go/pkg/didcomm/transport/ws"
 	"github.com/hyperledger/aries-framework-go/pkg/framework/aries"
 	ariescontext "github.com/hyperledger/aries-framework-go/pkg/framework/context"
@@ -124,6 +123,11 @@ func (r *Provider) GetBridgeEndpoint() (*framework.Endpoint, error) {
 	return r.conf.Endpoint("api.grpcBridge")
 }
 
+// GetTLSConfig returns the mutual TLS settings for the GRPC server
+func (r *Provider) GetTLSConfig() (*framework.TLSConfig, error) {
+	return r.conf.TLS()
+}
+
 func (r *Provider) GetAMQPPublisher(queue string) amqp.Publisher {
 	cfg, err := r.conf.AMQPConfig()
 	if err != nil {
@@ -160,7 +164,7 @@ func (r *Provider) GetAriesContext() (*ariescontext.Provider, error) {
 		return nil, err
 	}
 
-	amqpInbound, err := transportamqp.NewInbound(cfg.Endpoint(), external, "didexchange", "", "")
+	amqpInbound, err := rabbitmq.NewInbound(cfg.Endpoint(), external, "didexchange")
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to create amqp inbound")
 	}
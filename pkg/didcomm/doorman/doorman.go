@@ -178,7 +178,7 @@ func (r *Doorman) publishEvent(agent *datastore.Agent, externalID string, conn *
 		return
 	}
 
-	err = r.notificationPublisher.Publish(message, "application/json")
+	err = r.notificationPublisher.Publish(context.Background(), message, "application/json")
 	if err != nil {
 		log.Printf("unable to publish doorman event")
 		return
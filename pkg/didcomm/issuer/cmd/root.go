@@ -8,12 +8,12 @@ package cmd
 
 import (
 	"fmt"
-	"io/ioutil"
+	"io"
 	"log"
 	"os"
 	"strings"
+	"sync"
 
-	"github.com/hyperledger/aries-framework-go-ext/component/didcomm/transport/amqp"
 	"github.com/hyperledger/aries-framework-go/pkg/didcomm/dispatcher"
 	icprotocol "github.com/hyperledger/aries-framework-go/pkg/didcomm/protocol/issuecredential"
 	"github.com/hyperledger/aries-framework-go/pkg/didcomm/transport/ws"
@@ -30,6 +30,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 
+	"github.com/scoir/canis/pkg/amqp/rabbitmq"
 	"github.com/scoir/canis/pkg/aries/didcomm/protocol/middleware/issuecredential"
 	"github.com/scoir/canis/pkg/config"
 	"github.com/scoir/canis/pkg/credential/engine"
@@ -39,6 +40,7 @@ import (
 	"github.com/scoir/canis/pkg/didcomm/issuer"
 	"github.com/scoir/canis/pkg/framework"
 	"github.com/scoir/canis/pkg/framework/context"
+	indywrapper "github.com/scoir/canis/pkg/indy"
 	"github.com/scoir/canis/pkg/ursa"
 )
 
@@ -62,6 +64,9 @@ type Provider struct {
 	store                datastore.Store
 	ariesStorageProvider storage.Provider
 	conf                 config.Config
+	poolsOnce            sync.Once
+	pools                *indywrapper.Pools
+	ledgerCache          *indywrapper.LedgerCache
 }
 
 func (r *Provider) Oracle() indy.Oracle {
@@ -159,6 +164,11 @@ func (r *Provider) GetBridgeEndpoint() (*framework.Endpoint, error) {
 	return r.conf.Endpoint("api.grpcBridge")
 }
 
+// GetTLSConfig returns the mutual TLS settings for the GRPC server
+func (r *Provider) GetTLSConfig() (*framework.TLSConfig, error) {
+	return r.conf.TLS()
+}
+
 func (r *Provider) VDRIRegistry() vdriapi.Registry {
 	ctx, err := r.GetAriesContext()
 	if err != nil {
@@ -189,7 +199,7 @@ func (r *Provider) GetAriesContext() (*ariescontext.Provider, error) {
 		return nil, err
 	}
 
-	amqpInbound, err := amqp.NewInbound(cfg.Endpoint(), external, "issue-credential", "", "")
+	amqpInbound, err := rabbitmq.NewInbound(cfg.Endpoint(), external, "issue-credential")
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to create amqp aries inbound")
 	}
@@ -234,9 +244,12 @@ func (r *Provider) newIssueCredentialSvc() api.ProtocolSvcCreator {
 }
 
 func (r *Provider) IndyVDR() (indy.VDRClient, error) {
-	genesisFile := r.conf.GetString("registry.indy.genesisFile")
-	re := strings.NewReader(genesisFile)
-	cl, err := vdr.New(ioutil.NopCloser(re))
+	return r.IndyLedger("")
+}
+
+// IndyLedger returns the client for the ledger with the did:indy namespace, or the default ledger if empty
+func (r *Provider) IndyLedger(namespace string) (indy.VDRClient, error) {
+	cl, err := r.LedgerPools().Client(namespace)
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to get indy vdr client")
 	}
@@ -244,6 +257,47 @@ func (r *Provider) IndyVDR() (indy.VDRClient, error) {
 	return cl, nil
 }
 
+// LedgerPools returns the configured ledger pools, refreshed on the configured schedule
+func (r *Provider) LedgerPools() *indywrapper.Pools {
+	r.poolsOnce.Do(func() {
+		ledgers, err := r.conf.Ledgers()
+		if err != nil {
+			log.Fatalln("invalid ledgers configuration", err)
+		}
+
+		lc, err := r.conf.LedgerCache()
+		if err != nil {
+			log.Fatalln("invalid ledgerCache configuration", err)
+		}
+		r.ledgerCache = indywrapper.NewLedgerCache(lc)
+
+		pc, err := r.conf.PoolRefresh()
+		if err != nil {
+			log.Fatalln("invalid poolRefresh configuration", err)
+		}
+		r.pools = indywrapper.NewPools(ledgers, r.conf.GetString("registry.indy.genesisFile"), r.openLedger)
+		r.pools.Monitor(pc)
+	})
+
+	return r.pools
+}
+
+// Ready returns an error if the default ledger can not be reached
+func (r *Provider) Ready() error {
+	return r.LedgerPools().Ready()
+}
+
+func (r *Provider) openLedger(namespace string, genesis io.ReadCloser) (indywrapper.IndyVDRClient, error) {
+	cl, err := vdr.New(genesis)
+	if err != nil {
+		return nil, err
+	}
+
+	ec := indywrapper.NewEndorsingClient(cl, r.store, r.KMS(), r.conf.GetString("endorser"), namespace)
+	tc := indywrapper.NewTracedClient(indywrapper.NewTAAClient(ec, r.store, namespace))
+	return indywrapper.NewCachingClient(tc, r.ledgerCache, namespace), nil
+}
+
 func (r *Provider) KMS() kms.KeyManager {
 	mgr, err := localkms.New("local-lock://default/master/key/", r)
 	if err != nil {
@@ -15,6 +15,7 @@ import (
 	"github.com/scoir/canis/pkg/credential"
 	"github.com/scoir/canis/pkg/didcomm/issuer"
 	"github.com/scoir/canis/pkg/framework"
+	"github.com/scoir/canis/pkg/tracing"
 )
 
 var startCmd = &cobra.Command{
@@ -25,6 +26,8 @@ var startCmd = &cobra.Command{
 }
 
 func runStart(_ *cobra.Command, _ []string) {
+	defer tracing.Setup("canis-didcomm-issuer", ctx.conf)()
+
 	actx, err := ctx.GetAriesContext()
 	if err != nil {
 		log.Fatalln("unable to get aries context")
@@ -1,6 +1,7 @@
 package issuer
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -16,6 +17,7 @@ import (
 	"github.com/scoir/canis/pkg/credential/engine"
 	"github.com/scoir/canis/pkg/datastore"
 	"github.com/scoir/canis/pkg/notifier"
+	"github.com/scoir/canis/pkg/tracing"
 )
 
 type CredHandler struct {
@@ -82,7 +84,7 @@ func (r *CredHandler) ProposeCredentialMsg(e service.DIDCommAction, proposal *ic
 			continue
 		}
 
-		schemaID, err := r.registry.GetSchemaForProposal(format.Format, data)
+		schemaID, err := r.registry.GetSchemaForProposal(tracing.ThreadContext(thid), format.Format, data)
 		if err != nil || !agent.CanIssue(schemaID) {
 			log.Printf("invalid request for schema %s against agent %s", schemaID, agent.Name)
 			continue
@@ -176,7 +178,7 @@ func (r *CredHandler) RequestCredentialMsg(e service.DIDCommAction, request *icp
 	}
 
 	requestAttachment := request.RequestsAttach[0]
-	attachmentData, err := r.registry.IssueCredential(did, schema, cred.RegistryOfferID,
+	attachmentData, err := r.registry.IssueCredential(tracing.ThreadContext(thid), did, schema, cred.RegistryOfferID,
 		requestAttachment.Data, values)
 	if err != nil {
 		msg := fmt.Sprintf("registry error creating credential: %v", err)
@@ -252,7 +254,7 @@ func (r *CredHandler) publishEvent(evt interface{}) error {
 	}
 
 	fmt.Println(string(message))
-	err = r.notificationPublisher.Publish(message, "application/json")
+	err = r.notificationPublisher.Publish(context.Background(), message, "application/json")
 	if err != nil {
 		return errors.Wrap(err, "unable to publish credential event")
 	}
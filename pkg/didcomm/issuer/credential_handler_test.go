@@ -109,10 +109,10 @@ func TestCredHandler_ProposeCredentialMsg(t *testing.T) {
 		suite.store.On("FindCredentialByProtocolID", thid).Return(nil, errors.New("not found"))
 		suite.store.On("GetAgentByPublicDID", "did:my").Return(agent, nil)
 		suite.store.On("GetAgentConnectionForDID", agent, "did:their").Return(ac, nil)
-		suite.registry.On("GetSchemaForProposal", "hlindy-zkp-v1.0", []byte(`{}`)).Return(schemaID, nil)
+		suite.registry.On("GetSchemaForProposal", mock.Anything, "hlindy-zkp-v1.0", []byte(`{}`)).Return(schemaID, nil)
 		suite.store.On("GetSchema", schemaID).Return(schema, nil)
 		suite.store.On("InsertCredential", mock.AnythingOfType("*datastore.IssuedCredential")).Return("cred-id", nil)
-		suite.notificationPublisher.On("Publish", []byte(publishedMsg), "application/json").Return(nil)
+		suite.notificationPublisher.On("Publish", mock.Anything, []byte(publishedMsg), "application/json").Return(nil)
 
 		suite.target.ProposeCredentialMsg(action, proposal)
 
@@ -156,7 +156,7 @@ func TestCredHandler_ProposeCredentialMsg(t *testing.T) {
 		suite.store.On("FindCredentialByProtocolID", thid).Return(nil, errors.New("not found"))
 		suite.store.On("GetAgentByPublicDID", "did:my").Return(agent, nil)
 		suite.store.On("GetAgentConnectionForDID", agent, "did:their").Return(ac, nil)
-		suite.registry.On("GetSchemaForProposal", "hlindy-zkp-v1.0", []byte(`{}`)).Return(schemaID, nil)
+		suite.registry.On("GetSchemaForProposal", mock.Anything, "hlindy-zkp-v1.0", []byte(`{}`)).Return(schemaID, nil)
 		suite.store.On("GetSchema", schemaID).Return(schema, nil)
 		suite.store.On("InsertCredential", mock.AnythingOfType("*datastore.IssuedCredential")).Return("", errors.New("bad error"))
 
@@ -201,7 +201,7 @@ func TestCredHandler_ProposeCredentialMsg(t *testing.T) {
 		suite.store.On("FindCredentialByProtocolID", thid).Return(nil, errors.New("not found"))
 		suite.store.On("GetAgentByPublicDID", "did:my").Return(agent, nil)
 		suite.store.On("GetAgentConnectionForDID", agent, "did:their").Return(ac, nil)
-		suite.registry.On("GetSchemaForProposal", "hlindy-zkp-v1.0", []byte(`{}`)).Return(schemaID, nil)
+		suite.registry.On("GetSchemaForProposal", mock.Anything, "hlindy-zkp-v1.0", []byte(`{}`)).Return(schemaID, nil)
 		suite.store.On("GetSchema", schemaID).Return(nil, errors.New("not found"))
 
 		suite.target.ProposeCredentialMsg(action, proposal)
@@ -243,7 +243,7 @@ func TestCredHandler_ProposeCredentialMsg(t *testing.T) {
 		suite.store.On("FindCredentialByProtocolID", thid).Return(nil, errors.New("not found"))
 		suite.store.On("GetAgentByPublicDID", "did:my").Return(agent, nil)
 		suite.store.On("GetAgentConnectionForDID", agent, "did:their").Return(ac, nil)
-		suite.registry.On("GetSchemaForProposal", "hlindy-zkp-v1.0", []byte(`{}`)).Return(schemaID, nil)
+		suite.registry.On("GetSchemaForProposal", mock.Anything, "hlindy-zkp-v1.0", []byte(`{}`)).Return(schemaID, nil)
 
 		suite.target.ProposeCredentialMsg(action, proposal)
 
@@ -382,7 +382,7 @@ func TestRequestCredentialMsg(t *testing.T) {
 		suite.store.On("GetAgent", "agent-01").Return(agent, nil)
 		suite.store.On("GetSchema", "schema-01").Return(schema, nil)
 		suite.store.On("GetDID", "did:sov:123").Return(did, nil)
-		suite.registry.On("IssueCredential", did, schema, "1234", request.RequestsAttach[0].Data, values).Return(attach, nil)
+		suite.registry.On("IssueCredential", mock.Anything, did, schema, "1234", request.RequestsAttach[0].Data, values).Return(attach, nil)
 		suite.store.On("UpdateCredential", mock.MatchedBy(match)).Return(nil)
 
 		suite.target.RequestCredentialMsg(action, request)
@@ -437,7 +437,7 @@ func TestRequestCredentialMsg(t *testing.T) {
 		suite.store.On("GetAgent", "agent-01").Return(agent, nil)
 		suite.store.On("GetSchema", "schema-01").Return(schema, nil)
 		suite.store.On("GetDID", "did:sov:123").Return(did, nil)
-		suite.registry.On("IssueCredential", did, schema, "1234", request.RequestsAttach[0].Data, values).Return(attach, nil)
+		suite.registry.On("IssueCredential", mock.Anything, did, schema, "1234", request.RequestsAttach[0].Data, values).Return(attach, nil)
 		suite.store.On("UpdateCredential", mock.MatchedBy(match)).Return(errors.New("boom"))
 
 		suite.target.RequestCredentialMsg(action, request)
@@ -487,7 +487,7 @@ func TestRequestCredentialMsg(t *testing.T) {
 		suite.store.On("GetAgent", "agent-01").Return(agent, nil)
 		suite.store.On("GetSchema", "schema-01").Return(schema, nil)
 		suite.store.On("GetDID", "did:sov:123").Return(did, nil)
-		suite.registry.On("IssueCredential", did, schema, "1234", request.RequestsAttach[0].Data, values).Return(attach, nil)
+		suite.registry.On("IssueCredential", mock.Anything, did, schema, "1234", request.RequestsAttach[0].Data, values).Return(attach, nil)
 
 		suite.target.RequestCredentialMsg(action, request)
 		require.Equal(t, err.Error(), "unable to fetch attachment: no contents in this attachment")
@@ -535,7 +535,7 @@ func TestRequestCredentialMsg(t *testing.T) {
 		suite.store.On("GetAgent", "agent-01").Return(agent, nil)
 		suite.store.On("GetSchema", "schema-01").Return(schema, nil)
 		suite.store.On("GetDID", "did:sov:123").Return(did, nil)
-		suite.registry.On("IssueCredential", did, schema, "1234", request.RequestsAttach[0].Data, values).Return(nil, errors.New("engine failure"))
+		suite.registry.On("IssueCredential", mock.Anything, did, schema, "1234", request.RequestsAttach[0].Data, values).Return(nil, errors.New("engine failure"))
 
 		suite.target.RequestCredentialMsg(action, request)
 		require.Equal(t, err.Error(), "registry error creating credential: engine failure")
@@ -66,7 +66,7 @@ func (r *Server) APISpec() (http.HandlerFunc, error) {
 	return nil, errors.New("not implemented")
 }
 
-func (r *Server) IssueCredential(_ context.Context, req *common.IssueCredentialRequest) (*common.IssueCredentialResponse, error) {
+func (r *Server) IssueCredential(ctx context.Context, req *common.IssueCredentialRequest) (*common.IssueCredentialResponse, error) {
 
 	agent, err := r.store.GetAgent(req.AgentName)
 	if err != nil {
@@ -98,7 +98,7 @@ func (r *Server) IssueCredential(_ context.Context, req *common.IssueCredentialR
 		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("error unmarshaling credential body: %v", err))
 	}
 
-	registryOfferID, attachment, err := r.registry.CreateCredentialOffer(agent.PublicDID, ac.TheirDID, schema, body)
+	registryOfferID, attachment, err := r.registry.CreateCredentialOffer(ctx, agent.PublicDID, ac.TheirDID, schema, body)
 	if err != nil {
 		return nil, status.Error(codes.Internal, fmt.Sprintf("unexpected error creating credential offer: %v", err))
 	}
@@ -61,7 +61,7 @@ func TestIssueCredential(t *testing.T) {
 		suite.store.On("GetAgent", "agent-1").Return(a, nil)
 		suite.store.On("GetAgentConnection", a, "external-1").Return(ac, nil)
 		suite.store.On("GetSchema", "schema-2").Return(sch, nil)
-		suite.registry.On("CreateCredentialOffer", a.PublicDID, "did:keri:123", sch, []byte(`{}`)).Return("1234", attach, nil)
+		suite.registry.On("CreateCredentialOffer", mock.Anything, a.PublicDID, "did:keri:123", sch, []byte(`{}`)).Return("1234", attach, nil)
 		suite.issuer.On("SendOffer", mock.MatchedBy(matcher), "did:keri:abc", "did:keri:123").Return("123", nil)
 		suite.store.On("InsertCredential", mock.MatchedBy(cred)).Return("abc", nil)
 
@@ -108,7 +108,7 @@ func TestIssueCredential(t *testing.T) {
 		suite.store.On("GetAgent", "agent-1").Return(a, nil)
 		suite.store.On("GetAgentConnection", a, "external-1").Return(ac, nil)
 		suite.store.On("GetSchema", "schema-2").Return(sch, nil)
-		suite.registry.On("CreateCredentialOffer", a.PublicDID, "did:keri:123", sch, []byte(`{}`)).Return("1234", attach, nil)
+		suite.registry.On("CreateCredentialOffer", mock.Anything, a.PublicDID, "did:keri:123", sch, []byte(`{}`)).Return("1234", attach, nil)
 		suite.issuer.On("SendOffer", mock.MatchedBy(matcher), "did:keri:abc", "did:keri:123").Return("123", nil)
 		suite.store.On("InsertCredential", mock.MatchedBy(cred)).Return("", errors.New("unable to save"))
 
@@ -151,7 +151,7 @@ func TestIssueCredential(t *testing.T) {
 		suite.store.On("GetAgent", "agent-1").Return(a, nil)
 		suite.store.On("GetAgentConnection", a, "external-1").Return(ac, nil)
 		suite.store.On("GetSchema", "schema-2").Return(sch, nil)
-		suite.registry.On("CreateCredentialOffer", a.PublicDID, "did:keri:123", sch, []byte(`{}`)).Return("1234", attach, nil)
+		suite.registry.On("CreateCredentialOffer", mock.Anything, a.PublicDID, "did:keri:123", sch, []byte(`{}`)).Return("1234", attach, nil)
 		suite.issuer.On("SendOffer", mock.MatchedBy(matcher), "did:keri:abc", "did:keri:123").Return("", errors.New("boom"))
 
 		res, err := suite.target.IssueCredential(context.Background(), request)
@@ -181,7 +181,7 @@ func TestIssueCredential(t *testing.T) {
 		suite.store.On("GetAgent", "agent-1").Return(a, nil)
 		suite.store.On("GetAgentConnection", a, "external-1").Return(ac, nil)
 		suite.store.On("GetSchema", "schema-2").Return(sch, nil)
-		suite.registry.On("CreateCredentialOffer", a.PublicDID, "did:keri:123", sch, []byte(`{}`)).Return("", nil, errors.New("registry failed"))
+		suite.registry.On("CreateCredentialOffer", mock.Anything, a.PublicDID, "did:keri:123", sch, []byte(`{}`)).Return("", nil, errors.New("registry failed"))
 
 		res, err := suite.target.IssueCredential(context.Background(), request)
 		require.Error(t, err)
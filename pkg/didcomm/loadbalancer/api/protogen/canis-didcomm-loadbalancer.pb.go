@@ -1,14 +1,13 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.25.0
-// 	protoc        v3.6.1
+// 	protoc-gen-go v1.27.1
+// 	protoc        (unknown)
 // source: canis-didcomm-loadbalancer.proto
 
 package api
 
 import (
 	context "context"
-	proto "github.com/golang/protobuf/proto"
 	common "github.com/scoir/canis/pkg/protogen/common"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -25,10 +24,6 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-// This is a compile-time assertion that a sufficiently up-to-date version
-// of the legacy proto package is being used.
-const _ = proto.ProtoPackageIsVersion4
-
 var File_canis_didcomm_loadbalancer_proto protoreflect.FileDescriptor
 
 var file_canis_didcomm_loadbalancer_proto_rawDesc = []byte{
@@ -97,3 +97,8 @@ func (r *Provider) GetGRPCEndpoint() (*framework.Endpoint, error) {
 func (r *Provider) GetBridgeEndpoint() (*framework.Endpoint, error) {
 	return nil, errors.New("not supported")
 }
+
+// GetTLSConfig returns the mutual TLS settings for the GRPC server
+func (r *Provider) GetTLSConfig() (*framework.TLSConfig, error) {
+	return r.conf.TLS()
+}
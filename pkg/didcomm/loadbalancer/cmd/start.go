@@ -14,6 +14,7 @@ import (
 
 	"github.com/scoir/canis/pkg/controller"
 	lb "github.com/scoir/canis/pkg/didcomm/loadbalancer"
+	"github.com/scoir/canis/pkg/tracing"
 )
 
 var startCmd = &cobra.Command{
@@ -24,6 +25,8 @@ var startCmd = &cobra.Command{
 }
 
 func runStart(_ *cobra.Command, _ []string) {
+	defer tracing.Setup("canis-didcomm-lb", prov.conf)()
+
 	host := prov.conf.GetString("inbound.host")
 	httpPort := prov.conf.GetInt("inbound.httpport")
 	wsPort := prov.conf.GetInt("inbound.wsport")
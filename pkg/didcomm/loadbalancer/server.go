@@ -19,14 +19,19 @@ import (
 
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/hyperledger/aries-framework-go/pkg/didcomm/common/transport"
+	"github.com/hyperledger/aries-framework-go/pkg/storage"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
 	"nhooyr.io/websocket"
 
 	"github.com/scoir/canis/pkg/amqp"
 	"github.com/scoir/canis/pkg/amqp/rabbitmq"
+	"github.com/scoir/canis/pkg/aries/retiredkeys"
 	api "github.com/scoir/canis/pkg/didcomm/loadbalancer/api/protogen"
 	"github.com/scoir/canis/pkg/protogen/common"
+	"github.com/scoir/canis/pkg/tracing"
 )
 
 const (
@@ -49,6 +54,7 @@ type Server struct {
 
 type provider interface {
 	Packager() transport.Packager
+	StorageProvider() storage.Provider
 }
 
 //TODO:  to make this testable, need a "PublisherProvider" that will create the publisher here so we aren't hardcoding to RabbitMQ
@@ -63,11 +69,16 @@ func New(prov provider, amqpAddr, host string, httpPort, wsPort int, external st
 		qs[queueName] = p
 	}
 
+	keys, err := retiredkeys.New(prov)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to open retired keys")
+	}
+
 	return &Server{
 		wsAddr:     fmt.Sprintf("%s:%d", host, wsPort),
 		httpAddr:   fmt.Sprintf("%s:%d", host, httpPort),
 		external:   external,
-		packager:   prov.Packager(),
+		packager:   retiredkeys.NewPackager(prov.Packager(), keys),
 		publishers: qs,
 	}, nil
 }
@@ -145,15 +156,9 @@ func (r *Server) listener(conn *websocket.Conn, outbound bool) {
 			break
 		}
 
-		pub, err := r.publisherFromMessage(message)
-		if err != nil {
-			log.Printf("error typing message: %v", err)
-			continue
-		}
-
-		err = pub.Publish(message, "application/json")
+		err = r.dispatch(context.Background(), "ws", message)
 		if err != nil {
-			log.Printf("error publishing message: %v", err)
+			log.Printf("error dispatching message: %v", err)
 		}
 	}
 }
@@ -222,14 +227,9 @@ func (r *Server) startHTTP() {
 			return
 		}
 
-		pub, err := r.publisherFromMessage(body)
-		if err != nil {
-			log.Printf("error typing message: %v", err)
-			return
-		}
-
-		err = pub.Publish(body, "application/json")
+		err = r.dispatch(req.Context(), "http", body)
 		if err != nil {
+			log.Printf("error dispatching message: %v", err)
 			return
 		}
 	})
@@ -243,6 +243,22 @@ func (r *Server) startHTTP() {
 	}
 }
 
+// dispatch publishes an inbound DIDComm message to the queue for its protocol, starting the trace that
+// follows the message through AMQP to the protocol service
+func (r *Server) dispatch(ctx context.Context, via string, message []byte) (err error) {
+	ctx, span := tracing.Start(ctx, "didcomm receive",
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(attribute.String("didcomm.transport", via)))
+	defer func() { tracing.End(span, err) }()
+
+	pub, err := r.publisherFromMessage(message)
+	if err != nil {
+		return errors.Wrap(err, "error typing message")
+	}
+
+	return pub.Publish(ctx, message, "application/json")
+}
+
 func (r *Server) publisherFromMessage(message []byte) (amqp.Publisher, error) {
 	unpackMsg, err := r.packager.UnpackMessage(message)
 	if err != nil {
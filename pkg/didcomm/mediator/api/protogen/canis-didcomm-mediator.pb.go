@@ -1,14 +1,13 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.25.0
-// 	protoc        v3.6.1
+// 	protoc-gen-go v1.27.1
+// 	protoc        (unknown)
 // source: canis-didcomm-mediator.proto
 
 package api
 
 import (
 	context "context"
-	proto "github.com/golang/protobuf/proto"
 	common "github.com/scoir/canis/pkg/protogen/common"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -25,18 +24,14 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-// This is a compile-time assertion that a sufficiently up-to-date version
-// of the legacy proto package is being used.
-const _ = proto.ProtoPackageIsVersion4
-
 var File_canis_didcomm_mediator_proto protoreflect.FileDescriptor
 
 var file_canis_didcomm_mediator_proto_rawDesc = []byte{
 	0x0a, 0x1c, 0x63, 0x61, 0x6e, 0x69, 0x73, 0x2d, 0x64, 0x69, 0x64, 0x63, 0x6f, 0x6d, 0x6d, 0x2d,
 	0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07,
 	0x64, 0x69, 0x64, 0x63, 0x6f, 0x6d, 0x6d, 0x1a, 0x15, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0xaa,
-	0x01, 0x0a, 0x08, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x5a, 0x0a, 0x11, 0x52,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0xa3,
+	0x04, 0x0a, 0x08, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x5a, 0x0a, 0x11, 0x52,
 	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x45, 0x64, 0x67, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74,
 	0x12, 0x20, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
 	0x65, 0x72, 0x45, 0x64, 0x67, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
@@ -46,27 +41,67 @@ var file_canis_didcomm_mediator_proto_rawDesc = []byte{
 	0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x17, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
 	0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
 	0x18, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x16, 0x5a, 0x14, 0x64,
-	0x69, 0x64, 0x63, 0x6f, 0x6d, 0x6d, 0x2f, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x2f,
-	0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x10, 0x47,
+	0x65, 0x74, 0x4d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x1c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x60,
+	0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x64, 0x67, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x73, 0x12, 0x22, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x45, 0x64, 0x67, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x74,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x64, 0x67, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74,
+	0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x5d, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x45, 0x64, 0x67, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74,
+	0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x12, 0x21, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x47, 0x65, 0x74, 0x45, 0x64, 0x67, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x74,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x64, 0x67, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x63, 0x0a, 0x14, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x45, 0x64, 0x67, 0x65, 0x41, 0x67, 0x65,
+	0x6e, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x23, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x45, 0x64, 0x67, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74,
+	0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x45, 0x64, 0x67, 0x65,
+	0x41, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x42, 0x16, 0x5a, 0x14, 0x64, 0x69, 0x64, 0x63, 0x6f, 0x6d, 0x6d, 0x2f,
+	0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var file_canis_didcomm_mediator_proto_goTypes = []interface{}{
-	(*common.RegisterEdgeAgentRequest)(nil),  // 0: common.RegisterEdgeAgentRequest
-	(*common.EndpointRequest)(nil),           // 1: common.EndpointRequest
-	(*common.RegisterEdgeAgentResponse)(nil), // 2: common.RegisterEdgeAgentResponse
-	(*common.EndpointResponse)(nil),          // 3: common.EndpointResponse
+	(*common.RegisterEdgeAgentRequest)(nil),     // 0: common.RegisterEdgeAgentRequest
+	(*common.EndpointRequest)(nil),              // 1: common.EndpointRequest
+	(*common.MailboxStatusRequest)(nil),         // 2: common.MailboxStatusRequest
+	(*common.ListEdgeAgentRoutesRequest)(nil),   // 3: common.ListEdgeAgentRoutesRequest
+	(*common.GetEdgeAgentRoutesRequest)(nil),    // 4: common.GetEdgeAgentRoutesRequest
+	(*common.RevokeEdgeAgentRouteRequest)(nil),  // 5: common.RevokeEdgeAgentRouteRequest
+	(*common.RegisterEdgeAgentResponse)(nil),    // 6: common.RegisterEdgeAgentResponse
+	(*common.EndpointResponse)(nil),             // 7: common.EndpointResponse
+	(*common.MailboxStatusResponse)(nil),        // 8: common.MailboxStatusResponse
+	(*common.ListEdgeAgentRoutesResponse)(nil),  // 9: common.ListEdgeAgentRoutesResponse
+	(*common.GetEdgeAgentRoutesResponse)(nil),   // 10: common.GetEdgeAgentRoutesResponse
+	(*common.RevokeEdgeAgentRouteResponse)(nil), // 11: common.RevokeEdgeAgentRouteResponse
 }
 var file_canis_didcomm_mediator_proto_depIdxs = []int32{
-	0, // 0: didcomm.Mediator.RegisterEdgeAgent:input_type -> common.RegisterEdgeAgentRequest
-	1, // 1: didcomm.Mediator.GetEndpoint:input_type -> common.EndpointRequest
-	2, // 2: didcomm.Mediator.RegisterEdgeAgent:output_type -> common.RegisterEdgeAgentResponse
-	3, // 3: didcomm.Mediator.GetEndpoint:output_type -> common.EndpointResponse
-	2, // [2:4] is the sub-list for method output_type
-	0, // [0:2] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0,  // 0: didcomm.Mediator.RegisterEdgeAgent:input_type -> common.RegisterEdgeAgentRequest
+	1,  // 1: didcomm.Mediator.GetEndpoint:input_type -> common.EndpointRequest
+	2,  // 2: didcomm.Mediator.GetMailboxStatus:input_type -> common.MailboxStatusRequest
+	3,  // 3: didcomm.Mediator.ListEdgeAgentRoutes:input_type -> common.ListEdgeAgentRoutesRequest
+	4,  // 4: didcomm.Mediator.GetEdgeAgentRoutes:input_type -> common.GetEdgeAgentRoutesRequest
+	5,  // 5: didcomm.Mediator.RevokeEdgeAgentRoute:input_type -> common.RevokeEdgeAgentRouteRequest
+	6,  // 6: didcomm.Mediator.RegisterEdgeAgent:output_type -> common.RegisterEdgeAgentResponse
+	7,  // 7: didcomm.Mediator.GetEndpoint:output_type -> common.EndpointResponse
+	8,  // 8: didcomm.Mediator.GetMailboxStatus:output_type -> common.MailboxStatusResponse
+	9,  // 9: didcomm.Mediator.ListEdgeAgentRoutes:output_type -> common.ListEdgeAgentRoutesResponse
+	10, // 10: didcomm.Mediator.GetEdgeAgentRoutes:output_type -> common.GetEdgeAgentRoutesResponse
+	11, // 11: didcomm.Mediator.RevokeEdgeAgentRoute:output_type -> common.RevokeEdgeAgentRouteResponse
+	6,  // [6:12] is the sub-list for method output_type
+	0,  // [0:6] is the sub-list for method input_type
+	0,  // [0:0] is the sub-list for extension type_name
+	0,  // [0:0] is the sub-list for extension extendee
+	0,  // [0:0] is the sub-list for field type_name
 }
 
 func init() { file_canis_didcomm_mediator_proto_init() }
@@ -107,6 +142,10 @@ const _ = grpc.SupportPackageIsVersion6
 type MediatorClient interface {
 	RegisterEdgeAgent(ctx context.Context, in *common.RegisterEdgeAgentRequest, opts ...grpc.CallOption) (*common.RegisterEdgeAgentResponse, error)
 	GetEndpoint(ctx context.Context, in *common.EndpointRequest, opts ...grpc.CallOption) (*common.EndpointResponse, error)
+	GetMailboxStatus(ctx context.Context, in *common.MailboxStatusRequest, opts ...grpc.CallOption) (*common.MailboxStatusResponse, error)
+	ListEdgeAgentRoutes(ctx context.Context, in *common.ListEdgeAgentRoutesRequest, opts ...grpc.CallOption) (*common.ListEdgeAgentRoutesResponse, error)
+	GetEdgeAgentRoutes(ctx context.Context, in *common.GetEdgeAgentRoutesRequest, opts ...grpc.CallOption) (*common.GetEdgeAgentRoutesResponse, error)
+	RevokeEdgeAgentRoute(ctx context.Context, in *common.RevokeEdgeAgentRouteRequest, opts ...grpc.CallOption) (*common.RevokeEdgeAgentRouteResponse, error)
 }
 
 type mediatorClient struct {
@@ -135,10 +174,50 @@ func (c *mediatorClient) GetEndpoint(ctx context.Context, in *common.EndpointReq
 	return out, nil
 }
 
+func (c *mediatorClient) GetMailboxStatus(ctx context.Context, in *common.MailboxStatusRequest, opts ...grpc.CallOption) (*common.MailboxStatusResponse, error) {
+	out := new(common.MailboxStatusResponse)
+	err := c.cc.Invoke(ctx, "/didcomm.Mediator/GetMailboxStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mediatorClient) ListEdgeAgentRoutes(ctx context.Context, in *common.ListEdgeAgentRoutesRequest, opts ...grpc.CallOption) (*common.ListEdgeAgentRoutesResponse, error) {
+	out := new(common.ListEdgeAgentRoutesResponse)
+	err := c.cc.Invoke(ctx, "/didcomm.Mediator/ListEdgeAgentRoutes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mediatorClient) GetEdgeAgentRoutes(ctx context.Context, in *common.GetEdgeAgentRoutesRequest, opts ...grpc.CallOption) (*common.GetEdgeAgentRoutesResponse, error) {
+	out := new(common.GetEdgeAgentRoutesResponse)
+	err := c.cc.Invoke(ctx, "/didcomm.Mediator/GetEdgeAgentRoutes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mediatorClient) RevokeEdgeAgentRoute(ctx context.Context, in *common.RevokeEdgeAgentRouteRequest, opts ...grpc.CallOption) (*common.RevokeEdgeAgentRouteResponse, error) {
+	out := new(common.RevokeEdgeAgentRouteResponse)
+	err := c.cc.Invoke(ctx, "/didcomm.Mediator/RevokeEdgeAgentRoute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MediatorServer is the server API for Mediator service.
 type MediatorServer interface {
 	RegisterEdgeAgent(context.Context, *common.RegisterEdgeAgentRequest) (*common.RegisterEdgeAgentResponse, error)
 	GetEndpoint(context.Context, *common.EndpointRequest) (*common.EndpointResponse, error)
+	GetMailboxStatus(context.Context, *common.MailboxStatusRequest) (*common.MailboxStatusResponse, error)
+	ListEdgeAgentRoutes(context.Context, *common.ListEdgeAgentRoutesRequest) (*common.ListEdgeAgentRoutesResponse, error)
+	GetEdgeAgentRoutes(context.Context, *common.GetEdgeAgentRoutesRequest) (*common.GetEdgeAgentRoutesResponse, error)
+	RevokeEdgeAgentRoute(context.Context, *common.RevokeEdgeAgentRouteRequest) (*common.RevokeEdgeAgentRouteResponse, error)
 }
 
 // UnimplementedMediatorServer can be embedded to have forward compatible implementations.
@@ -151,6 +230,18 @@ func (*UnimplementedMediatorServer) RegisterEdgeAgent(context.Context, *common.R
 func (*UnimplementedMediatorServer) GetEndpoint(context.Context, *common.EndpointRequest) (*common.EndpointResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetEndpoint not implemented")
 }
+func (*UnimplementedMediatorServer) GetMailboxStatus(context.Context, *common.MailboxStatusRequest) (*common.MailboxStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMailboxStatus not implemented")
+}
+func (*UnimplementedMediatorServer) ListEdgeAgentRoutes(context.Context, *common.ListEdgeAgentRoutesRequest) (*common.ListEdgeAgentRoutesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEdgeAgentRoutes not implemented")
+}
+func (*UnimplementedMediatorServer) GetEdgeAgentRoutes(context.Context, *common.GetEdgeAgentRoutesRequest) (*common.GetEdgeAgentRoutesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEdgeAgentRoutes not implemented")
+}
+func (*UnimplementedMediatorServer) RevokeEdgeAgentRoute(context.Context, *common.RevokeEdgeAgentRouteRequest) (*common.RevokeEdgeAgentRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeEdgeAgentRoute not implemented")
+}
 
 func RegisterMediatorServer(s *grpc.Server, srv MediatorServer) {
 	s.RegisterService(&_Mediator_serviceDesc, srv)
@@ -192,6 +283,78 @@ func _Mediator_GetEndpoint_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Mediator_GetMailboxStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(common.MailboxStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MediatorServer).GetMailboxStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/didcomm.Mediator/GetMailboxStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MediatorServer).GetMailboxStatus(ctx, req.(*common.MailboxStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mediator_ListEdgeAgentRoutes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(common.ListEdgeAgentRoutesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MediatorServer).ListEdgeAgentRoutes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/didcomm.Mediator/ListEdgeAgentRoutes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MediatorServer).ListEdgeAgentRoutes(ctx, req.(*common.ListEdgeAgentRoutesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mediator_GetEdgeAgentRoutes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(common.GetEdgeAgentRoutesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MediatorServer).GetEdgeAgentRoutes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/didcomm.Mediator/GetEdgeAgentRoutes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MediatorServer).GetEdgeAgentRoutes(ctx, req.(*common.GetEdgeAgentRoutesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mediator_RevokeEdgeAgentRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(common.RevokeEdgeAgentRouteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MediatorServer).RevokeEdgeAgentRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/didcomm.Mediator/RevokeEdgeAgentRoute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MediatorServer).RevokeEdgeAgentRoute(ctx, req.(*common.RevokeEdgeAgentRouteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Mediator_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "didcomm.Mediator",
 	HandlerType: (*MediatorServer)(nil),
@@ -204,6 +367,22 @@ var _Mediator_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetEndpoint",
 			Handler:    _Mediator_GetEndpoint_Handler,
 		},
+		{
+			MethodName: "GetMailboxStatus",
+			Handler:    _Mediator_GetMailboxStatus_Handler,
+		},
+		{
+			MethodName: "ListEdgeAgentRoutes",
+			Handler:    _Mediator_ListEdgeAgentRoutes_Handler,
+		},
+		{
+			MethodName: "GetEdgeAgentRoutes",
+			Handler:    _Mediator_GetEdgeAgentRoutes_Handler,
+		},
+		{
+			MethodName: "RevokeEdgeAgentRoute",
+			Handler:    _Mediator_RevokeEdgeAgentRoute_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "canis-didcomm-mediator.proto",
@@ -12,6 +12,7 @@ import (
 	"os"
 	"strings"
 
+	"github.com/hyperledger/aries-framework-go/pkg/didcomm/messaging/msghandler"
 	"github.com/hyperledger/aries-framework-go/pkg/didcomm/transport/ws"
 	"github.com/hyperledger/aries-framework-go/pkg/framework/aries"
 	ariescontext "github.com/hyperledger/aries-framework-go/pkg/framework/context"
@@ -23,8 +24,10 @@ import (
 
 	"github.com/scoir/canis/pkg/config"
 	"github.com/scoir/canis/pkg/datastore"
+	"github.com/scoir/canis/pkg/didcomm/mediator"
 	"github.com/scoir/canis/pkg/framework"
 	"github.com/scoir/canis/pkg/framework/context"
+	"github.com/scoir/canis/pkg/push"
 )
 
 var (
@@ -45,6 +48,9 @@ type Provider struct {
 	store                datastore.Store
 	ariesStorageProvider storage.Provider
 	conf                 config.Config
+	mailbox              *mediator.Mailbox
+	registrar            *msghandler.Registrar
+	push                 push.Notifier
 }
 
 func Execute() {
@@ -91,11 +97,23 @@ func initConfig() {
 		log.Fatalln("invalid ledgerstore key in configuration")
 	}
 
+	pc, err := conf.Push()
+	if err != nil {
+		log.Fatalln("invalid push key in configuration", err)
+	}
+
 	ls := mongodbstore.NewProvider(lc.URL, mongodbstore.WithDBPrefix("canis-mediator"))
 	ctx = &Provider{
 		store:                store,
 		ariesStorageProvider: ls,
 		conf:                 conf,
+		registrar:            msghandler.NewRegistrar(),
+		push:                 push.New(pc),
+	}
+
+	ctx.mailbox, err = mediator.NewMailbox(ctx)
+	if err != nil {
+		log.Fatalln("unable to create mediator mailbox", err)
 	}
 }
 
@@ -113,6 +131,21 @@ func (r *Provider) GetGRPCEndpoint() (*framework.Endpoint, error) {
 	return r.conf.Endpoint("mediator.grpc")
 }
 
+// GetMailboxConfig returns the retention limits of edge agent mailboxes
+func (r *Provider) GetMailboxConfig() (*framework.MailboxConfig, error) {
+	return r.conf.Mailbox()
+}
+
+// GetPushNotifier returns the notifier that wakes up edge agents when messages are queued in their mailbox
+func (r *Provider) GetPushNotifier() push.Notifier {
+	return r.push
+}
+
+// GetMessageRegistrar returns the registrar of the DIDComm message services of the mediator
+func (r *Provider) GetMessageRegistrar() *msghandler.Registrar {
+	return r.registrar
+}
+
 func (r *Provider) GetEdgeAgentSecret() string {
 	return r.conf.GetString("mediator.edgeAgentSecret")
 }
@@ -122,6 +155,11 @@ func (r *Provider) GetBridgeEndpoint() (*framework.Endpoint, error) {
 	return r.conf.Endpoint("mediator.grpcBridge")
 }
 
+// GetTLSConfig returns the mutual TLS settings for the GRPC server
+func (r *Provider) GetTLSConfig() (*framework.TLSConfig, error) {
+	return r.conf.TLS()
+}
+
 // GetExternal returns the external endpoint
 func (r *Provider) GetExternal() string {
 	return r.conf.GetString("inbound.external")
@@ -157,7 +195,8 @@ func (r *Provider) GetAriesContext() (*ariescontext.Provider, error) {
 	vopts := []aries.Option{
 		aries.WithStoreProvider(r.ariesStorageProvider),
 		aries.WithInboundTransport(inbound),
-		aries.WithOutboundTransports(ws.NewOutbound()),
+		aries.WithOutboundTransports(ws.NewOutbound(), r.mailbox),
+		aries.WithMessageServiceProvider(r.registrar),
 		aries.WithSecretLock(lock),
 	}
 	for _, vdri := range vdris {
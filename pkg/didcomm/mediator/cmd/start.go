@@ -13,6 +13,7 @@ import (
 
 	"github.com/scoir/canis/pkg/controller"
 	"github.com/scoir/canis/pkg/didcomm/mediator"
+	"github.com/scoir/canis/pkg/tracing"
 )
 
 var startCmd = &cobra.Command{
@@ -23,6 +24,8 @@ var startCmd = &cobra.Command{
 }
 
 func runStart(_ *cobra.Command, _ []string) {
+	defer tracing.Setup("canis-didcomm-mediator", ctx.conf)()
+
 	i, err := mediator.New(ctx)
 	if err != nil {
 		log.Fatalln("unable to initialize mediator", err)
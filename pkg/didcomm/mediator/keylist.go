@@ -0,0 +1,265 @@
+package mediator
+
+import (
+	"log"
+
+	"github.com/google/uuid"
+	"github.com/hyperledger/aries-framework-go/pkg/didcomm/common/service"
+	"github.com/hyperledger/aries-framework-go/pkg/storage"
+	"github.com/pkg/errors"
+
+	"github.com/scoir/canis/pkg/datastore"
+)
+
+// Message types of the Aries coordinate mediation protocol handled by the mediator
+const (
+	KeylistUpdateMsgType         = "https://didcomm.org/coordinate-mediation/1.0/keylist-update"
+	KeylistUpdateResponseMsgType = "https://didcomm.org/coordinate-mediation/1.0/keylist-update-response"
+	KeylistQueryMsgType          = "https://didcomm.org/coordinate-mediation/1.0/keylist-query"
+	KeylistMsgType               = "https://didcomm.org/coordinate-mediation/1.0/keylist"
+)
+
+// Keylist update actions and results
+const (
+	KeylistAdd    = "add"
+	KeylistRemove = "remove"
+
+	KeylistSuccess     = "success"
+	KeylistNoChange    = "no_change"
+	KeylistClientError = "client_error"
+	KeylistServerError = "server_error"
+)
+
+const defaultKeylistPageSize = 100
+
+type keylistUpdate struct {
+	Updates []*keylistUpdateItem `json:"updates"`
+}
+
+type keylistUpdateItem struct {
+	RecipientKey string `json:"recipient_key"`
+	Action       string `json:"action"`
+	Result       string `json:"result,omitempty"`
+}
+
+type keylistUpdateResponse struct {
+	ID      string               `json:"@id"`
+	Type    string               `json:"@type"`
+	Updated []*keylistUpdateItem `json:"updated"`
+}
+
+type keylistQuery struct {
+	Paginate *keylistPagination `json:"paginate,omitempty"`
+}
+
+type keylistPagination struct {
+	Limit     int `json:"limit,omitempty"`
+	Offset    int `json:"offset,omitempty"`
+	Count     int `json:"count,omitempty"`
+	Remaining int `json:"remaining,omitempty"`
+}
+
+type keylistKey struct {
+	RecipientKey string `json:"recipient_key"`
+}
+
+type keylist struct {
+	ID         string             `json:"@id"`
+	Type       string             `json:"@type"`
+	Keys       []*keylistKey      `json:"keys"`
+	Pagination *keylistPagination `json:"pagination"`
+}
+
+// routeService is the part of the aries route service that maintains the routing keys it forwards messages with
+type routeService interface {
+	AddKey(connectionID, recKey string) error
+	RemoveKey(connectionID, recKey string) error
+	GetConnectionIDByRouteKey(recKey string) (string, error)
+}
+
+// routes keeps the routing keys of edge agents in their datastore record and in the aries route service that
+// forwards messages
+type routes struct {
+	store   datastore.Store
+	service routeService
+}
+
+func (r *routes) add(ea *datastore.EdgeAgent, key string) (string, error) {
+	if hasKey(ea.RoutingKeys, key) {
+		return KeylistNoChange, nil
+	}
+
+	other, err := r.store.GetEdgeAgentForRoutingKey(key)
+	if err == nil && other.ID != ea.ID {
+		return KeylistClientError, errors.Errorf("routing key %s belongs to another edge agent", key)
+	}
+
+	connectionID, err := r.service.GetConnectionIDByRouteKey(key)
+	if err == nil && connectionID != ea.DIDCommConnectionID {
+		return KeylistClientError, errors.Errorf("routing key %s is routed to another connection", key)
+	}
+
+	err = r.service.AddKey(ea.DIDCommConnectionID, key)
+	if err != nil {
+		return KeylistServerError, errors.Wrap(err, "unable to add route")
+	}
+
+	ea.RoutingKeys = append(ea.RoutingKeys, key)
+	err = r.store.UpdateEdgeAgent(ea)
+	if err != nil {
+		return KeylistServerError, errors.Wrap(err, "unable to save routing keys")
+	}
+
+	return KeylistSuccess, nil
+}
+
+func (r *routes) remove(ea *datastore.EdgeAgent, key string) (string, error) {
+	if !hasKey(ea.RoutingKeys, key) {
+		return KeylistNoChange, nil
+	}
+
+	err := r.service.RemoveKey(ea.DIDCommConnectionID, key)
+	if err != nil && !errors.Is(err, storage.ErrDataNotFound) {
+		return KeylistServerError, errors.Wrap(err, "unable to remove route")
+	}
+
+	var keys []string
+	for _, k := range ea.RoutingKeys {
+		if k != key {
+			keys = append(keys, k)
+		}
+	}
+
+	ea.RoutingKeys = keys
+	err = r.store.UpdateEdgeAgent(ea)
+	if err != nil {
+		return KeylistServerError, errors.Wrap(err, "unable to save routing keys")
+	}
+
+	return KeylistSuccess, nil
+}
+
+func hasKey(keys []string, key string) bool {
+	for _, k := range keys {
+		if k == key {
+			return true
+		}
+	}
+
+	return false
+}
+
+// keylistService lets edge agents update and query the keys the mediator routes to them
+type keylistService struct {
+	store     datastore.Store
+	routes    *routes
+	messenger service.Messenger
+}
+
+func (r *keylistService) Name() string {
+	return "coordinate-mediation"
+}
+
+func (r *keylistService) Accept(msgType string, _ []string) bool {
+	return msgType == KeylistUpdateMsgType || msgType == KeylistQueryMsgType
+}
+
+func (r *keylistService) HandleInbound(msg service.DIDCommMsg, _, theirDID string) (string, error) {
+	ea, err := r.store.GetEdgeAgentForDID(theirDID)
+	if err != nil {
+		return "", errors.Errorf("keylist request from unregistered DID %s", theirDID)
+	}
+
+	var reply interface{}
+	switch msg.Type() {
+	case KeylistUpdateMsgType:
+		req := &keylistUpdate{}
+		err = msg.Decode(req)
+		if err != nil {
+			return "", errors.Wrap(err, "invalid keylist update")
+		}
+		reply = r.update(ea, req)
+	case KeylistQueryMsgType:
+		req := &keylistQuery{}
+		err = msg.Decode(req)
+		if err != nil {
+			return "", errors.Wrap(err, "invalid keylist query")
+		}
+		reply = query(ea, req)
+	default:
+		return "", errors.Errorf("unexpected message type %s", msg.Type())
+	}
+
+	err = r.messenger.ReplyTo(msg.ID(), service.NewDIDCommMsgMap(reply))
+	if err != nil {
+		return "", errors.Wrap(err, "unable to reply to keylist request")
+	}
+
+	return "", nil
+}
+
+func (r *keylistService) update(ea *datastore.EdgeAgent, req *keylistUpdate) *keylistUpdateResponse {
+	out := &keylistUpdateResponse{
+		ID:   uuid.New().String(),
+		Type: KeylistUpdateResponseMsgType,
+	}
+
+	for _, u := range req.Updates {
+		var err error
+		result := KeylistClientError
+		switch u.Action {
+		case KeylistAdd:
+			result, err = r.routes.add(ea, u.RecipientKey)
+		case KeylistRemove:
+			result, err = r.routes.remove(ea, u.RecipientKey)
+		}
+
+		if err != nil {
+			log.Printf("keylist %s of %s for edge agent %s failed: %v\n", u.Action, u.RecipientKey, ea.ID, err)
+		}
+
+		out.Updated = append(out.Updated, &keylistUpdateItem{
+			RecipientKey: u.RecipientKey,
+			Action:       u.Action,
+			Result:       result,
+		})
+	}
+
+	return out
+}
+
+func query(ea *datastore.EdgeAgent, req *keylistQuery) *keylist {
+	limit, offset := defaultKeylistPageSize, 0
+	if req.Paginate != nil {
+		if req.Paginate.Limit > 0 {
+			limit = req.Paginate.Limit
+		}
+		offset = req.Paginate.Offset
+	}
+
+	if offset > len(ea.RoutingKeys) {
+		offset = len(ea.RoutingKeys)
+	}
+
+	end := offset + limit
+	if end > len(ea.RoutingKeys) {
+		end = len(ea.RoutingKeys)
+	}
+
+	out := &keylist{
+		ID:   uuid.New().String(),
+		Type: KeylistMsgType,
+		Keys: []*keylistKey{},
+		Pagination: &keylistPagination{
+			Count:     end - offset,
+			Offset:    offset,
+			Remaining: len(ea.RoutingKeys) - end,
+		},
+	}
+
+	for _, key := range ea.RoutingKeys[offset:end] {
+		out.Keys = append(out.Keys, &keylistKey{RecipientKey: key})
+	}
+
+	return out
+}
@@ -0,0 +1,99 @@
+package mediator
+
+import (
+	"testing"
+
+	"github.com/hyperledger/aries-framework-go/pkg/didcomm/common/service"
+	"github.com/hyperledger/aries-framework-go/pkg/storage"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scoir/canis/pkg/datastore"
+	"github.com/scoir/canis/pkg/datastore/mocks"
+)
+
+type testRouteService struct {
+	routes map[string]string
+}
+
+func (r *testRouteService) AddKey(connectionID, recKey string) error {
+	r.routes[recKey] = connectionID
+	return nil
+}
+
+func (r *testRouteService) RemoveKey(_, recKey string) error {
+	if _, ok := r.routes[recKey]; !ok {
+		return storage.ErrDataNotFound
+	}
+
+	delete(r.routes, recKey)
+	return nil
+}
+
+func (r *testRouteService) GetConnectionIDByRouteKey(recKey string) (string, error) {
+	connectionID, ok := r.routes[recKey]
+	if !ok {
+		return "", storage.ErrDataNotFound
+	}
+
+	return connectionID, nil
+}
+
+func TestKeylistUpdate(t *testing.T) {
+	store := &mocks.Store{}
+	routeSvc := &testRouteService{routes: map[string]string{"key-foreign": "conn-2"}}
+	messenger := &testMessenger{}
+	target := &keylistService{store: store, routes: &routes{store: store, service: routeSvc}, messenger: messenger}
+
+	ea := &datastore.EdgeAgent{ID: "edge-1", TheirDID: "did:peer:edge", DIDCommConnectionID: "conn-1", RoutingKeys: []string{"key-old"}}
+	routeSvc.routes["key-old"] = "conn-1"
+
+	store.On("GetEdgeAgentForDID", "did:peer:edge").Return(ea, nil)
+	store.On("GetEdgeAgentForRoutingKey", "key-new").Return(nil, errors.New("not found"))
+	store.On("GetEdgeAgentForRoutingKey", "key-taken").Return(&datastore.EdgeAgent{ID: "edge-2"}, nil)
+	store.On("GetEdgeAgentForRoutingKey", "key-foreign").Return(nil, errors.New("not found"))
+	store.On("UpdateEdgeAgent", mock.AnythingOfType("*datastore.EdgeAgent")).Return(nil)
+
+	msg := service.NewDIDCommMsgMap(map[string]interface{}{
+		"@id":   "req-1",
+		"@type": KeylistUpdateMsgType,
+		"updates": []map[string]string{
+			{"recipient_key": "key-new", "action": KeylistAdd},
+			{"recipient_key": "key-taken", "action": KeylistAdd},
+			{"recipient_key": "key-foreign", "action": KeylistAdd},
+			{"recipient_key": "key-old", "action": KeylistRemove},
+			{"recipient_key": "key-unknown", "action": KeylistRemove},
+			{"recipient_key": "key-new", "action": "replace"},
+		},
+	})
+
+	_, err := target.HandleInbound(msg, "did:peer:mediator", "did:peer:edge")
+	require.NoError(t, err)
+
+	resp := &keylistUpdateResponse{}
+	require.NoError(t, messenger.replies[0].Decode(resp))
+	require.Equal(t, KeylistUpdateResponseMsgType, resp.Type)
+
+	var results []string
+	for _, u := range resp.Updated {
+		results = append(results, u.Result)
+	}
+	require.Equal(t, []string{KeylistSuccess, KeylistClientError, KeylistClientError, KeylistSuccess, KeylistNoChange, KeylistClientError}, results)
+
+	require.Equal(t, []string{"key-new"}, ea.RoutingKeys)
+	require.Equal(t, map[string]string{"key-new": "conn-1", "key-foreign": "conn-2"}, routeSvc.routes)
+}
+
+func TestKeylistQuery(t *testing.T) {
+	ea := &datastore.EdgeAgent{RoutingKeys: []string{"key-1", "key-2", "key-3"}}
+
+	out := query(ea, &keylistQuery{Paginate: &keylistPagination{Limit: 2, Offset: 1}})
+	require.Len(t, out.Keys, 2)
+	require.Equal(t, "key-2", out.Keys[0].RecipientKey)
+	require.Equal(t, 0, out.Pagination.Remaining)
+
+	out = query(ea, &keylistQuery{Paginate: &keylistPagination{Offset: 10}})
+	require.Empty(t, out.Keys)
+	require.Equal(t, 0, out.Pagination.Count)
+}
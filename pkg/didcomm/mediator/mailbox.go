@@ -0,0 +1,197 @@
+package mediator
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hyperledger/aries-framework-go/pkg/didcomm/common/service"
+	"github.com/hyperledger/aries-framework-go/pkg/didcomm/transport"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/scoir/canis/pkg/datastore"
+	"github.com/scoir/canis/pkg/framework"
+	"github.com/scoir/canis/pkg/protogen/common"
+	"github.com/scoir/canis/pkg/push"
+)
+
+// QueueEndpoint is the service endpoint of edge agents that can not accept inbound connections.  Messages
+// forwarded to them are held in their mailbox until picked up
+const QueueEndpoint = "didcomm:transport/queue"
+
+const (
+	defaultMailboxMaxMessages    = 1000
+	defaultMailboxMaxAge         = 7 * 24 * time.Hour
+	defaultMailboxMaxMessageSize = 1 << 20
+)
+
+// Mailbox is an outbound transport that queues forwarded messages for edge agents that are offline
+type Mailbox struct {
+	store          datastore.Store
+	maxMessages    int
+	maxAge         time.Duration
+	maxMessageSize int
+	push           push.Notifier
+}
+
+type mailboxProvider interface {
+	GetDatastore() (datastore.Store, error)
+	GetMailboxConfig() (*framework.MailboxConfig, error)
+	GetPushNotifier() push.Notifier
+}
+
+func NewMailbox(ctx mailboxProvider) (*Mailbox, error) {
+	store, err := ctx.GetDatastore()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get datastore for mailbox")
+	}
+
+	r := &Mailbox{
+		store:          store,
+		maxMessages:    defaultMailboxMaxMessages,
+		maxAge:         defaultMailboxMaxAge,
+		maxMessageSize: defaultMailboxMaxMessageSize,
+		push:           ctx.GetPushNotifier(),
+	}
+
+	mc, err := ctx.GetMailboxConfig()
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid mailbox configuration")
+	}
+
+	if mc != nil {
+		if mc.MaxMessages != 0 {
+			r.maxMessages = mc.MaxMessages
+		}
+		if mc.MaxAge != 0 {
+			r.maxAge = mc.MaxAge
+		}
+		if mc.MaxMessageSize != 0 {
+			r.maxMessageSize = mc.MaxMessageSize
+		}
+	}
+
+	return r, nil
+}
+
+// Start is a no-op, the mailbox does not connect to anything
+func (r *Mailbox) Start(_ transport.Provider) error {
+	return nil
+}
+
+// Accept returns true for edge agents that pick up their messages
+func (r *Mailbox) Accept(url string) bool {
+	return url == QueueEndpoint
+}
+
+// AcceptRecipient is always false so messages are delivered directly to edge agents that are connected
+func (r *Mailbox) AcceptRecipient(_ []string) bool {
+	return false
+}
+
+// Send queues a packed message for the edge agent of the destination and enforces the retention limits
+func (r *Mailbox) Send(data []byte, destination *service.Destination) (string, error) {
+	if r.maxMessageSize > 0 && len(data) > r.maxMessageSize {
+		return "", errors.Errorf("message of %d bytes exceeds mailbox limit of %d", len(data), r.maxMessageSize)
+	}
+
+	ea, err := r.edgeAgent(destination.RecipientKeys)
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now().UTC()
+	msg := &datastore.MailboxMessage{
+		ID:          uuid.New().String(),
+		EdgeAgentID: ea.ID,
+		Message:     data,
+		Received:    now,
+	}
+
+	err = r.store.InsertMailboxMessage(msg)
+	if err != nil {
+		return "", errors.Wrapf(err, "unable to queue message for edge agent %s", ea.ID)
+	}
+
+	var cutoff time.Time
+	if r.maxAge > 0 {
+		cutoff = now.Add(-r.maxAge)
+	}
+
+	pruned, err := r.store.PruneMailbox(ea.ID, cutoff, r.maxMessages)
+	if err != nil {
+		log.Println("unable to prune mailbox of edge agent", ea.ID, err)
+	} else if pruned > 0 {
+		log.Printf("dropped %d messages from mailbox of edge agent %s\n", pruned, ea.ID)
+	}
+
+	if ea.PushToken != "" {
+		go r.wake(ea)
+	}
+
+	return "", nil
+}
+
+// wake tells the device of an edge agent it has messages to pick up, the notification carries no content
+func (r *Mailbox) wake(ea *datastore.EdgeAgent) {
+	err := r.push.Wake(ea.PushToken)
+	if err != nil {
+		log.Println("unable to wake edge agent", ea.ID, err)
+	}
+}
+
+func (r *Mailbox) edgeAgent(keys []string) (*datastore.EdgeAgent, error) {
+	for _, key := range keys {
+		ea, err := r.store.GetEdgeAgentForKey(key)
+		if err == nil {
+			return ea, nil
+		}
+	}
+
+	return nil, errors.New("no edge agent registered for message recipient")
+}
+
+// GetMailboxStatus returns the depth of the mailbox of one or all edge agents
+func (r *Mediator) GetMailboxStatus(_ context.Context, req *common.MailboxStatusRequest) (*common.MailboxStatusResponse, error) {
+	eas, err := r.store.ListEdgeAgents()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to list edge agents: (%v)", err)
+	}
+
+	out := &common.MailboxStatusResponse{}
+	for _, ea := range eas {
+		if ea.ID == "" || (req.EdgeAgentId != "" && ea.ID != req.EdgeAgentId) {
+			continue
+		}
+
+		count, err := r.store.CountMailboxMessages(ea.ID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "unable to count messages for edge agent %s: (%v)", ea.ID, err)
+		}
+
+		mb := &common.MailboxStatus{
+			EdgeAgentId: ea.ID,
+			ExternalId:  ea.ExternalID,
+			Queued:      int64(count),
+		}
+
+		if count > 0 {
+			oldest, err := r.store.ListMailboxMessages(ea.ID, 1)
+			if err == nil && len(oldest) == 1 {
+				mb.Oldest = timestamppb.New(oldest[0].Received)
+			}
+		}
+
+		out.Mailboxes = append(out.Mailboxes, mb)
+	}
+
+	if req.EdgeAgentId != "" && len(out.Mailboxes) == 0 {
+		return nil, status.Errorf(codes.NotFound, "edge agent %s not found", req.EdgeAgentId)
+	}
+
+	return out, nil
+}
@@ -0,0 +1,62 @@
+package mediator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/scoir/canis/pkg/datastore"
+	"github.com/scoir/canis/pkg/datastore/mocks"
+	"github.com/scoir/canis/pkg/framework"
+	"github.com/scoir/canis/pkg/push"
+)
+
+type testMailboxProvider struct {
+	store datastore.Store
+	conf  *framework.MailboxConfig
+}
+
+func (r *testMailboxProvider) GetDatastore() (datastore.Store, error) {
+	return r.store, nil
+}
+
+func (r *testMailboxProvider) GetMailboxConfig() (*framework.MailboxConfig, error) {
+	return r.conf, nil
+}
+
+func (r *testMailboxProvider) GetPushNotifier() push.Notifier {
+	return push.Nop{}
+}
+
+func TestNewMailbox(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		mb, err := NewMailbox(&testMailboxProvider{store: &mocks.Store{}})
+		require.NoError(t, err)
+		require.Equal(t, defaultMailboxMaxMessages, mb.maxMessages)
+		require.Equal(t, defaultMailboxMaxAge, mb.maxAge)
+		require.Equal(t, defaultMailboxMaxMessageSize, mb.maxMessageSize)
+	})
+
+	t.Run("partial config keeps defaults", func(t *testing.T) {
+		mb, err := NewMailbox(&testMailboxProvider{
+			store: &mocks.Store{},
+			conf:  &framework.MailboxConfig{MaxMessages: 10},
+		})
+		require.NoError(t, err)
+		require.Equal(t, 10, mb.maxMessages)
+		require.Equal(t, defaultMailboxMaxAge, mb.maxAge)
+		require.Equal(t, defaultMailboxMaxMessageSize, mb.maxMessageSize)
+	})
+
+	t.Run("full config", func(t *testing.T) {
+		mb, err := NewMailbox(&testMailboxProvider{
+			store: &mocks.Store{},
+			conf:  &framework.MailboxConfig{MaxMessages: 10, MaxAge: time.Hour, MaxMessageSize: 512},
+		})
+		require.NoError(t, err)
+		require.Equal(t, 10, mb.maxMessages)
+		require.Equal(t, time.Hour, mb.maxAge)
+		require.Equal(t, 512, mb.maxMessageSize)
+	})
+}
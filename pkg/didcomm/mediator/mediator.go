@@ -11,6 +11,7 @@ import (
 	ariesdidex "github.com/hyperledger/aries-framework-go/pkg/client/didexchange"
 	ariesmediator "github.com/hyperledger/aries-framework-go/pkg/client/mediator"
 	"github.com/hyperledger/aries-framework-go/pkg/didcomm/common/service"
+	"github.com/hyperledger/aries-framework-go/pkg/didcomm/messaging/msghandler"
 	"github.com/hyperledger/aries-framework-go/pkg/didcomm/protocol/mediator"
 	vdriapi "github.com/hyperledger/aries-framework-go/pkg/framework/aries/api/vdri"
 	ariescontext "github.com/hyperledger/aries-framework-go/pkg/framework/context"
@@ -22,6 +23,7 @@ import (
 	"github.com/scoir/canis/pkg/datastore"
 	api "github.com/scoir/canis/pkg/didcomm/mediator/api/protogen"
 	"github.com/scoir/canis/pkg/didexchange"
+	"github.com/scoir/canis/pkg/enrollment"
 	"github.com/scoir/canis/pkg/framework"
 	"github.com/scoir/canis/pkg/protogen/common"
 )
@@ -34,6 +36,7 @@ type Mediator struct {
 	bouncer            didexchange.Bouncer
 	mediatorActionChan <-chan service.DIDCommAction
 	client             *ariesmediator.Client
+	routes             *routes
 	edgeAgentSecret    string
 }
 
@@ -43,6 +46,7 @@ type provider interface {
 	GetDatastore() (datastore.Store, error)
 	GetEdgeAgentSecret() string
 	GetExternal() string
+	GetMessageRegistrar() *msghandler.Registrar
 }
 
 func New(ctx provider) (*Mediator, error) {
@@ -90,6 +94,26 @@ func New(ctx provider) (*Mediator, error) {
 
 	m.vdriReg = ap.VDRIRegistry()
 
+	svc, err := ap.Service(mediator.Coordination)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get aries route service")
+	}
+
+	routeSvc, ok := svc.(routeService)
+	if !ok {
+		return nil, errors.New("aries route service can not maintain routing keys")
+	}
+
+	m.routes = &routes{store: m.store, service: routeSvc}
+
+	err = ctx.GetMessageRegistrar().Register(
+		&pickup{store: m.store, messenger: ap.Messenger()},
+		&keylistService{store: m.store, routes: m.routes, messenger: ap.Messenger()},
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to register mediator message services")
+	}
+
 	return m, nil
 }
 
@@ -108,8 +132,10 @@ func (r *Mediator) APISpec() (http.HandlerFunc, error) {
 
 func (r *Mediator) RegisterEdgeAgent(_ context.Context, request *common.RegisterEdgeAgentRequest) (*common.RegisterEdgeAgentResponse, error) {
 
-	if request.Secret != r.edgeAgentSecret {
-		return nil, status.Error(codes.Unauthenticated, fmt.Sprintf("invalid edge agent secret"))
+	err := enrollment.Authorize(r.store, enrollment.EdgeAgent, request.ExternalId, request.EnrollmentToken,
+		request.Secret, r.edgeAgentSecret)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
 	}
 
 	did, err := r.store.GetMediatorDID()
@@ -122,7 +148,7 @@ func (r *Mediator) RegisterEdgeAgent(_ context.Context, request *common.Register
 		return nil, status.Error(codes.AlreadyExists, "error creating invitation with public DID for mediator")
 	}
 
-	id, err := r.store.RegisterEdgeAgent(invite.ID, request.ExternalId)
+	id, err := r.store.RegisterEdgeAgent(invite.ID, request.ExternalId, request.PushToken)
 	if err != nil {
 		return nil, status.Error(codes.Internal, fmt.Sprintf("unable to register edge agent with ID %s", request.ExternalId))
 
@@ -151,15 +177,17 @@ func (r *Mediator) Start() {
 			continue
 		}
 
-		_, err := r.store.GetEdgeAgentForDID(theirDID)
+		ea, err := r.store.GetEdgeAgentForDID(theirDID)
 		if err != nil {
 			log.Println("attempt to register for routing from unregistered DID", theirDID)
 			evt.Stop(errors.New("unauthrozied registration attempts"))
 			continue
 		}
 
-		//TODO: do we need to set ServiceEndpoint and Keys here?
-		evt.Continue(mediator.Options{})
+		evt.Continue(mediator.Options{
+			ServiceEndpoint: r.external,
+			RoutingKeys:     r.recipientKeys(ea.MyDID),
+		})
 	}
 }
 
@@ -179,6 +207,8 @@ func (r *Mediator) accepted(externalID string) func(id string, conn *ariesdidex.
 
 		ea.TheirDID = conn.TheirDID
 		ea.MyDID = conn.MyDID
+		ea.DIDCommConnectionID = conn.ConnectionID
+		ea.RecipientKeys = r.recipientKeys(conn.TheirDID)
 
 		err = r.store.UpdateEdgeAgent(ea)
 		if err != nil {
@@ -190,6 +220,23 @@ func (r *Mediator) accepted(externalID string) func(id string, conn *ariesdidex.
 	}
 }
 
+// recipientKeys returns the keys messages to a DID are encrypted for.  For an edge agent they find the mailbox
+// of messages forwarded to it, for the mediator they are the routing keys edge agents advertise
+func (r *Mediator) recipientKeys(didID string) []string {
+	doc, err := r.vdriReg.Resolve(didID)
+	if err != nil {
+		log.Println("unable to resolve DID", didID, err)
+		return nil
+	}
+
+	var keys []string
+	for _, svc := range doc.Service {
+		keys = append(keys, svc.RecipientKeys...)
+	}
+
+	return keys
+}
+
 func failed(id string, err error) {
 	log.Println("Connection to", id, "failed with error:", err)
 }
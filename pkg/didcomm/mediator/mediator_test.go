@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/hyperledger/aries-framework-go/pkg/client/didexchange"
+	"github.com/hyperledger/aries-framework-go/pkg/didcomm/messaging/msghandler"
 	"github.com/hyperledger/aries-framework-go/pkg/framework/aries"
 	"github.com/hyperledger/aries-framework-go/pkg/storage/mem"
 	"github.com/hyperledger/aries-framework-go/pkg/store/connection"
@@ -37,6 +38,7 @@ func SetupTest(t *testing.T) (*Mediator, *MediatorTestSuite) {
 	prov.On("GetEdgeAgentSecret").Return("secret words")
 	prov.On("GetDatastore").Return(suite.Store, nil)
 	prov.On("GetAriesContext").Return(ar.Context())
+	prov.On("GetMessageRegistrar").Return(msghandler.NewRegistrar())
 
 	target, err := New(prov)
 	require.NoError(t, err)
@@ -57,6 +59,7 @@ func TestNew(t *testing.T) {
 		prov.On("GetEdgeAgentSecret").Return("test-secret")
 		prov.On("GetDatastore").Return(&mocks.Store{}, nil)
 		prov.On("GetAriesContext").Return(ar.Context())
+		prov.On("GetMessageRegistrar").Return(msghandler.NewRegistrar())
 
 		target, err := New(prov)
 		require.NoError(t, err)
@@ -147,7 +150,7 @@ func TestRegisterEdgeAgent(t *testing.T) {
 		}
 
 		suite.Store.On("GetMediatorDID").Return(mediatorDID, nil)
-		suite.Store.On("RegisterEdgeAgent", mock.MatchedBy(connIDMatch), "ext-test").Return("", errors.New("boom"))
+		suite.Store.On("RegisterEdgeAgent", mock.MatchedBy(connIDMatch), "ext-test", "").Return("", errors.New("boom"))
 
 		req := &common.RegisterEdgeAgentRequest{Secret: "secret words", ExternalId: "ext-test"}
 
@@ -181,9 +184,9 @@ func TestRegisterEdgeAgent(t *testing.T) {
 		}
 
 		suite.Store.On("GetMediatorDID").Return(mediatorDID, nil)
-		suite.Store.On("RegisterEdgeAgent", mock.MatchedBy(connIDMatch), "ext-test").Return("success-id", nil)
+		suite.Store.On("RegisterEdgeAgent", mock.MatchedBy(connIDMatch), "ext-test", "push-token").Return("success-id", nil)
 
-		req := &common.RegisterEdgeAgentRequest{Secret: "secret words", ExternalId: "ext-test"}
+		req := &common.RegisterEdgeAgentRequest{Secret: "secret words", ExternalId: "ext-test", PushToken: "push-token"}
 
 		resp, err := target.RegisterEdgeAgent(context.Background(), req)
 		require.NoError(t, err)
@@ -223,9 +226,10 @@ func TestAccept(t *testing.T) {
 			ExternalID: "test-external",
 		}
 		updated := &datastore.EdgeAgent{
-			TheirDID:   "did:peer:xyz",
-			MyDID:      "did:sov:abc",
-			ExternalID: "test-external",
+			TheirDID:            "did:peer:xyz",
+			MyDID:               "did:sov:abc",
+			DIDCommConnectionID: "conn-id",
+			ExternalID:          "test-external",
 		}
 		suite.Store.On("GetEdgeAgent", "conn-id").Return(ea, nil)
 		suite.Store.On("UpdateEdgeAgent", updated).Return(nil)
@@ -296,9 +300,10 @@ func TestAccept(t *testing.T) {
 			ExternalID: "test-external",
 		}
 		updated := &datastore.EdgeAgent{
-			TheirDID:   "did:peer:xyz",
-			MyDID:      "did:sov:abc",
-			ExternalID: "test-external",
+			TheirDID:            "did:peer:xyz",
+			MyDID:               "did:sov:abc",
+			DIDCommConnectionID: "conn-id",
+			ExternalID:          "test-external",
 		}
 		suite.Store.On("GetEdgeAgent", "conn-id").Return(ea, nil)
 		suite.Store.On("UpdateEdgeAgent", updated).Return(errors.New("boom"))
@@ -7,6 +7,8 @@ import (
 	datastore "github.com/scoir/canis/pkg/datastore"
 
 	mock "github.com/stretchr/testify/mock"
+
+	msghandler "github.com/hyperledger/aries-framework-go/pkg/didcomm/messaging/msghandler"
 )
 
 // Provider is an autogenerated mock type for the provider type
@@ -87,3 +89,19 @@ func (_m *Provider) GetExternal() string {
 
 	return r0
 }
+
+// GetMessageRegistrar provides a mock function with given fields:
+func (_m *Provider) GetMessageRegistrar() *msghandler.Registrar {
+	ret := _m.Called()
+
+	var r0 *msghandler.Registrar
+	if rf, ok := ret.Get(0).(func() *msghandler.Registrar); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*msghandler.Registrar)
+		}
+	}
+
+	return r0
+}
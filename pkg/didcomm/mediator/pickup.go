@@ -0,0 +1,171 @@
+package mediator
+
+import (
+	"encoding/base64"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hyperledger/aries-framework-go/pkg/didcomm/common/service"
+	"github.com/hyperledger/aries-framework-go/pkg/didcomm/protocol/decorator"
+	"github.com/pkg/errors"
+
+	"github.com/scoir/canis/pkg/datastore"
+)
+
+// Message types of the Aries pickup protocol
+const (
+	PickupStatusRequestMsgType    = "https://didcomm.org/messagepickup/2.0/status-request"
+	PickupStatusMsgType           = "https://didcomm.org/messagepickup/2.0/status"
+	PickupDeliveryRequestMsgType  = "https://didcomm.org/messagepickup/2.0/delivery-request"
+	PickupDeliveryMsgType         = "https://didcomm.org/messagepickup/2.0/delivery"
+	PickupMessagesReceivedMsgType = "https://didcomm.org/messagepickup/2.0/messages-received"
+)
+
+const (
+	defaultBatchSize = 10
+	maxBatchSize     = 100
+)
+
+type pickupStatus struct {
+	ID                   string `json:"@id"`
+	Type                 string `json:"@type"`
+	MessageCount         int    `json:"message_count"`
+	LongestWaitedSeconds int64  `json:"longest_waited_seconds,omitempty"`
+}
+
+type pickupDeliveryRequest struct {
+	Limit int `json:"limit"`
+}
+
+type pickupDelivery struct {
+	ID          string                 `json:"@id"`
+	Type        string                 `json:"@type"`
+	Attachments []decorator.Attachment `json:"~attach"`
+}
+
+type pickupMessagesReceived struct {
+	MessageIDList []string `json:"message_id_list"`
+}
+
+// pickup lets edge agents collect the messages queued in their mailbox and acknowledge them once processed
+type pickup struct {
+	store     datastore.Store
+	messenger service.Messenger
+}
+
+func (r *pickup) Name() string {
+	return "messagepickup"
+}
+
+func (r *pickup) Accept(msgType string, _ []string) bool {
+	switch msgType {
+	case PickupStatusRequestMsgType, PickupDeliveryRequestMsgType, PickupMessagesReceivedMsgType:
+		return true
+	}
+
+	return false
+}
+
+func (r *pickup) HandleInbound(msg service.DIDCommMsg, _, theirDID string) (string, error) {
+	ea, err := r.store.GetEdgeAgentForDID(theirDID)
+	if err != nil {
+		return "", errors.Errorf("pickup request from unregistered DID %s", theirDID)
+	}
+
+	var reply interface{}
+	switch msg.Type() {
+	case PickupStatusRequestMsgType:
+		reply, err = r.status(ea)
+	case PickupDeliveryRequestMsgType:
+		req := &pickupDeliveryRequest{}
+		err = msg.Decode(req)
+		if err != nil {
+			return "", errors.Wrap(err, "invalid delivery request")
+		}
+		reply, err = r.delivery(ea, req.Limit)
+	case PickupMessagesReceivedMsgType:
+		req := &pickupMessagesReceived{}
+		err = msg.Decode(req)
+		if err != nil {
+			return "", errors.Wrap(err, "invalid messages received")
+		}
+
+		_, err = r.store.DeleteMailboxMessages(ea.ID, req.MessageIDList)
+		if err != nil {
+			return "", errors.Wrapf(err, "unable to remove picked up messages for edge agent %s", ea.ID)
+		}
+		reply, err = r.status(ea)
+	default:
+		return "", errors.Errorf("unexpected message type %s", msg.Type())
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	err = r.messenger.ReplyTo(msg.ID(), service.NewDIDCommMsgMap(reply))
+	if err != nil {
+		return "", errors.Wrap(err, "unable to reply to pickup request")
+	}
+
+	return "", nil
+}
+
+func (r *pickup) status(ea *datastore.EdgeAgent) (*pickupStatus, error) {
+	count, err := r.store.CountMailboxMessages(ea.ID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to count messages for edge agent %s", ea.ID)
+	}
+
+	out := &pickupStatus{
+		ID:           uuid.New().String(),
+		Type:         PickupStatusMsgType,
+		MessageCount: count,
+	}
+
+	if count > 0 {
+		oldest, err := r.store.ListMailboxMessages(ea.ID, 1)
+		if err == nil && len(oldest) == 1 {
+			out.LongestWaitedSeconds = int64(time.Since(oldest[0].Received).Seconds())
+		}
+	}
+
+	return out, nil
+}
+
+// delivery returns a batch of the oldest queued messages, they stay queued until acknowledged.  An empty
+// mailbox is answered with a status
+func (r *pickup) delivery(ea *datastore.EdgeAgent, limit int) (interface{}, error) {
+	if limit <= 0 {
+		limit = defaultBatchSize
+	} else if limit > maxBatchSize {
+		limit = maxBatchSize
+	}
+
+	msgs, err := r.store.ListMailboxMessages(ea.ID, limit)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to load messages for edge agent %s", ea.ID)
+	}
+
+	if len(msgs) == 0 {
+		return r.status(ea)
+	}
+
+	out := &pickupDelivery{
+		ID:          uuid.New().String(),
+		Type:        PickupDeliveryMsgType,
+		Attachments: make([]decorator.Attachment, len(msgs)),
+	}
+
+	for i, m := range msgs {
+		out.Attachments[i] = decorator.Attachment{
+			ID:       m.ID,
+			MimeType: "application/didcomm-envelope-enc",
+			Data: decorator.AttachmentData{
+				Base64: base64.StdEncoding.EncodeToString(m.Message),
+			},
+		}
+	}
+
+	return out, nil
+}
@@ -0,0 +1,98 @@
+package mediator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hyperledger/aries-framework-go/pkg/didcomm/common/service"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scoir/canis/pkg/datastore"
+	"github.com/scoir/canis/pkg/datastore/mocks"
+)
+
+type testMessenger struct {
+	service.Messenger
+	replies []service.DIDCommMsgMap
+}
+
+func (r *testMessenger) ReplyTo(_ string, msg service.DIDCommMsgMap) error {
+	r.replies = append(r.replies, msg)
+	return nil
+}
+
+func TestPickup(t *testing.T) {
+	ea := &datastore.EdgeAgent{ID: "edge-1", TheirDID: "did:peer:edge"}
+	queued := []*datastore.MailboxMessage{
+		{ID: "msg-1", EdgeAgentID: "edge-1", Message: []byte(`{"protected":"..."}`), Received: time.Now().Add(-time.Minute)},
+	}
+
+	setup := func() (*pickup, *mocks.Store, *testMessenger) {
+		store := &mocks.Store{}
+		store.On("GetEdgeAgentForDID", "did:peer:edge").Return(ea, nil)
+		messenger := &testMessenger{}
+		return &pickup{store: store, messenger: messenger}, store, messenger
+	}
+
+	t.Run("status", func(t *testing.T) {
+		target, store, messenger := setup()
+		store.On("CountMailboxMessages", "edge-1").Return(1, nil)
+		store.On("ListMailboxMessages", "edge-1", 1).Return(queued, nil)
+
+		msg := service.NewDIDCommMsgMap(map[string]interface{}{"@id": "req-1", "@type": PickupStatusRequestMsgType})
+		_, err := target.HandleInbound(msg, "did:peer:mediator", "did:peer:edge")
+		require.NoError(t, err)
+
+		require.Len(t, messenger.replies, 1)
+		status := &pickupStatus{}
+		require.NoError(t, messenger.replies[0].Decode(status))
+		require.Equal(t, PickupStatusMsgType, status.Type)
+		require.Equal(t, 1, status.MessageCount)
+		require.GreaterOrEqual(t, status.LongestWaitedSeconds, int64(59))
+	})
+
+	t.Run("delivery", func(t *testing.T) {
+		target, store, messenger := setup()
+		store.On("ListMailboxMessages", "edge-1", maxBatchSize).Return(queued, nil)
+
+		msg := service.NewDIDCommMsgMap(map[string]interface{}{
+			"@id": "req-1", "@type": PickupDeliveryRequestMsgType, "limit": 1000,
+		})
+		_, err := target.HandleInbound(msg, "did:peer:mediator", "did:peer:edge")
+		require.NoError(t, err)
+
+		delivery := &pickupDelivery{}
+		require.NoError(t, messenger.replies[0].Decode(delivery))
+		require.Equal(t, PickupDeliveryMsgType, delivery.Type)
+		require.Len(t, delivery.Attachments, 1)
+		require.Equal(t, "msg-1", delivery.Attachments[0].ID)
+	})
+
+	t.Run("messages received", func(t *testing.T) {
+		target, store, messenger := setup()
+		store.On("DeleteMailboxMessages", "edge-1", []string{"msg-1"}).Return(1, nil)
+		store.On("CountMailboxMessages", "edge-1").Return(0, nil)
+
+		msg := service.NewDIDCommMsgMap(map[string]interface{}{
+			"@id": "req-1", "@type": PickupMessagesReceivedMsgType, "message_id_list": []string{"msg-1"},
+		})
+		_, err := target.HandleInbound(msg, "did:peer:mediator", "did:peer:edge")
+		require.NoError(t, err)
+
+		status := &pickupStatus{}
+		require.NoError(t, messenger.replies[0].Decode(status))
+		require.Equal(t, 0, status.MessageCount)
+		store.AssertExpectations(t)
+	})
+
+	t.Run("unregistered", func(t *testing.T) {
+		store := &mocks.Store{}
+		store.On("GetEdgeAgentForDID", "did:peer:other").Return(nil, errors.New("not found"))
+		target := &pickup{store: store, messenger: &testMessenger{}}
+
+		msg := service.NewDIDCommMsgMap(map[string]interface{}{"@id": "req-1", "@type": PickupStatusRequestMsgType})
+		_, err := target.HandleInbound(msg, "did:peer:mediator", "did:peer:other")
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,92 @@
+package mediator
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/scoir/canis/pkg/datastore"
+	"github.com/scoir/canis/pkg/protogen/common"
+)
+
+// ListEdgeAgentRoutes returns the routing keys of every registered edge agent
+func (r *Mediator) ListEdgeAgentRoutes(_ context.Context, _ *common.ListEdgeAgentRoutesRequest) (*common.ListEdgeAgentRoutesResponse, error) {
+	eas, err := r.store.ListEdgeAgents()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to list edge agents: (%v)", err)
+	}
+
+	out := &common.ListEdgeAgentRoutesResponse{}
+	for _, ea := range eas {
+		if ea.ID == "" {
+			continue
+		}
+		out.EdgeAgents = append(out.EdgeAgents, edgeAgentRoutes(ea))
+	}
+
+	return out, nil
+}
+
+// GetEdgeAgentRoutes returns the routing and recipient keys and mailbox depth of an edge agent
+func (r *Mediator) GetEdgeAgentRoutes(_ context.Context, req *common.GetEdgeAgentRoutesRequest) (*common.GetEdgeAgentRoutesResponse, error) {
+	ea, err := r.getEdgeAgent(req.EdgeAgentId)
+	if err != nil {
+		return nil, err
+	}
+
+	out := edgeAgentRoutes(ea)
+	count, err := r.store.CountMailboxMessages(ea.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to count messages for edge agent %s: (%v)", ea.ID, err)
+	}
+	out.Queued = int64(count)
+
+	return &common.GetEdgeAgentRoutesResponse{EdgeAgent: out}, nil
+}
+
+// RevokeEdgeAgentRoute stops the mediator forwarding messages for a routing key to an edge agent
+func (r *Mediator) RevokeEdgeAgentRoute(_ context.Context, req *common.RevokeEdgeAgentRouteRequest) (*common.RevokeEdgeAgentRouteResponse, error) {
+	ea, err := r.getEdgeAgent(req.EdgeAgentId)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := r.routes.remove(ea, req.RoutingKey)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to revoke route %s: (%v)", req.RoutingKey, err)
+	}
+
+	if result == KeylistNoChange {
+		return nil, status.Errorf(codes.NotFound, "edge agent %s has no route for %s", ea.ID, req.RoutingKey)
+	}
+
+	return &common.RevokeEdgeAgentRouteResponse{}, nil
+}
+
+func (r *Mediator) getEdgeAgent(id string) (*datastore.EdgeAgent, error) {
+	eas, err := r.store.ListEdgeAgents()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to list edge agents: (%v)", err)
+	}
+
+	for _, ea := range eas {
+		if ea.ID != "" && ea.ID == id {
+			return ea, nil
+		}
+	}
+
+	return nil, status.Errorf(codes.NotFound, "edge agent %s not found", id)
+}
+
+func edgeAgentRoutes(ea *datastore.EdgeAgent) *common.EdgeAgentRoutes {
+	return &common.EdgeAgentRoutes{
+		EdgeAgentId:   ea.ID,
+		ExternalId:    ea.ExternalID,
+		TheirDid:      ea.TheirDID,
+		MyDid:         ea.MyDID,
+		ConnectionId:  ea.DIDCommConnectionID,
+		RoutingKeys:   ea.RoutingKeys,
+		RecipientKeys: ea.RecipientKeys,
+	}
+}
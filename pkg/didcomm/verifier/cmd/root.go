@@ -8,12 +8,12 @@ package cmd
 
 import (
 	"fmt"
-	"io/ioutil"
+	"io"
 	"log"
 	"os"
 	"strings"
+	"sync"
 
-	"github.com/hyperledger/aries-framework-go-ext/component/didcomm/transport/amqp"
 	"github.com/hyperledger/aries-framework-go/pkg/didcomm/dispatcher"
 	"github.com/hyperledger/aries-framework-go/pkg/didcomm/protocol/presentproof"
 	"github.com/hyperledger/aries-framework-go/pkg/didcomm/transport/ws"
@@ -29,12 +29,14 @@ import (
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 
+	"github.com/scoir/canis/pkg/amqp/rabbitmq"
 	"github.com/scoir/canis/pkg/config"
 	credindyengine "github.com/scoir/canis/pkg/credential/engine/indy"
 	"github.com/scoir/canis/pkg/datastore"
 	"github.com/scoir/canis/pkg/didcomm/verifier"
 	"github.com/scoir/canis/pkg/framework"
 	"github.com/scoir/canis/pkg/framework/context"
+	indywrapper "github.com/scoir/canis/pkg/indy"
 	"github.com/scoir/canis/pkg/presentproof/engine"
 	"github.com/scoir/canis/pkg/presentproof/engine/indy"
 	"github.com/scoir/canis/pkg/ursa"
@@ -61,6 +63,9 @@ type Provider struct {
 	keyMgr               kms.KeyManager
 	conf                 config.Config
 	actx                 *ariescontext.Provider
+	poolsOnce            sync.Once
+	pools                *indywrapper.Pools
+	ledgerCache          *indywrapper.LedgerCache
 }
 
 func Execute() {
@@ -156,6 +161,11 @@ func (r *Provider) GetBridgeEndpoint() (*framework.Endpoint, error) {
 	return r.conf.Endpoint("api.grpcBridge")
 }
 
+// GetTLSConfig returns the mutual TLS settings for the GRPC server
+func (r *Provider) GetTLSConfig() (*framework.TLSConfig, error) {
+	return r.conf.TLS()
+}
+
 // GetAriesContext todo
 func GetAriesContext(conf config.Config, ariesStorageProvider storage.Provider, lock secretlock.Service) (*ariescontext.Provider, error) {
 	external := conf.GetString("inbound.external")
@@ -174,9 +184,9 @@ func GetAriesContext(conf config.Config, ariesStorageProvider storage.Provider,
 		return nil, err
 	}
 
-	amqpInbound, err := amqp.NewInbound(cfg.Endpoint(), external, "present-proof", "", "")
+	amqpInbound, err := rabbitmq.NewInbound(cfg.Endpoint(), external, "present-proof")
 	if err != nil {
-		return nil, errors.Wrap(err, "amqp.NewInbound")
+		return nil, errors.Wrap(err, "unable to create amqp inbound")
 	}
 
 	vopts := []aries.Option{
@@ -231,9 +241,12 @@ func (r *Provider) GetPresentProofClient() (verifier.PresentProofClient, error)
 
 // IndyVDR todo
 func (r *Provider) IndyVDR() (credindyengine.VDRClient, error) {
-	genesisFile := r.conf.GetString("registry.indy.genesisFile")
-	re := strings.NewReader(genesisFile)
-	cl, err := vdr.New(ioutil.NopCloser(re))
+	return r.IndyLedger("")
+}
+
+// IndyLedger returns the client for the ledger with the did:indy namespace, or the default ledger if empty
+func (r *Provider) IndyLedger(namespace string) (credindyengine.VDRClient, error) {
+	cl, err := r.LedgerPools().Client(namespace)
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to get indy vdr client")
 	}
@@ -241,6 +254,45 @@ func (r *Provider) IndyVDR() (credindyengine.VDRClient, error) {
 	return cl, nil
 }
 
+// LedgerPools returns the configured ledger pools, refreshed on the configured schedule
+func (r *Provider) LedgerPools() *indywrapper.Pools {
+	r.poolsOnce.Do(func() {
+		ledgers, err := r.conf.Ledgers()
+		if err != nil {
+			log.Fatalln("invalid ledgers configuration", err)
+		}
+
+		lc, err := r.conf.LedgerCache()
+		if err != nil {
+			log.Fatalln("invalid ledgerCache configuration", err)
+		}
+		r.ledgerCache = indywrapper.NewLedgerCache(lc)
+
+		pc, err := r.conf.PoolRefresh()
+		if err != nil {
+			log.Fatalln("invalid poolRefresh configuration", err)
+		}
+		r.pools = indywrapper.NewPools(ledgers, r.conf.GetString("registry.indy.genesisFile"), r.openLedger)
+		r.pools.Monitor(pc)
+	})
+
+	return r.pools
+}
+
+// Ready returns an error if the default ledger can not be reached
+func (r *Provider) Ready() error {
+	return r.LedgerPools().Ready()
+}
+
+func (r *Provider) openLedger(namespace string, genesis io.ReadCloser) (indywrapper.IndyVDRClient, error) {
+	cl, err := vdr.New(genesis)
+	if err != nil {
+		return nil, err
+	}
+
+	return indywrapper.NewCachingClient(indywrapper.NewTracedClient(cl), r.ledgerCache, namespace), nil
+}
+
 // KMS todo
 func (r *Provider) KMS() kms.KeyManager {
 	return r.keyMgr
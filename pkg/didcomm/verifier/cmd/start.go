@@ -15,6 +15,7 @@ import (
 	"github.com/scoir/canis/pkg/didcomm/verifier"
 	"github.com/scoir/canis/pkg/framework"
 	"github.com/scoir/canis/pkg/presentproof"
+	"github.com/scoir/canis/pkg/tracing"
 )
 
 var startCmd = &cobra.Command{
@@ -25,6 +26,8 @@ var startCmd = &cobra.Command{
 }
 
 func runStart(_ *cobra.Command, _ []string) {
+	defer tracing.Setup("canis-didcomm-verifier", ctx.conf)()
+
 	prov := framework.NewSimpleProvider(ctx.actx)
 	ppsup, err := presentproof.New(prov)
 	if err != nil {
@@ -10,6 +10,7 @@ import (
 
 	"github.com/scoir/canis/pkg/datastore"
 	"github.com/scoir/canis/pkg/presentproof/engine"
+	"github.com/scoir/canis/pkg/tracing"
 )
 
 func NewProofHandler(store datastore.Store, reg engine.PresentationRegistry) *ProofHandler {
@@ -60,6 +61,9 @@ func (r *ProofHandler) PresentationMsg(e service.DIDCommAction, d *ppprotocol.Pr
 		return
 	}
 
+	thid, _ := e.Message.ThreadID()
+	ctx := tracing.ThreadContext(thid)
+
 	verified := make([]*datastore.Presentation, len(d.PresentationsAttach))
 	for i, format := range d.Formats {
 		presentationsAttach, ok := getAttachment(format.AttachID, d.PresentationsAttach)
@@ -78,7 +82,7 @@ func (r *ProofHandler) PresentationMsg(e service.DIDCommAction, d *ppprotocol.Pr
 			return
 		}
 
-		err = r.registry.Verify(format.Format, proofData, pr.Data, theirDID, myDID)
+		err = r.registry.Verify(ctx, format.Format, proofData, pr.Data, theirDID, myDID)
 		if err != nil {
 			err := errors.Errorf("unexpected error verifying %d presentation: (%v)", i, err)
 			log.Println(err)
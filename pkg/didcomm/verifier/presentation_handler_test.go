@@ -7,6 +7,7 @@ import (
 	"github.com/hyperledger/aries-framework-go/pkg/didcomm/protocol/decorator"
 	ppprotocol "github.com/hyperledger/aries-framework-go/pkg/didcomm/protocol/presentproof"
 	"github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/scoir/canis/pkg/datastore"
@@ -46,6 +47,7 @@ func TestProofHandler_PresentationMsg(t *testing.T) {
 		var result string
 		action := service.DIDCommAction{
 			ProtocolName: "presentation",
+			Message:      testMsg(t),
 			Stop: func(e error) {
 				err = e
 			},
@@ -80,7 +82,7 @@ func TestProofHandler_PresentationMsg(t *testing.T) {
 		}
 
 		suite.store.On("GetPresentationRequest", "123").Return(pr, nil)
-		suite.registry.On("Verify", "indy", []byte(`{}`), []byte(`proofData`), "sov:123", "sov:abc").Return(nil)
+		suite.registry.On("Verify", mock.Anything, "indy", []byte(`{}`), []byte(`proofData`), "sov:123", "sov:abc").Return(nil)
 		suite.store.On("InsertPresentation", verified).Return("id-1", nil)
 
 		suite.target.PresentationMsg(action, p)
@@ -94,6 +96,7 @@ func TestProofHandler_PresentationMsg(t *testing.T) {
 		var err error
 		action := service.DIDCommAction{
 			ProtocolName: "presentation",
+			Message:      testMsg(t),
 			Stop: func(e error) {
 				err = e
 			},
@@ -125,7 +128,7 @@ func TestProofHandler_PresentationMsg(t *testing.T) {
 		}
 
 		suite.store.On("GetPresentationRequest", "123").Return(pr, nil)
-		suite.registry.On("Verify", "indy", []byte(`{}`), []byte(`proofData`), "sov:123", "sov:abc").Return(nil)
+		suite.registry.On("Verify", mock.Anything, "indy", []byte(`{}`), []byte(`proofData`), "sov:123", "sov:abc").Return(nil)
 		suite.store.On("InsertPresentation", verified).Return("", errors.New("not saved"))
 
 		suite.target.PresentationMsg(action, p)
@@ -140,6 +143,7 @@ func TestProofHandler_PresentationMsg(t *testing.T) {
 		var err error
 		action := service.DIDCommAction{
 			ProtocolName: "presentation",
+			Message:      testMsg(t),
 			Stop: func(e error) {
 				err = e
 			},
@@ -164,7 +168,7 @@ func TestProofHandler_PresentationMsg(t *testing.T) {
 		}
 
 		suite.store.On("GetPresentationRequest", "123").Return(pr, nil)
-		suite.registry.On("Verify", "indy", []byte(`{}`), []byte(`proofData`), "sov:123", "sov:abc").Return(errors.New("boom"))
+		suite.registry.On("Verify", mock.Anything, "indy", []byte(`{}`), []byte(`proofData`), "sov:123", "sov:abc").Return(errors.New("boom"))
 
 		suite.target.PresentationMsg(action, p)
 		require.Error(t, err)
@@ -178,6 +182,7 @@ func TestProofHandler_PresentationMsg(t *testing.T) {
 		var err error
 		action := service.DIDCommAction{
 			ProtocolName: "presentation",
+			Message:      testMsg(t),
 			Stop: func(e error) {
 				err = e
 			},
@@ -213,6 +218,7 @@ func TestProofHandler_PresentationMsg(t *testing.T) {
 		var err error
 		action := service.DIDCommAction{
 			ProtocolName: "presentation",
+			Message:      testMsg(t),
 			Stop: func(e error) {
 				err = e
 			},
@@ -240,6 +246,7 @@ func TestProofHandler_PresentationMsg(t *testing.T) {
 		var err error
 		action := service.DIDCommAction{
 			ProtocolName: "presentation",
+			Message:      testMsg(t),
 			Stop: func(e error) {
 				err = e
 			},
@@ -262,6 +269,7 @@ func TestProofHandler_PresentationMsg(t *testing.T) {
 		var err error
 		action := service.DIDCommAction{
 			ProtocolName: "presentation",
+			Message:      testMsg(t),
 			Stop: func(e error) {
 				err = e
 			},
@@ -350,3 +358,15 @@ func setup(t *testing.T) (*suite, func()) {
 		s.registry.AssertExpectations(t)
 	}
 }
+
+func testMsg(t *testing.T) service.DIDCommMsg {
+	msg, err := service.ParseDIDCommMsgMap([]byte(`{
+						"@id":"80f8b418-4818-4af6-8915-f299b974f5c2",
+						"@type":"https://didcomm.org/present-proof/2.0/presentation",
+						"~thread":{
+						   "thid":"123"
+						}
+					}`))
+	require.NoError(t, err)
+	return msg
+}
@@ -71,7 +71,7 @@ func (r *Server) APISpec() (http.HandlerFunc, error) {
 	return nil, errors.New("not implemented")
 }
 
-func (r *Server) RequestPresentation(_ context.Context, req *common.RequestPresentationRequest) (*common.RequestPresentationResponse, error) {
+func (r *Server) RequestPresentation(ctx context.Context, req *common.RequestPresentationRequest) (*common.RequestPresentationResponse, error) {
 	agent, err := r.store.GetAgent(req.AgentName)
 	if err != nil {
 		return nil, status.Error(codes.Internal, fmt.Sprintf("unable to load agent: %v", err))
@@ -99,7 +99,7 @@ func (r *Server) RequestPresentation(_ context.Context, req *common.RequestPrese
 		}
 	}
 
-	presentation, err := r.registry.RequestPresentation(req.Presentation.Name, req.Presentation.Format, definitions)
+	presentation, err := r.registry.RequestPresentation(ctx, req.Presentation.Name, req.Presentation.Format, definitions)
 	if err != nil {
 		return nil, status.Error(codes.Internal, fmt.Sprintf("unexpected error creating presentation request: %v", err))
 	}
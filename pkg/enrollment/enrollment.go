@@ -0,0 +1,109 @@
+/*
+Copyright Scoir Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package enrollment
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/scoir/canis/pkg/datastore"
+)
+
+// Agent types an enrollment token can register
+const (
+	EdgeAgent  = "edge"
+	CloudAgent = "cloud"
+)
+
+const (
+	// DefaultTTL is how long a token is valid for when the admin does not ask for a lifetime
+	DefaultTTL = 24 * time.Hour
+	// MaxTTL is the longest lifetime a token can be minted with
+	MaxTTL = 30 * 24 * time.Hour
+
+	tokenSize = 32
+)
+
+// Mint creates a single use token that registers an agent of agentType for externalID until it expires.
+// Only a hash of the token is stored
+func Mint(store datastore.Store, agentType, externalID string, ttl time.Duration) (string, time.Time, error) {
+	if agentType != EdgeAgent && agentType != CloudAgent {
+		return "", time.Time{}, errors.Errorf("unknown agent type %s", agentType)
+	}
+
+	if externalID == "" {
+		return "", time.Time{}, errors.New("external ID is required")
+	}
+
+	if ttl <= 0 {
+		ttl = DefaultTTL
+	} else if ttl > MaxTTL {
+		return "", time.Time{}, errors.Errorf("token lifetime can not exceed %s", MaxTTL)
+	}
+
+	b := make([]byte, tokenSize)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", time.Time{}, errors.Wrap(err, "unable to generate token")
+	}
+	token := base64.RawURLEncoding.EncodeToString(b)
+
+	now := time.Now().UTC().Truncate(time.Millisecond)
+	t := &datastore.EnrollmentToken{
+		ID:         hash(token),
+		AgentType:  agentType,
+		ExternalID: externalID,
+		Created:    now,
+		Expires:    now.Add(ttl),
+	}
+
+	err = store.InsertEnrollmentToken(t)
+	if err != nil {
+		return "", time.Time{}, errors.Wrap(err, "unable to save enrollment token")
+	}
+
+	return token, t.Expires, nil
+}
+
+// Authorize checks an agent registration.  A registration with a token must redeem it, otherwise the shared
+// secret is accepted when one is configured
+func Authorize(store datastore.Store, agentType, externalID, token, secret, sharedSecret string) error {
+	if token != "" {
+		return redeem(store, agentType, externalID, token)
+	}
+
+	if sharedSecret == "" || subtle.ConstantTimeCompare([]byte(secret), []byte(sharedSecret)) != 1 {
+		return errors.Errorf("invalid %s agent secret", agentType)
+	}
+
+	return nil
+}
+
+// redeem consumes the token, it can not be used again even when it was minted for another agent
+func redeem(store datastore.Store, agentType, externalID, token string) error {
+	t, err := store.ConsumeEnrollmentToken(hash(token), time.Now().UTC())
+	if err != nil {
+		return errors.New("invalid enrollment token")
+	}
+
+	if t.AgentType != agentType || t.ExternalID != externalID {
+		return errors.New("enrollment token was not issued for this agent")
+	}
+
+	return nil
+}
+
+func hash(token string) string {
+	h := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(h[:])
+}
@@ -0,0 +1,70 @@
+/*
+Copyright Scoir Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package enrollment
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scoir/canis/pkg/datastore"
+	"github.com/scoir/canis/pkg/datastore/mocks"
+)
+
+func TestMint(t *testing.T) {
+	store := &mocks.Store{}
+	var saved *datastore.EnrollmentToken
+	store.On("InsertEnrollmentToken", mock.AnythingOfType("*datastore.EnrollmentToken")).Run(func(args mock.Arguments) {
+		saved = args.Get(0).(*datastore.EnrollmentToken)
+	}).Return(nil)
+
+	token, expires, err := Mint(store, EdgeAgent, "external-1", 0)
+	require.NoError(t, err)
+	require.NotEmpty(t, token)
+	require.Equal(t, hash(token), saved.ID)
+	require.NotEqual(t, token, saved.ID)
+	require.Equal(t, "external-1", saved.ExternalID)
+	require.Equal(t, saved.Created.Add(DefaultTTL), expires)
+
+	_, _, err = Mint(store, "other", "external-1", 0)
+	require.Error(t, err)
+
+	_, _, err = Mint(store, EdgeAgent, "external-1", MaxTTL+time.Second)
+	require.Error(t, err)
+}
+
+func TestAuthorize(t *testing.T) {
+	t.Run("token", func(t *testing.T) {
+		store := &mocks.Store{}
+		store.On("ConsumeEnrollmentToken", hash("token-1"), mock.AnythingOfType("time.Time")).Return(
+			&datastore.EnrollmentToken{AgentType: EdgeAgent, ExternalID: "external-1"}, nil)
+
+		require.NoError(t, Authorize(store, EdgeAgent, "external-1", "token-1", "", ""))
+		require.Error(t, Authorize(store, EdgeAgent, "external-2", "token-1", "", ""))
+		require.Error(t, Authorize(store, CloudAgent, "external-1", "token-1", "", ""))
+	})
+
+	t.Run("used or expired token", func(t *testing.T) {
+		store := &mocks.Store{}
+		store.On("ConsumeEnrollmentToken", hash("token-1"), mock.AnythingOfType("time.Time")).Return(nil, errors.New("not found"))
+
+		err := Authorize(store, EdgeAgent, "external-1", "token-1", "secret", "secret")
+		require.EqualError(t, err, "invalid enrollment token")
+	})
+
+	t.Run("shared secret", func(t *testing.T) {
+		store := &mocks.Store{}
+
+		require.NoError(t, Authorize(store, EdgeAgent, "external-1", "", "secret", "secret"))
+		require.EqualError(t, Authorize(store, EdgeAgent, "external-1", "", "guess", "secret"), "invalid edge agent secret")
+		require.Error(t, Authorize(store, CloudAgent, "external-1", "", "", ""))
+		store.AssertNotCalled(t, "ConsumeEnrollmentToken", mock.Anything, mock.Anything)
+	})
+}
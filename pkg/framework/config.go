@@ -8,6 +8,7 @@ package framework
 
 import (
 	"fmt"
+	"time"
 )
 
 type Endpoint struct {
@@ -33,3 +34,57 @@ type AMQPConfig struct {
 func (r *AMQPConfig) Endpoint() string {
 	return fmt.Sprintf("amqp://%s:%s@%s:%d/%s", r.User, r.Password, r.Host, r.Port, r.VHost)
 }
+
+// TracingConfig selects where OpenTelemetry spans are exported, tracing is disabled when Exporter is empty
+type TracingConfig struct {
+	// Exporter is either "otlp" or "stdout"
+	Exporter string `mapstructure:"exporter"`
+	// Endpoint is the OTLP collector gRPC address
+	Endpoint string `mapstructure:"endpoint"`
+	Insecure bool   `mapstructure:"insecure"`
+	// SampleRatio is the fraction of new traces that are sampled, all traces are sampled when zero
+	SampleRatio float64 `mapstructure:"sampleRatio"`
+}
+
+// LedgerConfig is a named Indy network, DIDs and ledger objects written to it are qualified with its
+// did:indy namespace
+type LedgerConfig struct {
+	Namespace   string `mapstructure:"namespace"`
+	GenesisFile string `mapstructure:"genesisFile"`
+}
+
+// LedgerCacheConfig bounds the cache of ledger reads and sets the time to live of each type of cached read.
+// Schemas and cred defs are immutable so can be kept much longer than NYMs and ATTRIBs.  A zero TTL
+// disables caching of that type of read
+type LedgerCacheConfig struct {
+	// Size is the maximum number of cached reads, caching is disabled when zero
+	Size       int           `mapstructure:"size"`
+	NymTTL     time.Duration `mapstructure:"nymTTL"`
+	AttribTTL  time.Duration `mapstructure:"attribTTL"`
+	SchemaTTL  time.Duration `mapstructure:"schemaTTL"`
+	CredDefTTL time.Duration `mapstructure:"credDefTTL"`
+}
+
+// PoolRefreshConfig schedules refreshes of each ledger pool's validator list.  A random delay of up to
+// Jitter is added to each Interval so replicas do not refresh together.  Refresh is disabled when
+// Interval is zero
+type PoolRefreshConfig struct {
+	Interval time.Duration `mapstructure:"interval"`
+	Jitter   time.Duration `mapstructure:"jitter"`
+}
+
+// MailboxConfig bounds the messages the mediator queues for each offline edge agent.  Messages older than
+// MaxAge and the oldest messages beyond MaxMessages are dropped, zero disables a limit
+type MailboxConfig struct {
+	MaxMessages    int           `mapstructure:"maxMessages"`
+	MaxAge         time.Duration `mapstructure:"maxAge"`
+	MaxMessageSize int           `mapstructure:"maxMessageSize"`
+}
+
+// PushConfig is the webhook wake-up notifications for devices are posted to, a bridge behind it delivers them
+// through FCM or APNs.  Requests are signed with Secret when one is set
+type PushConfig struct {
+	WebhookURL string        `mapstructure:"webhookURL"`
+	Secret     string        `mapstructure:"secret"`
+	Timeout    time.Duration `mapstructure:"timeout"`
+}
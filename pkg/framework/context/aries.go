@@ -11,6 +11,7 @@ import (
 	"io/ioutil"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/hyperledger/aries-framework-go/pkg/client/didexchange"
 	"github.com/hyperledger/aries-framework-go/pkg/client/issuecredential"
@@ -29,7 +30,9 @@ import (
 	mongodbstore "github.com/scoir/aries-storage-mongo/pkg"
 
 	"github.com/scoir/canis/pkg/aries/vdri/indy"
+	"github.com/scoir/canis/pkg/aries/vdri/web"
 	"github.com/scoir/canis/pkg/credential"
+	indywrapper "github.com/scoir/canis/pkg/indy"
 )
 
 const (
@@ -43,20 +46,90 @@ func GetAriesVDRIs(vdriConfig []map[string]interface{}) ([]vdriapi.VDRI, error)
 		typ, _ := v["type"].(string)
 		switch typ {
 		case "indy":
-			method, _ := v["method"].(string)
-			genesisFile, _ := v["genesisFile"].(string)
-			re := strings.NewReader(genesisFile)
-			indyVDRI, err := indy.New(method, indy.WithIndyVDRGenesisReader(ioutil.NopCloser(re)))
+			indyVDRI, err := newIndyVDRI(v)
 			if err != nil {
 				return nil, errors.Wrap(err, "unable to initialize configured indy vdri provider")
 			}
 			out = append(out, indyVDRI)
+		case web.Method:
+			out = append(out, web.New())
 		}
 	}
 
 	return out, nil
 }
 
+// newIndyVDRI creates an indy VDRI from its config.  did:indy VDRIs list a genesis file per ledger
+// namespace under pools, the first being the default
+func newIndyVDRI(v map[string]interface{}) (*indy.VDRI, error) {
+	method, _ := v["method"].(string)
+
+	pools, _ := v["pools"].([]interface{})
+	if len(pools) == 0 {
+		genesisFile, _ := v["genesisFile"].(string)
+		re := strings.NewReader(genesisFile)
+		return indy.New(method, indy.WithIndyVDRGenesisReader(ioutil.NopCloser(re)), withLedgerCache(v))
+	}
+
+	opts := []indy.Option{withLedgerCache(v)}
+	for _, p := range pools {
+		pool := stringMap(p)
+		namespace, _ := pool["namespace"].(string)
+		genesisFile, _ := pool["genesisFile"].(string)
+		re := strings.NewReader(genesisFile)
+		opts = append(opts, indy.WithNamespaceGenesisReader(namespace, ioutil.NopCloser(re)))
+	}
+
+	return indy.New(method, opts...)
+}
+
+// withLedgerCache caches the VDRI ledger reads, overriding the default size and TTLs with any set under cache
+func withLedgerCache(v map[string]interface{}) indy.Option {
+	lc := indywrapper.DefaultLedgerCacheConfig
+	c := stringMap(v["cache"])
+
+	if size, ok := c["size"].(int); ok {
+		lc.Size = size
+	}
+
+	ttls := map[string]*time.Duration{
+		"nymTTL":    &lc.NymTTL,
+		"attribTTL": &lc.AttribTTL,
+	}
+
+	for key, ttl := range ttls {
+		s, ok := c[key].(string)
+		if !ok {
+			continue
+		}
+
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			log.Printf("invalid indy vdri cache %s %q, using default\n", key, s)
+			continue
+		}
+		*ttl = d
+	}
+
+	return indy.WithLedgerCache(indywrapper.NewLedgerCache(&lc))
+}
+
+// stringMap returns a nested config map, which YAML decoding may have left with interface keys
+func stringMap(v interface{}) map[string]interface{} {
+	switch m := v.(type) {
+	case map[string]interface{}:
+		return m
+	case map[interface{}]interface{}:
+		out := make(map[string]interface{}, len(m))
+		for k, val := range m {
+			out[fmt.Sprintf("%v", k)] = val
+		}
+		return out
+	}
+
+	return map[string]interface{}{}
+}
+
 type kmsProvider struct {
 	sp   storage.Provider
 	lock secretlock.Service
@@ -158,14 +231,12 @@ func (r *Provider) getOptions() []aries.Option {
 			typ, _ := v["type"].(string)
 			switch typ {
 			case "indy":
-				method, _ := v["method"].(string)
-				genesisFile, _ := v["genesisFile"].(string)
-				re := strings.NewReader(genesisFile)
-				indyVDRI, err := indy.New(method, indy.WithIndyVDRGenesisReader(ioutil.NopCloser(re)))
+				indyVDRI, err := newIndyVDRI(v)
 				if err == nil {
 					out = append(out, aries.WithVDRI(indyVDRI))
 				}
-
+			case web.Method:
+				out = append(out, aries.WithVDRI(web.New()))
 			}
 		}
 	}
@@ -210,7 +281,6 @@ func (r *Provider) GetCredentialClient() (*issuecredential.Client, error) {
 	return r.credcl, nil
 }
 
-
 func (r *Provider) GetSupervisor(h credential.Handler) (*credential.Supervisor, error) {
 	sup, err := credential.New(r)
 	if err != nil {
@@ -11,6 +11,7 @@ import (
 	"google.golang.org/grpc"
 
 	api "github.com/scoir/canis/pkg/apiserver/api/protogen"
+	"github.com/scoir/canis/pkg/tracing"
 )
 
 func (r *Provider) GetAPIAdminClient() (api.AdminClient, error) {
@@ -19,7 +20,17 @@ func (r *Provider) GetAPIAdminClient() (api.AdminClient, error) {
 		return nil, err
 	}
 
-	cc, err := grpc.Dial(ep.Address(), grpc.WithInsecure())
+	tc, err := r.conf.TLS()
+	if err != nil {
+		return nil, errors.Wrap(err, "tls is not properly configured")
+	}
+
+	creds, err := tc.DialOption()
+	if err != nil {
+		return nil, err
+	}
+
+	cc, err := grpc.Dial(ep.Address(), creds, grpc.WithUnaryInterceptor(tracing.UnaryClientInterceptor()))
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to dial grpc for api client")
 	}
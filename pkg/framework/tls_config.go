@@ -0,0 +1,255 @@
+/*
+Copyright Scoir Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package framework
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+	"log"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// TLSConfig configures mutual TLS for the internal GRPC servers and clients.  Certificate and key
+// files are checked for changes on every handshake so they can be rotated without a restart.
+type TLSConfig struct {
+	// CertFile and KeyFile are the certificate presented by GRPC servers
+	CertFile string `mapstructure:"certFile"`
+	KeyFile  string `mapstructure:"keyFile"`
+	// ClientCAFile is the CA bundle used by GRPC servers to verify client certificates
+	ClientCAFile string `mapstructure:"clientCAFile"`
+
+	// CAFile is the CA bundle used by GRPC clients to verify servers
+	CAFile string `mapstructure:"caFile"`
+	// ClientCertFile and ClientKeyFile are the certificate presented by GRPC clients
+	ClientCertFile string `mapstructure:"clientCertFile"`
+	ClientKeyFile  string `mapstructure:"clientKeyFile"`
+	// ServerName overrides the name clients expect in server certificates
+	ServerName string `mapstructure:"serverName"`
+}
+
+// ServerEnabled returns true if GRPC servers should listen with TLS
+func (r *TLSConfig) ServerEnabled() bool {
+	return r != nil && r.CertFile != "" && r.KeyFile != ""
+}
+
+// ClientEnabled returns true if GRPC clients should dial with TLS
+func (r *TLSConfig) ClientEnabled() bool {
+	return r != nil && r.CAFile != ""
+}
+
+// ServerOptions returns the transport credentials for a GRPC server, no options are returned if TLS is not configured
+func (r *TLSConfig) ServerOptions() ([]grpc.ServerOption, error) {
+	if !r.ServerEnabled() {
+		return nil, nil
+	}
+
+	keyPair := &keyPairReloader{certFile: r.CertFile, keyFile: r.KeyFile}
+	_, err := keyPair.get()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to load server certificate")
+	}
+
+	var clientCAs *certPoolReloader
+	if r.ClientCAFile != "" {
+		clientCAs = &certPoolReloader{file: r.ClientCAFile}
+		_, err = clientCAs.get()
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to load client CA")
+		}
+	}
+
+	cfg := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(_ *tls.ClientHelloInfo) (*tls.Config, error) {
+			cert, err := keyPair.get()
+			if err != nil {
+				return nil, err
+			}
+
+			out := &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				Certificates: []tls.Certificate{*cert},
+				NextProtos:   []string{"h2"},
+			}
+
+			if clientCAs != nil {
+				pool, err := clientCAs.get()
+				if err != nil {
+					return nil, err
+				}
+				out.ClientCAs = pool
+				out.ClientAuth = tls.RequireAndVerifyClientCert
+			}
+
+			return out, nil
+		},
+	}
+
+	return []grpc.ServerOption{grpc.Creds(credentials.NewTLS(cfg))}, nil
+}
+
+// DialOption returns the transport credentials for a GRPC client, an insecure option is returned if TLS is not configured
+func (r *TLSConfig) DialOption() (grpc.DialOption, error) {
+	if !r.ClientEnabled() {
+		return grpc.WithInsecure(), nil
+	}
+
+	roots := &certPoolReloader{file: r.CAFile}
+	_, err := roots.get()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to load CA")
+	}
+
+	// RootCAs would pin the CA bundle loaded at startup, so the server chain is verified
+	// in VerifyConnection against the reloaded bundle instead
+	cfg := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		ServerName:         r.ServerName,
+		InsecureSkipVerify: true,
+		VerifyConnection: func(cs tls.ConnectionState) error {
+			return roots.verify(cs.ServerName, cs.PeerCertificates)
+		},
+	}
+
+	if r.ClientCertFile != "" && r.ClientKeyFile != "" {
+		keyPair := &keyPairReloader{certFile: r.ClientCertFile, keyFile: r.ClientKeyFile}
+		_, err = keyPair.get()
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to load client certificate")
+		}
+
+		cfg.GetClientCertificate = func(_ *tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			return keyPair.get()
+		}
+	}
+
+	return grpc.WithTransportCredentials(credentials.NewTLS(cfg)), nil
+}
+
+type keyPairReloader struct {
+	certFile, keyFile string
+
+	lock    sync.Mutex
+	cert    *tls.Certificate
+	modTime time.Time
+}
+
+// get returns the current key pair, reloading it if either file has changed.  If a rotated
+// pair fails to load the previous one is kept so a partial write does not take the service down
+func (r *keyPairReloader) get() (*tls.Certificate, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	modTime, err := latestModTime(r.certFile, r.keyFile)
+	if err != nil && r.cert == nil {
+		return nil, err
+	}
+
+	if r.cert != nil && (err != nil || !modTime.After(r.modTime)) {
+		return r.cert, nil
+	}
+
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		if r.cert != nil {
+			log.Println("unable to reload certificate", r.certFile, err)
+			return r.cert, nil
+		}
+		return nil, errors.Wrapf(err, "unable to load key pair %s", r.certFile)
+	}
+
+	r.cert = &cert
+	r.modTime = modTime
+	return r.cert, nil
+}
+
+type certPoolReloader struct {
+	file string
+
+	lock    sync.Mutex
+	pool    *x509.CertPool
+	modTime time.Time
+}
+
+func (r *certPoolReloader) get() (*x509.CertPool, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	modTime, err := latestModTime(r.file)
+	if err != nil && r.pool == nil {
+		return nil, err
+	}
+
+	if r.pool != nil && (err != nil || !modTime.After(r.modTime)) {
+		return r.pool, nil
+	}
+
+	pem, err := ioutil.ReadFile(r.file)
+	if err == nil {
+		pool := x509.NewCertPool()
+		if pool.AppendCertsFromPEM(pem) {
+			r.pool = pool
+			r.modTime = modTime
+			return r.pool, nil
+		}
+		err = errors.New("no certificates found")
+	}
+
+	if r.pool != nil {
+		log.Println("unable to reload CA bundle", r.file, err)
+		return r.pool, nil
+	}
+
+	return nil, errors.Wrapf(err, "unable to load CA bundle %s", r.file)
+}
+
+// verify checks a peer certificate chain for serverName against the current CA bundle
+func (r *certPoolReloader) verify(serverName string, certs []*x509.Certificate) error {
+	if len(certs) == 0 {
+		return errors.New("no peer certificates presented")
+	}
+
+	pool, err := r.get()
+	if err != nil {
+		return err
+	}
+
+	opts := x509.VerifyOptions{
+		DNSName:       serverName,
+		Roots:         pool,
+		Intermediates: x509.NewCertPool(),
+	}
+	for _, cert := range certs[1:] {
+		opts.Intermediates.AddCert(cert)
+	}
+
+	_, err = certs[0].Verify(opts)
+	return errors.Wrap(err, "unable to verify peer certificate")
+}
+
+func latestModTime(files ...string) (time.Time, error) {
+	var out time.Time
+	for _, f := range files {
+		fi, err := os.Stat(f)
+		if err != nil {
+			return out, errors.Wrapf(err, "unable to stat %s", f)
+		}
+
+		if fi.ModTime().After(out) {
+			out = fi.ModTime()
+		}
+	}
+
+	return out, nil
+}
@@ -0,0 +1,168 @@
+package framework
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTLSConfig_Enabled(t *testing.T) {
+	var tc *TLSConfig
+	require.False(t, tc.ServerEnabled())
+	require.False(t, tc.ClientEnabled())
+
+	opts, err := tc.ServerOptions()
+	require.NoError(t, err)
+	require.Empty(t, opts)
+
+	opt, err := tc.DialOption()
+	require.NoError(t, err)
+	require.NotNil(t, opt)
+
+	tc = &TLSConfig{CertFile: "cert.pem", KeyFile: "key.pem", CAFile: "ca.pem"}
+	require.True(t, tc.ServerEnabled())
+	require.True(t, tc.ClientEnabled())
+}
+
+func TestTLSConfig_Options(t *testing.T) {
+	dir, err := ioutil.TempDir("", "canis-tls")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	certFile, keyFile := writeKeyPair(t, dir, "first")
+	tc := &TLSConfig{
+		CertFile:       certFile,
+		KeyFile:        keyFile,
+		ClientCAFile:   certFile,
+		CAFile:         certFile,
+		ClientCertFile: certFile,
+		ClientKeyFile:  keyFile,
+	}
+
+	opts, err := tc.ServerOptions()
+	require.NoError(t, err)
+	require.Len(t, opts, 1)
+
+	_, err = tc.DialOption()
+	require.NoError(t, err)
+
+	t.Run("missing files", func(t *testing.T) {
+		bad := &TLSConfig{CertFile: filepath.Join(dir, "nope.crt"), KeyFile: keyFile}
+		_, err := bad.ServerOptions()
+		require.Error(t, err)
+
+		bad = &TLSConfig{CAFile: filepath.Join(dir, "nope.crt")}
+		_, err = bad.DialOption()
+		require.Error(t, err)
+	})
+}
+
+func TestKeyPairReloader(t *testing.T) {
+	dir, err := ioutil.TempDir("", "canis-tls")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	certFile, keyFile := writeKeyPair(t, dir, "first")
+	r := &keyPairReloader{certFile: certFile, keyFile: keyFile}
+
+	first, err := r.get()
+	require.NoError(t, err)
+
+	again, err := r.get()
+	require.NoError(t, err)
+	require.Same(t, first, again)
+
+	writeKeyPair(t, dir, "second")
+	future := time.Now().Add(time.Minute)
+	require.NoError(t, os.Chtimes(certFile, future, future))
+
+	second, err := r.get()
+	require.NoError(t, err)
+	require.NotEqual(t, first.Certificate[0], second.Certificate[0])
+
+	err = ioutil.WriteFile(certFile, []byte("garbage"), 0600)
+	require.NoError(t, err)
+	future = future.Add(time.Minute)
+	require.NoError(t, os.Chtimes(certFile, future, future))
+
+	kept, err := r.get()
+	require.NoError(t, err)
+	require.Same(t, second, kept)
+}
+
+func TestCertPoolReloader_Verify(t *testing.T) {
+	dir, err := ioutil.TempDir("", "canis-tls")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	certFile, _ := writeKeyPair(t, dir, "first")
+	first := readCert(t, certFile)
+	r := &certPoolReloader{file: certFile}
+
+	require.NoError(t, r.verify("localhost", []*x509.Certificate{first}))
+	require.Error(t, r.verify("example.com", []*x509.Certificate{first}))
+	require.Error(t, r.verify("localhost", nil))
+
+	writeKeyPair(t, dir, "second")
+	future := time.Now().Add(time.Minute)
+	require.NoError(t, os.Chtimes(certFile, future, future))
+	second := readCert(t, certFile)
+
+	require.Error(t, r.verify("localhost", []*x509.Certificate{first}))
+	require.NoError(t, r.verify("localhost", []*x509.Certificate{second}))
+}
+
+func readCert(t *testing.T, file string) *x509.Certificate {
+	data, err := ioutil.ReadFile(file)
+	require.NoError(t, err)
+
+	block, _ := pem.Decode(data)
+	require.NotNil(t, block)
+
+	cert, err := x509.ParseCertificate(block.Bytes)
+	require.NoError(t, err)
+	return cert
+}
+
+func writeKeyPair(t *testing.T, dir, cn string) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: cn},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		DNSNames:              []string{"localhost"},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certFile := filepath.Join(dir, "tls.crt")
+	keyFile := filepath.Join(dir, "tls.key")
+	err = ioutil.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600)
+	require.NoError(t, err)
+	err = ioutil.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0600)
+	require.NoError(t, err)
+
+	return certFile, keyFile
+}
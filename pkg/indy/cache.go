@@ -0,0 +1,230 @@
+package indy
+
+import (
+	"container/list"
+	"context"
+	"expvar"
+	"sync"
+	"time"
+
+	"github.com/hyperledger/indy-vdr/wrappers/golang/vdr"
+
+	"github.com/scoir/canis/pkg/framework"
+)
+
+// Types of cached ledger reads, each with its own time to live
+const (
+	NymRead     = "nym"
+	AttribRead  = "attrib"
+	SchemaRead  = "schema"
+	CredDefRead = "cred_def"
+)
+
+// DefaultLedgerCacheConfig is used when no ledger cache is configured
+var DefaultLedgerCacheConfig = framework.LedgerCacheConfig{
+	Size:       1000,
+	NymTTL:     time.Minute,
+	AttribTTL:  time.Minute,
+	SchemaTTL:  24 * time.Hour,
+	CredDefTTL: 24 * time.Hour,
+}
+
+// cacheStats exports hits, misses and evictions of every ledger cache in the process, by read type
+var cacheStats = expvar.NewMap("indy_ledger_cache")
+
+// LedgerCache is a bounded, least recently used cache of ledger read replies shared by the clients of
+// every ledger in a process
+type LedgerCache struct {
+	lock    sync.Mutex
+	size    int
+	ttl     map[string]time.Duration
+	entries map[string]*list.Element
+	lru     *list.List
+	now     func() time.Time
+}
+
+type cacheEntry struct {
+	key     string
+	rply    *vdr.ReadReply
+	expires time.Time
+}
+
+// NewLedgerCache returns a cache sized and timed by conf, or by DefaultLedgerCacheConfig if conf is nil
+func NewLedgerCache(conf *framework.LedgerCacheConfig) *LedgerCache {
+	if conf == nil {
+		conf = &DefaultLedgerCacheConfig
+	}
+
+	return &LedgerCache{
+		size: conf.Size,
+		ttl: map[string]time.Duration{
+			NymRead:     conf.NymTTL,
+			AttribRead:  conf.AttribTTL,
+			SchemaRead:  conf.SchemaTTL,
+			CredDefRead: conf.CredDefTTL,
+		},
+		entries: map[string]*list.Element{},
+		lru:     list.New(),
+		now:     time.Now,
+	}
+}
+
+// Read returns the cached reply for key, calling fetch on a miss.  Failed reads and replies without data
+// are not cached so objects written to the ledger after a miss are found
+func (r *LedgerCache) Read(typ, key string, fetch func() (*vdr.ReadReply, error)) (*vdr.ReadReply, error) {
+	if r == nil || r.size <= 0 || r.ttl[typ] <= 0 {
+		return fetch()
+	}
+
+	key = typ + "|" + key
+	if rply, ok := r.get(key); ok {
+		cacheStats.Add(typ+"_hits", 1)
+		return rply, nil
+	}
+
+	cacheStats.Add(typ+"_misses", 1)
+	rply, err := fetch()
+	if err != nil || rply == nil || rply.Data == nil {
+		return rply, err
+	}
+
+	r.put(key, rply, r.ttl[typ])
+	return rply, nil
+}
+
+// Invalidate drops the cached reply for key
+func (r *LedgerCache) Invalidate(typ, key string) {
+	if r == nil {
+		return
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if el, ok := r.entries[typ+"|"+key]; ok {
+		r.remove(el)
+	}
+}
+
+// Len returns the number of cached replies
+func (r *LedgerCache) Len() int {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	return r.lru.Len()
+}
+
+func (r *LedgerCache) get(key string) (*vdr.ReadReply, bool) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	el, ok := r.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	entry := el.Value.(*cacheEntry)
+	if r.now().After(entry.expires) {
+		r.remove(el)
+		return nil, false
+	}
+
+	r.lru.MoveToFront(el)
+	return entry.rply, true
+}
+
+func (r *LedgerCache) put(key string, rply *vdr.ReadReply, ttl time.Duration) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if el, ok := r.entries[key]; ok {
+		r.remove(el)
+	}
+
+	r.entries[key] = r.lru.PushFront(&cacheEntry{key: key, rply: rply, expires: r.now().Add(ttl)})
+
+	for r.lru.Len() > r.size {
+		r.remove(r.lru.Back())
+		cacheStats.Add("evictions", 1)
+	}
+}
+
+func (r *LedgerCache) remove(el *list.Element) {
+	r.lru.Remove(el)
+	delete(r.entries, el.Value.(*cacheEntry).key)
+}
+
+// CachingClient answers NYM, ATTRIB, SCHEMA and CLAIM_DEF reads from a LedgerCache
+type CachingClient struct {
+	IndyVDRClient
+	cache     *LedgerCache
+	namespace string
+}
+
+// NewCachingClient wraps client for the ledger with namespace with reads cached in cache
+func NewCachingClient(client IndyVDRClient, cache *LedgerCache, namespace string) *CachingClient {
+	return &CachingClient{
+		IndyVDRClient: client,
+		cache:         cache,
+		namespace:     namespace,
+	}
+}
+
+// WithContext returns a client that shares the cache of r and records the ledger calls it makes as part of the
+// trace of ctx
+func (r *CachingClient) WithContext(ctx context.Context) IndyVDRClient {
+	return &CachingClient{
+		IndyVDRClient: WithContext(ctx, r.IndyVDRClient),
+		cache:         r.cache,
+		namespace:     r.namespace,
+	}
+}
+
+func (r *CachingClient) CreateNym(did, verkey, role, from string, signer vdr.Signer) error {
+	defer r.cache.Invalidate(NymRead, r.namespace+"|"+did)
+	return r.IndyVDRClient.CreateNym(did, verkey, role, from, signer)
+}
+
+func (r *CachingClient) SetEndpoint(did, from string, ep string, signer vdr.Signer) error {
+	defer r.cache.Invalidate(AttribRead, r.namespace+"|"+did+"|endpoint")
+	return r.IndyVDRClient.SetEndpoint(did, from, ep, signer)
+}
+
+func (r *CachingClient) CreateAttrib(did, from string, data map[string]interface{}, signer vdr.Signer) error {
+	defer func() {
+		for raw := range data {
+			r.cache.Invalidate(AttribRead, r.namespace+"|"+did+"|"+raw)
+		}
+	}()
+	return r.IndyVDRClient.CreateAttrib(did, from, data, signer)
+}
+
+func (r *CachingClient) GetNym(did string) (*vdr.ReadReply, error) {
+	return r.cache.Read(NymRead, r.namespace+"|"+did, func() (*vdr.ReadReply, error) {
+		return r.IndyVDRClient.GetNym(did)
+	})
+}
+
+func (r *CachingClient) GetEndpoint(did string) (*vdr.ReadReply, error) {
+	return r.cache.Read(AttribRead, r.namespace+"|"+did+"|endpoint", func() (*vdr.ReadReply, error) {
+		return r.IndyVDRClient.GetEndpoint(did)
+	})
+}
+
+func (r *CachingClient) GetAttrib(did, raw string) (*vdr.ReadReply, error) {
+	return r.cache.Read(AttribRead, r.namespace+"|"+did+"|"+raw, func() (*vdr.ReadReply, error) {
+		return r.IndyVDRClient.GetAttrib(did, raw)
+	})
+}
+
+func (r *CachingClient) GetSchema(schemaID string) (*vdr.ReadReply, error) {
+	return r.cache.Read(SchemaRead, r.namespace+"|"+schemaID, func() (*vdr.ReadReply, error) {
+		return r.IndyVDRClient.GetSchema(schemaID)
+	})
+}
+
+func (r *CachingClient) GetCredDef(credDefID string) (*vdr.ReadReply, error) {
+	return r.cache.Read(CredDefRead, r.namespace+"|"+credDefID, func() (*vdr.ReadReply, error) {
+		return r.IndyVDRClient.GetCredDef(credDefID)
+	})
+}
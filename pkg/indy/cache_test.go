@@ -0,0 +1,138 @@
+package indy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hyperledger/indy-vdr/wrappers/golang/vdr"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scoir/canis/pkg/framework"
+	"github.com/scoir/canis/pkg/indy/mocks"
+)
+
+func TestCachingClient(t *testing.T) {
+	t.Run("cached reads", func(t *testing.T) {
+		client := &mocks.IndyVDRClient{}
+		client.On("GetNym", "did").Return(&vdr.ReadReply{Data: "nym"}, nil).Once()
+		client.On("GetSchema", "schema").Return(&vdr.ReadReply{Data: "schema"}, nil).Once()
+		client.On("GetCredDef", "creddef").Return(&vdr.ReadReply{Data: "creddef"}, nil).Once()
+		client.On("GetAttrib", "did", "diddocContent").Return(&vdr.ReadReply{Data: "attrib"}, nil).Once()
+
+		target := NewCachingClient(client, NewLedgerCache(nil), "")
+		for i := 0; i < 3; i++ {
+			rply, err := target.GetNym("did")
+			require.NoError(t, err)
+			require.Equal(t, "nym", rply.Data)
+
+			rply, err = target.GetSchema("schema")
+			require.NoError(t, err)
+			require.Equal(t, "schema", rply.Data)
+
+			rply, err = target.GetCredDef("creddef")
+			require.NoError(t, err)
+			require.Equal(t, "creddef", rply.Data)
+
+			rply, err = target.GetAttrib("did", "diddocContent")
+			require.NoError(t, err)
+			require.Equal(t, "attrib", rply.Data)
+		}
+
+		client.AssertExpectations(t)
+	})
+	t.Run("misses and errors not cached", func(t *testing.T) {
+		client := &mocks.IndyVDRClient{}
+		client.On("GetNym", "missing").Return(&vdr.ReadReply{}, nil).Twice()
+		client.On("GetNym", "broken").Return(nil, errors.New("boom")).Twice()
+
+		target := NewCachingClient(client, NewLedgerCache(nil), "")
+		for i := 0; i < 2; i++ {
+			_, err := target.GetNym("missing")
+			require.NoError(t, err)
+
+			_, err = target.GetNym("broken")
+			require.Error(t, err)
+		}
+
+		client.AssertExpectations(t)
+	})
+	t.Run("namespaces cached separately", func(t *testing.T) {
+		cache := NewLedgerCache(nil)
+		sovrin := &mocks.IndyVDRClient{}
+		sovrin.On("GetNym", "did").Return(&vdr.ReadReply{Data: "sovrin"}, nil).Once()
+		staging := &mocks.IndyVDRClient{}
+		staging.On("GetNym", "did").Return(&vdr.ReadReply{Data: "staging"}, nil).Once()
+
+		rply, err := NewCachingClient(sovrin, cache, "sovrin").GetNym("did")
+		require.NoError(t, err)
+		require.Equal(t, "sovrin", rply.Data)
+
+		rply, err = NewCachingClient(staging, cache, "sovrin:staging").GetNym("did")
+		require.NoError(t, err)
+		require.Equal(t, "staging", rply.Data)
+	})
+	t.Run("writes invalidate", func(t *testing.T) {
+		client := &mocks.IndyVDRClient{}
+		client.On("GetEndpoint", "did").Return(&vdr.ReadReply{Data: "endpoint"}, nil).Twice()
+		client.On("SetEndpoint", "did", "did", "https://example.com", nil).Return(nil)
+
+		target := NewCachingClient(client, NewLedgerCache(nil), "")
+		_, err := target.GetEndpoint("did")
+		require.NoError(t, err)
+
+		err = target.SetEndpoint("did", "did", "https://example.com", nil)
+		require.NoError(t, err)
+
+		_, err = target.GetEndpoint("did")
+		require.NoError(t, err)
+		client.AssertExpectations(t)
+	})
+}
+
+func TestLedgerCache(t *testing.T) {
+	fetch := func(data string) func() (*vdr.ReadReply, error) {
+		return func() (*vdr.ReadReply, error) {
+			return &vdr.ReadReply{Data: data}, nil
+		}
+	}
+
+	t.Run("expiry", func(t *testing.T) {
+		now := time.Now()
+		cache := NewLedgerCache(&framework.LedgerCacheConfig{Size: 10, NymTTL: time.Minute, SchemaTTL: time.Hour})
+		cache.now = func() time.Time { return now }
+
+		_, _ = cache.Read(NymRead, "a", fetch("1"))
+		_, _ = cache.Read(SchemaRead, "a", fetch("1"))
+
+		now = now.Add(2 * time.Minute)
+		rply, _ := cache.Read(NymRead, "a", fetch("2"))
+		require.Equal(t, "2", rply.Data)
+
+		rply, _ = cache.Read(SchemaRead, "a", fetch("2"))
+		require.Equal(t, "1", rply.Data)
+	})
+	t.Run("bounded", func(t *testing.T) {
+		cache := NewLedgerCache(&framework.LedgerCacheConfig{Size: 2, NymTTL: time.Minute})
+
+		_, _ = cache.Read(NymRead, "a", fetch("a"))
+		_, _ = cache.Read(NymRead, "b", fetch("b"))
+		_, _ = cache.Read(NymRead, "a", fetch("a"))
+		_, _ = cache.Read(NymRead, "c", fetch("c"))
+		require.Equal(t, 2, cache.Len())
+
+		rply, _ := cache.Read(NymRead, "a", fetch("new"))
+		require.Equal(t, "a", rply.Data)
+
+		rply, _ = cache.Read(NymRead, "b", fetch("new"))
+		require.Equal(t, "new", rply.Data)
+	})
+	t.Run("disabled type", func(t *testing.T) {
+		cache := NewLedgerCache(&framework.LedgerCacheConfig{Size: 2})
+
+		_, _ = cache.Read(AttribRead, "a", fetch("1"))
+		rply, _ := cache.Read(AttribRead, "a", fetch("2"))
+		require.Equal(t, "2", rply.Data)
+		require.Equal(t, 0, cache.Len())
+	})
+}
@@ -0,0 +1,231 @@
+package indy
+
+import (
+	"fmt"
+
+	"github.com/google/tink/go/keyset"
+	"github.com/google/tink/go/signature/subtle"
+	"github.com/hyperledger/aries-framework-go/pkg/kms"
+	"github.com/hyperledger/indy-vdr/wrappers/golang/vdr"
+	"github.com/pkg/errors"
+
+	"github.com/scoir/canis/pkg/datastore"
+)
+
+const (
+	trusteeRole = "0"
+	stewardRole = "2"
+	anyRole     = "*"
+)
+
+// ErrEndorsementPending is returned for writes that were queued for an external endorser
+var ErrEndorsementPending = errors.New("transaction queued for endorsement")
+
+// EndorsementStore provides the steward public DID and tracks endorsements
+type EndorsementStore interface {
+	GetLedgerPublicDID(ledger string) (*datastore.DID, error)
+	InsertEndorsement(e *datastore.Endorsement) (string, error)
+}
+
+// RequestSigner adds a signature to a request for multi-signature submission, returning the signed request
+type RequestSigner interface {
+	MultiSignRequest(req *vdr.Request, did string, signer vdr.Signer) ([]byte, error)
+}
+
+// EndorsingClient checks each ledger write against the ledger's auth rules and, when the author is not
+// permitted to write on its own, has the transaction endorsed.  If an external endorser DID is configured
+// the author signed transaction is queued for that endorser, otherwise the steward public DID endorses it.
+type EndorsingClient struct {
+	IndyVDRClient
+	store     EndorsementStore
+	kms       kms.KeyManager
+	endorser  string
+	namespace string
+}
+
+// NewEndorsingClient wraps client for the ledger with namespace with endorsement of writes by unprivileged
+// DIDs.  endorser is the DID of an external endorser, or empty to endorse with the steward public DID
+func NewEndorsingClient(client IndyVDRClient, store EndorsementStore, keyMgr kms.KeyManager, endorser, namespace string) *EndorsingClient {
+	return &EndorsingClient{
+		IndyVDRClient: client,
+		store:         store,
+		kms:           keyMgr,
+		endorser:      endorser,
+		namespace:     namespace,
+	}
+}
+
+func (r *EndorsingClient) SubmitWrite(req *vdr.Request, signer vdr.Signer) (*vdr.WriteReply, error) {
+	typ := txnType(req)
+	if r.permitted(req.Identifier, typ) {
+		return r.IndyVDRClient.SubmitWrite(req, signer)
+	}
+
+	ms, ok := r.IndyVDRClient.(RequestSigner)
+	if !ok {
+		ms = &MultiSigner{}
+	}
+
+	if r.endorser != "" {
+		return nil, r.queue(ms, req, typ, signer)
+	}
+
+	steward, err := r.store.GetLedgerPublicDID(r.namespace)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get steward public DID to endorse transaction")
+	}
+
+	stewardSigner, err := SignerForKey(r.kms, steward.KeyPair.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get signer for steward public DID")
+	}
+
+	req.Endorser = steward.DID.MethodID()
+	_, err = ms.MultiSignRequest(req, req.Identifier, signer)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to sign transaction as author")
+	}
+
+	d, err := ms.MultiSignRequest(req, req.Endorser, stewardSigner)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to sign transaction as endorser")
+	}
+
+	_, err = r.IndyVDRClient.Submit(d)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to submit endorsed transaction")
+	}
+
+	return &vdr.WriteReply{}, nil
+}
+
+func (r *EndorsingClient) queue(ms RequestSigner, req *vdr.Request, typ string, signer vdr.Signer) error {
+	req.Endorser = r.endorser
+	d, err := ms.MultiSignRequest(req, req.Identifier, signer)
+	if err != nil {
+		return errors.Wrap(err, "unable to sign transaction as author")
+	}
+
+	id, err := r.store.InsertEndorsement(&datastore.Endorsement{
+		TxnType:     typ,
+		AuthorDID:   req.Identifier,
+		EndorserDID: r.endorser,
+		Request:     string(d),
+		Status:      datastore.EndorsementPending,
+		Ledger:      r.namespace,
+	})
+	if err != nil {
+		return errors.Wrap(err, "unable to save transaction for endorsement")
+	}
+
+	return errors.Wrap(ErrEndorsementPending, id)
+}
+
+// permitted reports whether did can write a transaction of type typ without an endorser.  Any failure to
+// read the author's role or the auth rule is treated as not permitted so the write is endorsed
+func (r *EndorsingClient) permitted(did, typ string) bool {
+	if typ == "" {
+		return true
+	}
+
+	role, err := r.role(did)
+	if err != nil {
+		return false
+	}
+
+	if role == trusteeRole || role == stewardRole || role == vdr.EndorserRole {
+		return true
+	}
+
+	rply, err := r.IndyVDRClient.GetTxnTypeAuthRule(typ, "ADD", anyRole)
+	if err != nil {
+		return false
+	}
+
+	var rules []authRule
+	err = decodeReply(rply, &rules)
+	if err != nil || len(rules) == 0 {
+		return false
+	}
+
+	for _, rule := range rules {
+		if rule.Constraint.allows(role) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r *EndorsingClient) role(did string) (string, error) {
+	rply, err := r.IndyVDRClient.GetNym(did)
+	if err != nil {
+		return "", err
+	}
+
+	nym := struct {
+		Role string `json:"role"`
+	}{}
+	err = decodeReply(rply, &nym)
+	return nym.Role, err
+}
+
+type authRule struct {
+	Constraint authConstraint `json:"constraint"`
+}
+
+type authConstraint struct {
+	ConstraintID    string           `json:"constraint_id"`
+	Role            string           `json:"role"`
+	SigCount        int              `json:"sig_count"`
+	AuthConstraints []authConstraint `json:"auth_constraints"`
+}
+
+// allows evaluates whether a single signature from an author with role satisfies the constraint
+func (r authConstraint) allows(role string) bool {
+	switch r.ConstraintID {
+	case "OR":
+		for _, c := range r.AuthConstraints {
+			if c.allows(role) {
+				return true
+			}
+		}
+		return false
+	case "AND":
+		for _, c := range r.AuthConstraints {
+			if !c.allows(role) {
+				return false
+			}
+		}
+		return len(r.AuthConstraints) > 0
+	case "ROLE":
+		return r.SigCount <= 1 && (r.Role == anyRole || r.Role == role)
+	}
+
+	return false
+}
+
+func txnType(req *vdr.Request) string {
+	op, ok := req.Operation.(map[string]interface{})
+	if !ok {
+		return ""
+	}
+
+	return fmt.Sprintf("%v", op["type"])
+}
+
+// SignerForKey loads the ED25519 signer for a key held in keyMgr
+func SignerForKey(keyMgr kms.KeyManager, kid string) (vdr.Signer, error) {
+	kh, err := keyMgr.Get(kid)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get private key")
+	}
+
+	privKeyHandle := kh.(*keyset.Handle)
+	prim, err := privKeyHandle.Primitives()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to load signer primitives")
+	}
+
+	return prim.Primary.Primitive.(*subtle.ED25519Signer), nil
+}
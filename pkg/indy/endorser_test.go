@@ -0,0 +1,141 @@
+package indy
+
+import (
+	"testing"
+
+	"github.com/hyperledger/indy-vdr/wrappers/golang/vdr"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scoir/canis/pkg/datastore"
+	dmocks "github.com/scoir/canis/pkg/datastore/mocks"
+	"github.com/scoir/canis/pkg/indy/mocks"
+)
+
+type multiSignClient struct {
+	*mocks.IndyVDRClient
+	signedBy []string
+}
+
+func (r *multiSignClient) MultiSignRequest(req *vdr.Request, did string, _ vdr.Signer) ([]byte, error) {
+	r.signedBy = append(r.signedBy, did)
+	return []byte(`{"identifier":"` + req.Identifier + `","endorser":"` + req.Endorser + `"}`), nil
+}
+
+const restrictedSchemaRule = `[{"auth_type":"101","auth_action":"ADD","field":"*","constraint":{"constraint_id":"OR",
+"auth_constraints":[{"constraint_id":"ROLE","role":"0","sig_count":1},{"constraint_id":"ROLE","role":"101","sig_count":1}]}}]`
+
+func schemaRequest() *vdr.Request {
+	return &vdr.Request{
+		Identifier: "author",
+		Operation:  map[string]interface{}{"type": schemaTxn},
+	}
+}
+
+func TestAuthConstraint(t *testing.T) {
+	anyone := authConstraint{ConstraintID: "ROLE", Role: anyRole, SigCount: 1}
+	require.True(t, anyone.allows(""))
+
+	endorser := authConstraint{ConstraintID: "ROLE", Role: "101", SigCount: 1}
+	require.False(t, endorser.allows(""))
+	require.True(t, endorser.allows("101"))
+
+	twoStewards := authConstraint{ConstraintID: "ROLE", Role: "2", SigCount: 2}
+	require.False(t, twoStewards.allows("2"))
+
+	or := authConstraint{ConstraintID: "OR", AuthConstraints: []authConstraint{endorser, anyone}}
+	require.True(t, or.allows(""))
+
+	and := authConstraint{ConstraintID: "AND", AuthConstraints: []authConstraint{endorser, anyone}}
+	require.False(t, and.allows(""))
+}
+
+func TestEndorsingClient(t *testing.T) {
+	t.Run("permitted author", func(t *testing.T) {
+		client := &mocks.IndyVDRClient{}
+		client.On("GetNym", "author").Return(&vdr.ReadReply{Data: `{"role":"101"}`}, nil)
+		client.On("SubmitWrite", mock.Anything, nil).Return(&vdr.WriteReply{}, nil)
+
+		target := NewEndorsingClient(client, &dmocks.Store{}, nil, "", "")
+		_, err := target.SubmitWrite(schemaRequest(), nil)
+		require.NoError(t, err)
+		client.AssertExpectations(t)
+	})
+	t.Run("rule allows anyone", func(t *testing.T) {
+		client := &mocks.IndyVDRClient{}
+		client.On("GetNym", "author").Return(&vdr.ReadReply{Data: `{"role":null}`}, nil)
+		client.On("GetTxnTypeAuthRule", schemaTxn, "ADD", anyRole).Return(&vdr.ReadReply{
+			Data: `[{"constraint":{"constraint_id":"ROLE","role":"*","sig_count":1}}]`}, nil)
+		client.On("SubmitWrite", mock.Anything, nil).Return(&vdr.WriteReply{}, nil)
+
+		target := NewEndorsingClient(client, &dmocks.Store{}, nil, "", "")
+		_, err := target.SubmitWrite(schemaRequest(), nil)
+		require.NoError(t, err)
+		client.AssertExpectations(t)
+	})
+	t.Run("queued for external endorser", func(t *testing.T) {
+		client := &multiSignClient{IndyVDRClient: &mocks.IndyVDRClient{}}
+		client.On("GetNym", "author").Return(&vdr.ReadReply{Data: `{}`}, nil)
+		client.On("GetTxnTypeAuthRule", schemaTxn, "ADD", anyRole).Return(&vdr.ReadReply{Data: restrictedSchemaRule}, nil)
+
+		store := &dmocks.Store{}
+		match := func(e *datastore.Endorsement) bool {
+			return e.Status == datastore.EndorsementPending && e.EndorserDID == "external" && e.Ledger == "sovrin" &&
+				e.Request == `{"identifier":"author","endorser":"external"}`
+		}
+		store.On("InsertEndorsement", mock.MatchedBy(match)).Return("endorsement-id", nil)
+
+		target := NewEndorsingClient(client, store, nil, "external", "sovrin")
+		_, err := target.SubmitWrite(schemaRequest(), nil)
+		require.True(t, errors.Is(err, ErrEndorsementPending))
+		require.Contains(t, err.Error(), "endorsement-id")
+		require.Equal(t, []string{"author"}, client.signedBy)
+		client.AssertNotCalled(t, "SubmitWrite", mock.Anything, mock.Anything)
+	})
+	t.Run("queue failure", func(t *testing.T) {
+		client := &multiSignClient{IndyVDRClient: &mocks.IndyVDRClient{}}
+		client.On("GetNym", "author").Return(&vdr.ReadReply{Data: `{}`}, nil)
+		client.On("GetTxnTypeAuthRule", schemaTxn, "ADD", anyRole).Return(&vdr.ReadReply{Data: restrictedSchemaRule}, nil)
+
+		store := &dmocks.Store{}
+		store.On("InsertEndorsement", mock.Anything).Return("", errors.New("boom"))
+
+		target := NewEndorsingClient(client, store, nil, "external", "sovrin")
+		_, err := target.SubmitWrite(schemaRequest(), nil)
+		require.Error(t, err)
+		require.False(t, errors.Is(err, ErrEndorsementPending))
+	})
+	t.Run("client without multi signature", func(t *testing.T) {
+		client := &mocks.IndyVDRClient{}
+		client.On("GetNym", "author").Return(&vdr.ReadReply{Data: `{}`}, nil)
+		client.On("GetTxnTypeAuthRule", schemaTxn, "ADD", anyRole).Return(&vdr.ReadReply{Data: restrictedSchemaRule}, nil)
+
+		author := newTestSigner("00000000000000000000000000author")
+		store := &dmocks.Store{}
+		match := func(e *datastore.Endorsement) bool {
+			signed := decodeRequest(t, []byte(e.Request))
+			sigs, ok := signed["signatures"].(map[string]interface{})
+			return ok && author.verify([]byte(SignatureInput(signed)), sigs["author"].(string))
+		}
+		store.On("InsertEndorsement", mock.MatchedBy(match)).Return("endorsement-id", nil)
+
+		target := NewEndorsingClient(client, store, nil, "external", "sovrin")
+		_, err := target.SubmitWrite(schemaRequest(), author)
+		require.True(t, errors.Is(err, ErrEndorsementPending))
+		store.AssertExpectations(t)
+	})
+	t.Run("auth rule failure is endorsed", func(t *testing.T) {
+		client := &multiSignClient{IndyVDRClient: &mocks.IndyVDRClient{}}
+		client.On("GetNym", "author").Return(&vdr.ReadReply{Data: `{}`}, nil)
+		client.On("GetTxnTypeAuthRule", schemaTxn, "ADD", anyRole).Return(nil, errors.New("boom"))
+
+		store := &dmocks.Store{}
+		store.On("InsertEndorsement", mock.Anything).Return("endorsement-id", nil)
+
+		target := NewEndorsingClient(client, store, nil, "external", "sovrin")
+		_, err := target.SubmitWrite(schemaRequest(), nil)
+		require.True(t, errors.Is(err, ErrEndorsementPending))
+		client.AssertNotCalled(t, "SubmitWrite", mock.Anything, mock.Anything)
+	})
+}
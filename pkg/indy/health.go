@@ -0,0 +1,260 @@
+package indy
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/hyperledger/indy-vdr/wrappers/golang/vdr"
+	"github.com/pkg/errors"
+
+	"github.com/scoir/canis/pkg/framework"
+)
+
+// DefaultPoolRefreshConfig is used when no pool refresh is configured
+var DefaultPoolRefreshConfig = framework.PoolRefreshConfig{
+	Interval: 10 * time.Minute,
+	Jitter:   time.Minute,
+}
+
+// PoolHealth is the state of a ledger pool as seen by this process.  Reads and writes are those made
+// through the pool's client, including reads answered from the ledger cache
+type PoolHealth struct {
+	Namespace   string
+	Connected   bool
+	Validators  int
+	LastRefresh time.Time
+	LastRead    time.Time
+	LastWrite   time.Time
+	// LastError is the error from the most recent refresh, empty if it succeeded
+	LastError string
+}
+
+type health struct {
+	lock sync.Mutex
+	PoolHealth
+	refreshed bool
+}
+
+func (r *health) read(err error) {
+	if err == nil {
+		r.lock.Lock()
+		r.LastRead = time.Now()
+		r.lock.Unlock()
+	}
+}
+
+func (r *health) write(err error) {
+	if err == nil || errors.Is(err, ErrEndorsementPending) {
+		r.lock.Lock()
+		r.LastWrite = time.Now()
+		r.lock.Unlock()
+	}
+}
+
+func (r *health) setConnected(connected bool) {
+	r.lock.Lock()
+	r.Connected = connected
+	r.lock.Unlock()
+}
+
+func (r *health) snapshot() *PoolHealth {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	out := r.PoolHealth
+	return &out
+}
+
+// Monitor refreshes the validator list of every connected pool each interval, plus a random delay of up to
+// jitter so replicas do not refresh together, until the pools are closed
+func (r *Pools) Monitor(conf *framework.PoolRefreshConfig) {
+	if conf == nil {
+		conf = &DefaultPoolRefreshConfig
+	}
+
+	if conf.Interval <= 0 {
+		return
+	}
+
+	go func() {
+		for {
+			delay := conf.Interval
+			if conf.Jitter > 0 {
+				delay += time.Duration(rand.Int63n(int64(conf.Jitter)))
+			}
+
+			select {
+			case <-r.done:
+				return
+			case <-time.After(delay):
+				r.RefreshAll()
+			}
+		}
+	}()
+}
+
+// RefreshAll refreshes the validator list of every connected pool
+func (r *Pools) RefreshAll() {
+	r.lock.Lock()
+	clients := make(map[string]IndyVDRClient, len(r.clients))
+	for ns, cl := range r.clients {
+		clients[ns] = cl
+	}
+	r.lock.Unlock()
+
+	for ns, cl := range clients {
+		_ = r.refresh(ns, cl)
+	}
+}
+
+func (r *Pools) refresh(namespace string, cl IndyVDRClient) error {
+	h := r.healthOf(namespace)
+
+	err := cl.RefreshPool()
+	var st *vdr.PoolStatus
+	if err == nil {
+		st, err = cl.GetPoolStatus()
+	}
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	h.refreshed = true
+	h.LastRefresh = time.Now()
+	if err != nil {
+		h.LastError = err.Error()
+		return errors.Wrapf(err, "unable to refresh ledger %s", namespace)
+	}
+
+	h.LastError = ""
+	h.Validators = len(st.Nodes)
+	return nil
+}
+
+// Status returns the health of every configured pool, default first
+func (r *Pools) Status() []*PoolHealth {
+	out := make([]*PoolHealth, len(r.namespaces))
+	for i, ns := range r.namespaces {
+		out[i] = r.healthOf(ns).snapshot()
+	}
+
+	return out
+}
+
+// Ready returns an error if the default ledger can not be reached.  The default pool is connected and
+// refreshed if it has not been yet, otherwise the result of its last refresh is used
+func (r *Pools) Ready() error {
+	cl, err := r.Client("")
+	if err != nil {
+		return err
+	}
+
+	h := r.healthOf(r.Default())
+	h.lock.Lock()
+	refreshed, lastErr := h.refreshed, h.LastError
+	h.lock.Unlock()
+
+	if !refreshed || lastErr != "" {
+		return r.refresh(r.Default(), cl)
+	}
+
+	return nil
+}
+
+func (r *Pools) healthOf(namespace string) *health {
+	r.healthLock.Lock()
+	defer r.healthLock.Unlock()
+
+	h, ok := r.health[namespace]
+	if !ok {
+		h = &health{PoolHealth: PoolHealth{Namespace: namespace}}
+		r.health[namespace] = h
+	}
+
+	return h
+}
+
+// monitoredClient records the time of the last successful read and write made through the wrapped client
+type monitoredClient struct {
+	IndyVDRClient
+	health *health
+}
+
+func (r *monitoredClient) CreateClaimDef(from string, ref uint32, pubKey, revocation map[string]interface{}, signer vdr.Signer) (string, error) {
+	id, err := r.IndyVDRClient.CreateClaimDef(from, ref, pubKey, revocation, signer)
+	r.health.write(err)
+	return id, err
+}
+
+func (r *monitoredClient) CreateNym(did, verkey, role, from string, signer vdr.Signer) error {
+	err := r.IndyVDRClient.CreateNym(did, verkey, role, from, signer)
+	r.health.write(err)
+	return err
+}
+
+func (r *monitoredClient) CreateAttrib(did, from string, data map[string]interface{}, signer vdr.Signer) error {
+	err := r.IndyVDRClient.CreateAttrib(did, from, data, signer)
+	r.health.write(err)
+	return err
+}
+
+func (r *monitoredClient) SetEndpoint(did, from string, ep string, signer vdr.Signer) error {
+	err := r.IndyVDRClient.SetEndpoint(did, from, ep, signer)
+	r.health.write(err)
+	return err
+}
+
+func (r *monitoredClient) CreateSchema(issuerDID, name, version string, attrs []string, signer vdr.Signer) (string, error) {
+	id, err := r.IndyVDRClient.CreateSchema(issuerDID, name, version, attrs, signer)
+	r.health.write(err)
+	return id, err
+}
+
+func (r *monitoredClient) SubmitWrite(req *vdr.Request, signer vdr.Signer) (*vdr.WriteReply, error) {
+	rply, err := r.IndyVDRClient.SubmitWrite(req, signer)
+	r.health.write(err)
+	return rply, err
+}
+
+func (r *monitoredClient) Submit(request []byte) (*vdr.ReadReply, error) {
+	rply, err := r.IndyVDRClient.Submit(request)
+	r.health.write(err)
+	return rply, err
+}
+
+func (r *monitoredClient) GetNym(did string) (*vdr.ReadReply, error) {
+	rply, err := r.IndyVDRClient.GetNym(did)
+	r.health.read(err)
+	return rply, err
+}
+
+func (r *monitoredClient) GetEndpoint(did string) (*vdr.ReadReply, error) {
+	rply, err := r.IndyVDRClient.GetEndpoint(did)
+	r.health.read(err)
+	return rply, err
+}
+
+func (r *monitoredClient) GetAttrib(did, raw string) (*vdr.ReadReply, error) {
+	rply, err := r.IndyVDRClient.GetAttrib(did, raw)
+	r.health.read(err)
+	return rply, err
+}
+
+func (r *monitoredClient) GetSchema(schemaID string) (*vdr.ReadReply, error) {
+	rply, err := r.IndyVDRClient.GetSchema(schemaID)
+	r.health.read(err)
+	return rply, err
+}
+
+func (r *monitoredClient) GetCredDef(credDefID string) (*vdr.ReadReply, error) {
+	rply, err := r.IndyVDRClient.GetCredDef(credDefID)
+	r.health.read(err)
+	return rply, err
+}
+
+func (r *monitoredClient) GetTxnAuthorAgreement() (*vdr.ReadReply, error) {
+	rply, err := r.IndyVDRClient.GetTxnAuthorAgreement()
+	r.health.read(err)
+	return rply, err
+}
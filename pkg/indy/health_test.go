@@ -0,0 +1,84 @@
+package indy
+
+import (
+	"io"
+	"testing"
+
+	"github.com/hyperledger/indy-vdr/wrappers/golang/vdr"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scoir/canis/pkg/framework"
+	"github.com/scoir/canis/pkg/indy/mocks"
+)
+
+func TestPools_Health(t *testing.T) {
+	main := &mocks.IndyVDRClient{}
+	main.On("RefreshPool").Return(nil)
+	main.On("GetPoolStatus").Return(&vdr.PoolStatus{Nodes: []string{"Node1", "Node2", "Node3", "Node4"}}, nil)
+	main.On("GetNym", "did").Return(&vdr.ReadReply{}, nil)
+	main.On("CreateSchema", "did", "name", "1.0", []string{"a"}, nil).Return("", errors.New("boom"))
+
+	staging := &mocks.IndyVDRClient{}
+	staging.On("RefreshPool").Return(errors.New("unreachable"))
+
+	open := func(namespace string, _ io.ReadCloser) (IndyVDRClient, error) {
+		if namespace == "" {
+			return main, nil
+		}
+		return staging, nil
+	}
+
+	pools := NewPools([]*framework.LedgerConfig{
+		{Namespace: "sovrin", GenesisFile: "main"},
+		{Namespace: "sovrin:staging", GenesisFile: "staging"},
+		{Namespace: "sovrin:builder", GenesisFile: "builder"},
+	}, "", open)
+
+	require.NoError(t, pools.Ready())
+
+	cl, err := pools.Client("")
+	require.NoError(t, err)
+	_, err = cl.GetNym("did")
+	require.NoError(t, err)
+	_, err = cl.CreateSchema("did", "name", "1.0", []string{"a"}, nil)
+	require.Error(t, err)
+
+	_, err = pools.Client("sovrin:staging")
+	require.NoError(t, err)
+	pools.RefreshAll()
+
+	status := pools.Status()
+	require.Len(t, status, 3)
+
+	require.Equal(t, "sovrin", status[0].Namespace)
+	require.True(t, status[0].Connected)
+	require.Equal(t, 4, status[0].Validators)
+	require.False(t, status[0].LastRefresh.IsZero())
+	require.False(t, status[0].LastRead.IsZero())
+	require.True(t, status[0].LastWrite.IsZero())
+
+	require.True(t, status[1].Connected)
+	require.Equal(t, "unreachable", status[1].LastError)
+
+	require.False(t, status[2].Connected)
+	require.True(t, status[2].LastRefresh.IsZero())
+}
+
+func TestPools_Ready(t *testing.T) {
+	t.Run("unreachable", func(t *testing.T) {
+		cl := &mocks.IndyVDRClient{}
+		cl.On("RefreshPool").Return(errors.New("unreachable"))
+
+		pools := NewPools(nil, "genesis", func(string, io.ReadCloser) (IndyVDRClient, error) {
+			return cl, nil
+		})
+		require.Error(t, pools.Ready())
+	})
+	t.Run("connect fails", func(t *testing.T) {
+		pools := NewPools(nil, "genesis", func(string, io.ReadCloser) (IndyVDRClient, error) {
+			return nil, errors.New("boom")
+		})
+		require.Error(t, pools.Ready())
+	})
+}
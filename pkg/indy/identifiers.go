@@ -0,0 +1,103 @@
+package indy
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// IndyMethod is the DID method for namespace qualified Indy DIDs, did:indy:<namespace>:<id>
+	IndyMethod = "indy"
+	// SovMethod is the DID method used for the unnamed, single ledger configuration
+	SovMethod = "sov"
+
+	anoncredsPath = "anoncreds/v0"
+)
+
+// DIDMethod returns the method, including namespace, for DIDs written to the ledger identified by namespace
+func DIDMethod(namespace string) string {
+	if namespace == "" {
+		return SovMethod
+	}
+
+	return IndyMethod + ":" + namespace
+}
+
+// QualifyDID returns the fully qualified DID for the unqualified ledger identifier id
+func QualifyDID(namespace, id string) string {
+	return fmt.Sprintf("did:%s:%s", DIDMethod(namespace), id)
+}
+
+// ParseDID splits a did:indy or did:sov DID into its ledger namespace and unqualified identifier.  Namespaces
+// may have sub-namespaces, so the identifier is always the last segment.
+func ParseDID(did string) (string, string, error) {
+	parts := strings.Split(did, ":")
+	if len(parts) < 3 || parts[0] != "did" {
+		return "", "", errors.Errorf("invalid DID %s", did)
+	}
+
+	switch parts[1] {
+	case SovMethod:
+		return "", parts[len(parts)-1], nil
+	case IndyMethod:
+		if len(parts) < 4 {
+			return "", "", errors.Errorf("did:indy DID %s is missing a namespace", did)
+		}
+		return strings.Join(parts[2:len(parts)-1], ":"), parts[len(parts)-1], nil
+	}
+
+	return "", "", errors.Errorf("unsupported DID method %s", parts[1])
+}
+
+// SchemaID returns the schema ID for a schema written by did, qualified with namespace if there is one
+func SchemaID(namespace, did, name, version string) string {
+	if namespace == "" {
+		return fmt.Sprintf("%s:2:%s:%s", did, name, version)
+	}
+
+	return fmt.Sprintf("%s/%s/SCHEMA/%s/%s", QualifyDID(namespace, did), anoncredsPath, name, version)
+}
+
+// CredDefID returns the credential definition ID for a CL cred def written by did, qualified with
+// namespace if there is one
+func CredDefID(namespace, did string, schemaSeqNo uint32, tag string) string {
+	if namespace == "" {
+		return fmt.Sprintf("%s:3:CL:%d:%s", did, schemaSeqNo, tag)
+	}
+
+	return fmt.Sprintf("%s/%s/CLAIM_DEF/%d/%s", QualifyDID(namespace, did), anoncredsPath, schemaSeqNo, tag)
+}
+
+// LedgerID splits a schema or cred def ID into the namespace of the ledger it is on and the legacy
+// unqualified ID the ledger understands.  Unqualified IDs are on the default ledger.
+func LedgerID(id string) (string, string, error) {
+	if !strings.HasPrefix(id, "did:") {
+		return "", id, nil
+	}
+
+	i := strings.Index(id, "/")
+	if i < 0 {
+		return "", "", errors.Errorf("invalid ledger object ID %s", id)
+	}
+
+	namespace, did, err := ParseDID(id[:i])
+	if err != nil {
+		return "", "", err
+	}
+
+	path := strings.Split(strings.TrimPrefix(id[i+1:], anoncredsPath+"/"), "/")
+	if len(path) != 3 {
+		return "", "", errors.Errorf("invalid ledger object ID %s", id)
+	}
+
+	switch path[0] {
+	case "SCHEMA":
+		return namespace, fmt.Sprintf("%s:2:%s:%s", did, path[1], path[2]), nil
+	case "CLAIM_DEF":
+		return namespace, fmt.Sprintf("%s:3:CL:%s:%s", did, path[1], path[2]), nil
+	}
+
+	return "", "", errors.Errorf("unsupported ledger object type %s", path[0])
+}
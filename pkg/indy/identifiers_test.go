@@ -0,0 +1,65 @@
+package indy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDID(t *testing.T) {
+	ns, id, err := ParseDID("did:sov:WgWxqztrNooG92RXvxSTWv")
+	require.NoError(t, err)
+	require.Equal(t, "", ns)
+	require.Equal(t, "WgWxqztrNooG92RXvxSTWv", id)
+
+	ns, id, err = ParseDID("did:indy:sovrin:staging:WgWxqztrNooG92RXvxSTWv")
+	require.NoError(t, err)
+	require.Equal(t, "sovrin:staging", ns)
+	require.Equal(t, "WgWxqztrNooG92RXvxSTWv", id)
+
+	_, _, err = ParseDID("did:indy:WgWxqztrNooG92RXvxSTWv")
+	require.Error(t, err)
+
+	_, _, err = ParseDID("did:peer:WgWxqztrNooG92RXvxSTWv")
+	require.Error(t, err)
+}
+
+func TestLedgerIDs(t *testing.T) {
+	t.Run("default ledger", func(t *testing.T) {
+		require.Equal(t, "did:sov:abc", QualifyDID("", "abc"))
+
+		id := SchemaID("", "abc", "degree", "1.0")
+		require.Equal(t, "abc:2:degree:1.0", id)
+
+		ns, legacy, err := LedgerID(id)
+		require.NoError(t, err)
+		require.Equal(t, "", ns)
+		require.Equal(t, id, legacy)
+
+		require.Equal(t, "abc:3:CL:23:default", CredDefID("", "abc", 23, "default"))
+	})
+	t.Run("namespaced", func(t *testing.T) {
+		id := SchemaID("sovrin:staging", "abc", "degree", "1.0")
+		require.Equal(t, "did:indy:sovrin:staging:abc/anoncreds/v0/SCHEMA/degree/1.0", id)
+
+		ns, legacy, err := LedgerID(id)
+		require.NoError(t, err)
+		require.Equal(t, "sovrin:staging", ns)
+		require.Equal(t, "abc:2:degree:1.0", legacy)
+
+		id = CredDefID("sovrin", "abc", 23, "default")
+		require.Equal(t, "did:indy:sovrin:abc/anoncreds/v0/CLAIM_DEF/23/default", id)
+
+		ns, legacy, err = LedgerID(id)
+		require.NoError(t, err)
+		require.Equal(t, "sovrin", ns)
+		require.Equal(t, "abc:3:CL:23:default", legacy)
+	})
+	t.Run("invalid", func(t *testing.T) {
+		_, _, err := LedgerID("did:indy:sovrin:abc")
+		require.Error(t, err)
+
+		_, _, err = LedgerID("did:indy:sovrin:abc/anoncreds/v0/REV_REG_DEF/1/2")
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,120 @@
+package indy
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/btcsuite/btcutil/base58"
+	"github.com/hyperledger/indy-vdr/wrappers/golang/vdr"
+	"github.com/pkg/errors"
+)
+
+const getAttribTxn = "104"
+
+// MultiSigner collects the signatures of a single request for multi-signature submission.  It is used
+// with ledger clients, like *vdr.Client, that can not multi-sign requests themselves
+type MultiSigner struct {
+	signatures map[string]string
+}
+
+var _ RequestSigner = (*MultiSigner)(nil)
+
+// MultiSignRequest adds did's signature to req and returns the request with every signature so far
+func (r *MultiSigner) MultiSignRequest(req *vdr.Request, did string, signer vdr.Signer) ([]byte, error) {
+	if signer == nil {
+		return nil, errors.New("a signer is required to sign a request")
+	}
+
+	d, err := json.Marshal(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to marshal request")
+	}
+
+	body := map[string]interface{}{}
+	dec := json.NewDecoder(bytes.NewReader(d))
+	dec.UseNumber()
+	err = dec.Decode(&body)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to decode request")
+	}
+
+	if r.signatures == nil {
+		r.signatures = map[string]string{}
+	}
+
+	if sig, ok := body["signature"].(string); ok && sig != "" {
+		r.signatures[req.Identifier] = sig
+	}
+	delete(body, "signature")
+
+	sig, err := signer.Sign([]byte(SignatureInput(body)))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to sign request")
+	}
+	r.signatures[did] = base58.Encode(sig)
+
+	body["signatures"] = r.signatures
+	d, err = json.Marshal(body)
+	return d, errors.Wrap(err, "unable to marshal signed request")
+}
+
+// SignatureInput serializes a request for signing the way Indy ledgers do, keys are sorted and
+// joined as key:value with |, signatures and fees are skipped and attrib values are hashed
+func SignatureInput(req map[string]interface{}) string {
+	typ := ""
+	if op, ok := req["operation"].(map[string]interface{}); ok {
+		typ = fmt.Sprintf("%v", op["type"])
+	}
+
+	return serializeSignature(req, true, typ)
+}
+
+func serializeSignature(v interface{}, topLevel bool, typ string) string {
+	switch val := v.(type) {
+	case bool:
+		if val {
+			return "True"
+		}
+		return "False"
+	case json.Number:
+		return val.String()
+	case float64:
+		return fmt.Sprintf("%v", val)
+	case string:
+		return val
+	case []interface{}:
+		parts := make([]string, len(val))
+		for i, el := range val {
+			parts[i] = serializeSignature(el, false, typ)
+		}
+		return strings.Join(parts, ",")
+	case map[string]interface{}:
+		keys := make([]string, 0, len(val))
+		for k := range val {
+			if topLevel && (k == "signature" || k == "signatures" || k == "fees") {
+				continue
+			}
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		parts := make([]string, len(keys))
+		for i, k := range keys {
+			el := val[k]
+			if (typ == attribTxn || typ == getAttribTxn) && (k == "raw" || k == "hash" || k == "enc") {
+				s, _ := el.(string)
+				h := sha256.Sum256([]byte(s))
+				el = hex.EncodeToString(h[:])
+			}
+			parts[i] = k + ":" + serializeSignature(el, false, typ)
+		}
+		return strings.Join(parts, "|")
+	}
+
+	return ""
+}
@@ -0,0 +1,80 @@
+package indy
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/json"
+	"testing"
+
+	"github.com/btcsuite/btcutil/base58"
+	"github.com/hyperledger/indy-vdr/wrappers/golang/vdr"
+	"github.com/stretchr/testify/require"
+)
+
+type testSigner struct {
+	priv ed25519.PrivateKey
+}
+
+func newTestSigner(seed string) *testSigner {
+	return &testSigner{priv: ed25519.NewKeyFromSeed([]byte(seed))}
+}
+
+func (r *testSigner) Sign(msg []byte) ([]byte, error) {
+	return ed25519.Sign(r.priv, msg), nil
+}
+
+func (r *testSigner) verify(msg []byte, sig string) bool {
+	return ed25519.Verify(r.priv.Public().(ed25519.PublicKey), msg, base58.Decode(sig))
+}
+
+func decodeRequest(t *testing.T, d []byte) map[string]interface{} {
+	out := map[string]interface{}{}
+	dec := json.NewDecoder(bytes.NewReader(d))
+	dec.UseNumber()
+	require.NoError(t, dec.Decode(&out))
+	return out
+}
+
+func TestSignatureInput(t *testing.T) {
+	msg := decodeRequest(t, []byte(`{"name": "John Doe", "age": 43, "operation": {"dest": 54},
+		"phones": ["1234567", "2345678", {"rust": 5, "age": 1}, 3]}`))
+	require.Equal(t, "age:43|name:John Doe|operation:dest:54|phones:1234567,2345678,age:1|rust:5,3", SignatureInput(msg))
+
+	msg = decodeRequest(t, []byte(`{"name": "John Doe", "age": 43, "operation": {"dest": 54},
+		"fees": "fees1", "signature": "sign1", "signatures": "sign-m"}`))
+	require.Equal(t, "age:43|name:John Doe|operation:dest:54", SignatureInput(msg))
+
+	msg = decodeRequest(t, []byte(`{"name": "John Doe", "age": 43, "operation": {"type": "100", "hash": "cool hash", "dest": 54}}`))
+	require.Equal(t, "age:43|name:John Doe|operation:dest:54|hash:46aa0c92129b33ee72ee1478d2ae62fa6e756869dedc6c858af3214a6fcf1904|type:100",
+		SignatureInput(msg))
+}
+
+func TestMultiSigner(t *testing.T) {
+	author := newTestSigner("00000000000000000000000000author")
+	endorser := newTestSigner("000000000000000000000000endorser")
+	req := &vdr.Request{
+		Identifier: "author",
+		Endorser:   "endorser",
+		ReqID:      12345,
+		Operation:  map[string]interface{}{"type": schemaTxn},
+	}
+
+	target := &MultiSigner{}
+	_, err := target.MultiSignRequest(req, "author", author)
+	require.NoError(t, err)
+
+	d, err := target.MultiSignRequest(req, "endorser", endorser)
+	require.NoError(t, err)
+
+	signed := decodeRequest(t, d)
+	sigs, ok := signed["signatures"].(map[string]interface{})
+	require.True(t, ok)
+	require.Len(t, sigs, 2)
+
+	msg := []byte(SignatureInput(signed))
+	require.True(t, author.verify(msg, sigs["author"].(string)))
+	require.True(t, endorser.verify(msg, sigs["endorser"].(string)))
+
+	_, err = target.MultiSignRequest(req, "author", nil)
+	require.Error(t, err)
+}
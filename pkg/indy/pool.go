@@ -0,0 +1,122 @@
+package indy
+
+import (
+	"io"
+	"io/ioutil"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/scoir/canis/pkg/framework"
+)
+
+// OpenFunc connects to the ledger described by genesis, wrapping the client as needed for namespace.  The
+// default ledger is opened with an empty namespace, matching how records scoped to it are stored.
+type OpenFunc func(namespace string, genesis io.ReadCloser) (IndyVDRClient, error)
+
+// Pools holds a client for each configured Indy network, keyed by did:indy namespace.  Clients are
+// connected on first use.  The first ledger configured is the default, used for unqualified identifiers.
+type Pools struct {
+	lock       sync.Mutex
+	open       OpenFunc
+	genesis    map[string]string
+	clients    map[string]IndyVDRClient
+	namespaces []string
+	healthLock sync.Mutex
+	health     map[string]*health
+	done       chan struct{}
+}
+
+// NewPools creates pools for the configured ledgers.  When no ledgers are configured the single legacy
+// genesis file is used as an unnamed default pool.
+func NewPools(ledgers []*framework.LedgerConfig, genesis string, open OpenFunc) *Pools {
+	out := &Pools{
+		open:    open,
+		genesis: map[string]string{},
+		clients: map[string]IndyVDRClient{},
+		health:  map[string]*health{},
+		done:    make(chan struct{}),
+	}
+
+	if len(ledgers) == 0 {
+		ledgers = []*framework.LedgerConfig{{GenesisFile: genesis}}
+	}
+
+	for _, l := range ledgers {
+		out.genesis[l.Namespace] = l.GenesisFile
+		out.namespaces = append(out.namespaces, l.Namespace)
+	}
+
+	return out
+}
+
+// Default returns the namespace of the default ledger, empty for the unnamed legacy pool
+func (r *Pools) Default() string {
+	return r.namespaces[0]
+}
+
+// Namespaces returns the namespaces of all configured ledgers, default first
+func (r *Pools) Namespaces() []string {
+	return r.namespaces
+}
+
+// Client returns the client for the ledger with namespace, or the default ledger if namespace is empty
+func (r *Pools) Client(namespace string) (IndyVDRClient, error) {
+	if namespace == "" {
+		namespace = r.Default()
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if cl, ok := r.clients[namespace]; ok {
+		return cl, nil
+	}
+
+	genesis, ok := r.genesis[namespace]
+	if !ok {
+		return nil, errors.Errorf("no ledger configured for namespace %s", namespace)
+	}
+
+	scope := namespace
+	if namespace == r.Default() {
+		scope = ""
+	}
+
+	cl, err := r.open(scope, ioutil.NopCloser(strings.NewReader(genesis)))
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to connect to ledger %s", namespace)
+	}
+
+	h := r.healthOf(namespace)
+	h.setConnected(true)
+
+	cl = &monitoredClient{IndyVDRClient: cl, health: h}
+	r.clients[namespace] = cl
+	return cl, nil
+}
+
+// Close closes all connected clients and stops refreshing them
+func (r *Pools) Close() error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	select {
+	case <-r.done:
+	default:
+		close(r.done)
+	}
+
+	var out error
+	for ns, cl := range r.clients {
+		err := cl.Close()
+		if err != nil && out == nil {
+			out = errors.Wrapf(err, "unable to close ledger %s", ns)
+		}
+		delete(r.clients, ns)
+		r.healthOf(ns).setConnected(false)
+	}
+
+	return out
+}
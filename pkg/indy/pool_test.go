@@ -0,0 +1,72 @@
+package indy
+
+import (
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scoir/canis/pkg/framework"
+	"github.com/scoir/canis/pkg/indy/mocks"
+)
+
+func TestPools(t *testing.T) {
+	t.Run("legacy genesis", func(t *testing.T) {
+		opened := map[string]string{}
+		open := func(namespace string, genesis io.ReadCloser) (IndyVDRClient, error) {
+			d, _ := ioutil.ReadAll(genesis)
+			opened[namespace] = string(d)
+			return &mocks.IndyVDRClient{}, nil
+		}
+
+		pools := NewPools(nil, "legacy genesis", open)
+		require.Equal(t, "", pools.Default())
+
+		cl, err := pools.Client("")
+		require.NoError(t, err)
+		require.NotNil(t, cl)
+		require.Equal(t, "legacy genesis", opened[""])
+	})
+	t.Run("named ledgers", func(t *testing.T) {
+		var opened []string
+		open := func(namespace string, genesis io.ReadCloser) (IndyVDRClient, error) {
+			opened = append(opened, namespace)
+			cl := &mocks.IndyVDRClient{}
+			cl.On("Close").Return(nil)
+			return cl, nil
+		}
+
+		pools := NewPools([]*framework.LedgerConfig{
+			{Namespace: "sovrin", GenesisFile: "main"},
+			{Namespace: "sovrin:staging", GenesisFile: "staging"},
+		}, "ignored", open)
+		require.Equal(t, "sovrin", pools.Default())
+		require.Equal(t, []string{"sovrin", "sovrin:staging"}, pools.Namespaces())
+
+		def, err := pools.Client("")
+		require.NoError(t, err)
+		main, err := pools.Client("sovrin")
+		require.NoError(t, err)
+		require.Same(t, def, main)
+
+		_, err = pools.Client("sovrin:staging")
+		require.NoError(t, err)
+		require.Equal(t, []string{"", "sovrin:staging"}, opened)
+
+		_, err = pools.Client("sovrin:builder")
+		require.Error(t, err)
+
+		require.NoError(t, pools.Close())
+	})
+	t.Run("connect fails", func(t *testing.T) {
+		open := func(namespace string, genesis io.ReadCloser) (IndyVDRClient, error) {
+			return nil, errors.New("boom")
+		}
+
+		pools := NewPools(nil, "legacy genesis", open)
+		_, err := pools.Client("")
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,47 @@
+package indy
+
+import (
+	"github.com/hyperledger/indy-vdr/wrappers/golang/vdr"
+	"github.com/pkg/errors"
+)
+
+// ErrSchemaNotFound is returned by GetSchema when the ledger has no schema with the requested ID
+var ErrSchemaNotFound = errors.New("schema not found on ledger")
+
+// Schema is a schema as written to an Indy ledger
+type Schema struct {
+	Name      string   `json:"name"`
+	Version   string   `json:"version"`
+	AttrNames []string `json:"attr_names"`
+	SeqNo     uint32   `json:"-"`
+}
+
+// SchemaReader reads schemas from an Indy ledger
+type SchemaReader interface {
+	GetSchema(schemaID string) (*vdr.ReadReply, error)
+}
+
+// GetSchema reads the schema with the unqualified ledger ID schemaID, returning ErrSchemaNotFound if there is none
+func GetSchema(client SchemaReader, schemaID string) (*Schema, error) {
+	rply, err := client.GetSchema(schemaID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to get schema %s from ledger", schemaID)
+	}
+
+	if rply == nil || rply.Data == nil || rply.SeqNo == 0 {
+		return nil, ErrSchemaNotFound
+	}
+
+	out := &Schema{}
+	err = decodeReply(rply, out)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid schema %s reply from ledger", schemaID)
+	}
+
+	if out.Name == "" || len(out.AttrNames) == 0 {
+		return nil, errors.Errorf("schema %s on ledger has no name or attributes", schemaID)
+	}
+
+	out.SeqNo = rply.SeqNo
+	return out, nil
+}
@@ -0,0 +1,45 @@
+package indy
+
+import (
+	"testing"
+
+	"github.com/hyperledger/indy-vdr/wrappers/golang/vdr"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scoir/canis/pkg/indy/mocks"
+)
+
+func TestGetSchema(t *testing.T) {
+	id := "WgWxqztrNooG92RXvxSTWv:2:degree:1.0"
+
+	t.Run("found", func(t *testing.T) {
+		client := &mocks.IndyVDRClient{}
+		client.On("GetSchema", id).Return(&vdr.ReadReply{
+			SeqNo: 42,
+			Data:  map[string]interface{}{"name": "degree", "version": "1.0", "attr_names": []interface{}{"name", "gpa"}},
+		}, nil)
+
+		s, err := GetSchema(client, id)
+		require.NoError(t, err)
+		require.Equal(t, "degree", s.Name)
+		require.Equal(t, "1.0", s.Version)
+		require.Equal(t, []string{"name", "gpa"}, s.AttrNames)
+		require.Equal(t, uint32(42), s.SeqNo)
+	})
+	t.Run("not found", func(t *testing.T) {
+		client := &mocks.IndyVDRClient{}
+		client.On("GetSchema", id).Return(&vdr.ReadReply{}, nil)
+
+		_, err := GetSchema(client, id)
+		require.True(t, errors.Is(err, ErrSchemaNotFound))
+	})
+	t.Run("ledger error", func(t *testing.T) {
+		client := &mocks.IndyVDRClient{}
+		client.On("GetSchema", id).Return(nil, errors.New("boom"))
+
+		_, err := GetSchema(client, id)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "boom")
+	})
+}
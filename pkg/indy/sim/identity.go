@@ -0,0 +1,44 @@
+package sim
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+
+	"github.com/btcsuite/btcutil/base58"
+)
+
+// Identity is an ED25519 key pair and the Indy DID derived from it, usable as the vdr.Signer for writes
+type Identity struct {
+	DID    string
+	Verkey string
+	priv   ed25519.PrivateKey
+}
+
+// NewIdentity derives an identity from seed.  Seeds that are not 32 bytes long are hashed, so any string
+// gives a stable identity
+func NewIdentity(seed string) *Identity {
+	s := []byte(seed)
+	if len(s) != ed25519.SeedSize {
+		h := sha256.Sum256(s)
+		s = h[:]
+	}
+
+	priv := ed25519.NewKeyFromSeed(s)
+	pub := priv.Public().(ed25519.PublicKey)
+
+	return &Identity{
+		DID:    base58.Encode(pub[:16]),
+		Verkey: base58.Encode(pub),
+		priv:   priv,
+	}
+}
+
+// AbbreviatedVerkey returns the verkey in the abbreviated form accepted by the ledger for a DID derived from it
+func (r *Identity) AbbreviatedVerkey() string {
+	pub := r.priv.Public().(ed25519.PublicKey)
+	return "~" + base58.Encode(pub[16:])
+}
+
+func (r *Identity) Sign(msg []byte) ([]byte, error) {
+	return ed25519.Sign(r.priv, msg), nil
+}
@@ -0,0 +1,663 @@
+package sim
+
+import (
+	"crypto/ed25519"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcutil/base58"
+	"github.com/hyperledger/indy-vdr/wrappers/golang/vdr"
+	"github.com/pkg/errors"
+
+	"github.com/scoir/canis/pkg/indy"
+)
+
+const (
+	nymTxn      = "1"
+	attribTxn   = "100"
+	schemaTxn   = "101"
+	claimDefTxn = "102"
+
+	trusteeRole = "0"
+	stewardRole = "2"
+)
+
+// Ledger is an in-memory Indy ledger.  Writes must be signed by their author, and by the endorser when one
+// is named, with signatures checked against the verkeys on the signers' NYMs.  NYM, SCHEMA and CLAIM_DEF
+// writes are limited to trustees, stewards and endorsers and ATTRIBs to the owner of the NYM, as on a
+// default configured network.  Signatures cover the JSON encoding of the request, not the ledger's own
+// serialization, so requests signed for a Ledger are not valid on a real network.
+type Ledger struct {
+	lock     sync.RWMutex
+	seqNo    uint32
+	now      func() time.Time
+	nyms     map[string]*nym
+	attribs  map[string]map[string]*txn
+	schemas  map[string]*txn
+	refs     map[uint32]bool
+	credDefs map[string]*txn
+	pending  map[uint32]map[string]string
+	taa      *indy.TAA
+	aml      *indy.AML
+	genesis  []string
+}
+
+type nym struct {
+	Identifier string `json:"identifier"`
+	Dest       string `json:"dest"`
+	Verkey     string `json:"verkey"`
+	Role       string `json:"role,omitempty"`
+	SeqNo      uint32 `json:"seqNo"`
+	TxnTime    uint32 `json:"txnTime"`
+}
+
+type txn struct {
+	data    interface{}
+	seqNo   uint32
+	txnTime uint32
+}
+
+// signedRequest is the multi-signed form of a request returned by MultiSignRequest and accepted by Submit
+type signedRequest struct {
+	Request    *vdr.Request      `json:"request"`
+	Signatures map[string]string `json:"signatures"`
+}
+
+var _ indy.IndyVDRClient = (*Ledger)(nil)
+var _ indy.RequestSigner = (*Ledger)(nil)
+
+// New returns an empty ledger with the genesis NYMs given by opts
+func New(opts ...Option) *Ledger {
+	out := &Ledger{
+		now:      time.Now,
+		nyms:     map[string]*nym{},
+		attribs:  map[string]map[string]*txn{},
+		schemas:  map[string]*txn{},
+		refs:     map[uint32]bool{},
+		credDefs: map[string]*txn{},
+		pending:  map[uint32]map[string]string{},
+	}
+
+	for _, opt := range opts {
+		opt(out)
+	}
+
+	return out
+}
+
+func (r *Ledger) CreateNym(did, verkey, role, from string, signer vdr.Signer) error {
+	op := map[string]interface{}{
+		"type":   nymTxn,
+		"dest":   did,
+		"verkey": verkey,
+	}
+	if role != "" && role != vdr.NoRole {
+		op["role"] = role
+	}
+
+	_, err := r.SubmitWrite(request(from, op), signer)
+	return errors.Wrap(err, "unable to create nym")
+}
+
+func (r *Ledger) CreateAttrib(did, from string, data map[string]interface{}, signer vdr.Signer) error {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return errors.Wrap(err, "unable to marshal attrib")
+	}
+
+	op := map[string]interface{}{
+		"type": attribTxn,
+		"dest": did,
+		"raw":  string(raw),
+	}
+
+	_, err = r.SubmitWrite(request(from, op), signer)
+	return errors.Wrap(err, "unable to create attrib")
+}
+
+func (r *Ledger) SetEndpoint(did, from string, ep string, signer vdr.Signer) error {
+	data := map[string]interface{}{
+		"endpoint": map[string]interface{}{
+			"endpoint": ep,
+		},
+	}
+
+	return r.CreateAttrib(did, from, data, signer)
+}
+
+func (r *Ledger) CreateSchema(issuerDID, name, version string, attrs []string, signer vdr.Signer) (string, error) {
+	op := map[string]interface{}{
+		"type": schemaTxn,
+		"data": map[string]interface{}{
+			"name":       name,
+			"version":    version,
+			"attr_names": attrs,
+		},
+	}
+
+	_, err := r.SubmitWrite(request(issuerDID, op), signer)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to create schema")
+	}
+
+	return fmt.Sprintf("%s:2:%s:%s", issuerDID, name, version), nil
+}
+
+func (r *Ledger) CreateClaimDef(from string, ref uint32, pubKey, revocation map[string]interface{}, signer vdr.Signer) (string, error) {
+	data := map[string]interface{}{
+		"primary": pubKey,
+	}
+	if revocation != nil {
+		data["revocation"] = revocation
+	}
+
+	op := map[string]interface{}{
+		"type":           claimDefTxn,
+		"ref":            ref,
+		"signature_type": "CL",
+		"tag":            "default",
+		"data":           data,
+	}
+
+	_, err := r.SubmitWrite(request(from, op), signer)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to create claim def")
+	}
+
+	return fmt.Sprintf("%s:3:CL:%d:default", from, ref), nil
+}
+
+func (r *Ledger) SubmitWrite(req *vdr.Request, signer vdr.Signer) (*vdr.WriteReply, error) {
+	sig, err := sign(req, signer)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = r.write(req, map[string]string{req.Identifier: sig})
+	if err != nil {
+		return nil, err
+	}
+
+	return &vdr.WriteReply{}, nil
+}
+
+// MultiSignRequest adds did's signature to the signatures collected for req, returning the request with
+// every signature so far for Submit
+func (r *Ledger) MultiSignRequest(req *vdr.Request, did string, signer vdr.Signer) ([]byte, error) {
+	sig, err := sign(req, signer)
+	if err != nil {
+		return nil, err
+	}
+
+	r.lock.Lock()
+	sigs, ok := r.pending[req.ReqID]
+	if !ok {
+		sigs = map[string]string{}
+		r.pending[req.ReqID] = sigs
+	}
+	sigs[did] = sig
+	d, err := json.Marshal(&signedRequest{Request: req, Signatures: sigs})
+	r.lock.Unlock()
+
+	return d, errors.Wrap(err, "unable to marshal signed request")
+}
+
+// Submit writes a request signed with MultiSignRequest.  The reply has the sequence number and time of the
+// new transaction
+func (r *Ledger) Submit(request []byte) (*vdr.ReadReply, error) {
+	signed := &signedRequest{}
+	err := json.Unmarshal(request, signed)
+	if err != nil || signed.Request == nil {
+		return nil, errors.New("unsupported request, only multi-signed writes can be submitted")
+	}
+
+	t, err := r.write(signed.Request, signed.Signatures)
+	if err != nil {
+		return nil, err
+	}
+
+	r.lock.Lock()
+	delete(r.pending, signed.Request.ReqID)
+	r.lock.Unlock()
+
+	return &vdr.ReadReply{SeqNo: t.seqNo, TxnTime: t.txnTime}, nil
+}
+
+func (r *Ledger) write(req *vdr.Request, sigs map[string]string) (*txn, error) {
+	op := map[string]interface{}{}
+	d, _ := json.Marshal(req.Operation)
+	err := json.Unmarshal(d, &op)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid operation")
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	err = r.verify(req, sigs)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.checkTAA(req)
+	if err != nil {
+		return nil, err
+	}
+
+	typ := str(op["type"])
+	writer := req.Identifier
+	if req.Endorser != "" {
+		writer = req.Endorser
+	}
+
+	switch typ {
+	case nymTxn:
+		return r.writeNym(req.Identifier, writer, op)
+	case attribTxn:
+		return r.writeAttrib(req.Identifier, op)
+	case schemaTxn:
+		return r.writeSchema(req.Identifier, writer, op)
+	case claimDefTxn:
+		return r.writeClaimDef(req.Identifier, writer, op)
+	}
+
+	return nil, errors.Errorf("unsupported transaction type %s", typ)
+}
+
+func (r *Ledger) writeNym(author, writer string, op map[string]interface{}) (*txn, error) {
+	dest := str(op["dest"])
+	if dest == "" {
+		return nil, errors.New("nym dest is required")
+	}
+
+	role := str(op["role"])
+	existing, ok := r.nyms[dest]
+	if ok {
+		if author != dest && author != existing.Identifier && r.role(author) != trusteeRole {
+			return nil, errors.Errorf("%s is not permitted to update nym %s", author, dest)
+		}
+		if _, set := op["role"]; !set {
+			role = existing.Role
+		} else if role != existing.Role && r.role(writer) != trusteeRole {
+			return nil, errors.Errorf("%s is not permitted to change the role of %s", writer, dest)
+		}
+	} else {
+		if !r.privileged(writer) {
+			return nil, errors.Errorf("%s is not permitted to create nyms", writer)
+		}
+		if (role == trusteeRole || role == stewardRole) && r.role(writer) != trusteeRole {
+			return nil, errors.Errorf("%s is not permitted to create nyms with role %s", writer, role)
+		}
+	}
+
+	verkey := str(op["verkey"])
+	if verkey == "" && ok {
+		verkey = existing.Verkey
+	}
+
+	t := r.next(nil)
+	r.nyms[dest] = &nym{
+		Identifier: author,
+		Dest:       dest,
+		Verkey:     verkey,
+		Role:       role,
+		SeqNo:      t.seqNo,
+		TxnTime:    t.txnTime,
+	}
+
+	return t, nil
+}
+
+func (r *Ledger) writeAttrib(author string, op map[string]interface{}) (*txn, error) {
+	dest := str(op["dest"])
+	n, ok := r.nyms[dest]
+	if !ok {
+		return nil, errors.Errorf("unknown nym %s", dest)
+	}
+
+	if author != dest && author != n.Identifier {
+		return nil, errors.Errorf("%s is not the owner of %s", author, dest)
+	}
+
+	data := map[string]interface{}{}
+	err := json.Unmarshal([]byte(str(op["raw"])), &data)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid raw attrib")
+	}
+
+	if _, ok := r.attribs[dest]; !ok {
+		r.attribs[dest] = map[string]*txn{}
+	}
+
+	t := r.next(nil)
+	for k, v := range data {
+		r.attribs[dest][k] = r.stamp(t, v)
+	}
+
+	return t, nil
+}
+
+func (r *Ledger) writeSchema(author, writer string, op map[string]interface{}) (*txn, error) {
+	if !r.privileged(writer) {
+		return nil, errors.Errorf("%s is not permitted to create schemas", writer)
+	}
+
+	data, _ := op["data"].(map[string]interface{})
+	name, version := str(data["name"]), str(data["version"])
+	if name == "" || version == "" {
+		return nil, errors.New("schema name and version are required")
+	}
+
+	id := fmt.Sprintf("%s:2:%s:%s", author, name, version)
+	if _, ok := r.schemas[id]; ok {
+		return nil, errors.Errorf("schema %s already exists", id)
+	}
+
+	t := r.next(data)
+	r.schemas[id] = t
+	r.refs[t.seqNo] = true
+
+	return t, nil
+}
+
+func (r *Ledger) writeClaimDef(author, writer string, op map[string]interface{}) (*txn, error) {
+	if !r.privileged(writer) {
+		return nil, errors.Errorf("%s is not permitted to create claim defs", writer)
+	}
+
+	ref, _ := op["ref"].(float64)
+	if !r.refs[uint32(ref)] {
+		return nil, errors.Errorf("no schema with seqNo %d", uint32(ref))
+	}
+
+	id := fmt.Sprintf("%s:3:%s:%d:%s", author, str(op["signature_type"]), uint32(ref), str(op["tag"]))
+	if _, ok := r.credDefs[id]; ok {
+		return nil, errors.Errorf("claim def %s already exists", id)
+	}
+
+	t := r.next(op["data"])
+	r.credDefs[id] = t
+
+	return t, nil
+}
+
+// next assigns the next sequence number to a transaction with data.  Callers hold the write lock
+func (r *Ledger) next(data interface{}) *txn {
+	r.seqNo++
+	return &txn{data: data, seqNo: r.seqNo, txnTime: uint32(r.now().Unix())}
+}
+
+func (r *Ledger) stamp(t *txn, data interface{}) *txn {
+	return &txn{data: data, seqNo: t.seqNo, txnTime: t.txnTime}
+}
+
+// verify checks the author's and any endorser's signature on req
+func (r *Ledger) verify(req *vdr.Request, sigs map[string]string) error {
+	signers := []string{req.Identifier}
+	if req.Endorser != "" {
+		signers = append(signers, req.Endorser)
+	}
+
+	msg := signingInput(req)
+	for _, did := range signers {
+		sig, ok := sigs[did]
+		if !ok {
+			return errors.Errorf("missing signature from %s", did)
+		}
+
+		n, ok := r.nyms[did]
+		if !ok {
+			return errors.Errorf("unknown signer %s", did)
+		}
+
+		pub, err := publicKey(n.Dest, n.Verkey)
+		if err != nil {
+			return err
+		}
+
+		if !ed25519.Verify(pub, msg, base58.Decode(sig)) {
+			return errors.Errorf("invalid signature from %s", did)
+		}
+	}
+
+	return nil
+}
+
+func (r *Ledger) checkTAA(req *vdr.Request) error {
+	if r.taa == nil {
+		return nil
+	}
+
+	acc := req.TAAAcceptance
+	if acc == nil || str(acc["taaDigest"]) != r.taa.Digest {
+		return errors.New("transaction author agreement has not been accepted")
+	}
+
+	if _, ok := r.aml.Mechanisms[str(acc["mechanism"])]; !ok {
+		return errors.Errorf("unknown acceptance mechanism %v", acc["mechanism"])
+	}
+
+	return nil
+}
+
+func (r *Ledger) role(did string) string {
+	if n, ok := r.nyms[did]; ok {
+		return n.Role
+	}
+
+	return ""
+}
+
+func (r *Ledger) privileged(did string) bool {
+	role := r.role(did)
+	return role == trusteeRole || role == stewardRole || role == vdr.EndorserRole
+}
+
+func (r *Ledger) GetNym(did string) (*vdr.ReadReply, error) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	n, ok := r.nyms[did]
+	if !ok {
+		return &vdr.ReadReply{}, nil
+	}
+
+	d, _ := json.Marshal(n)
+	return &vdr.ReadReply{Data: string(d), SeqNo: n.SeqNo, TxnTime: n.TxnTime}, nil
+}
+
+func (r *Ledger) GetAttrib(did, raw string) (*vdr.ReadReply, error) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	t, ok := r.attribs[did][raw]
+	if !ok {
+		return &vdr.ReadReply{}, nil
+	}
+
+	d, _ := json.Marshal(map[string]interface{}{raw: t.data})
+	return &vdr.ReadReply{Data: string(d), SeqNo: t.seqNo, TxnTime: t.txnTime}, nil
+}
+
+func (r *Ledger) GetEndpoint(did string) (*vdr.ReadReply, error) {
+	return r.GetAttrib(did, "endpoint")
+}
+
+func (r *Ledger) GetSchema(schemaID string) (*vdr.ReadReply, error) {
+	return r.read(r.schemas, schemaID), nil
+}
+
+func (r *Ledger) GetCredDef(credDefID string) (*vdr.ReadReply, error) {
+	return r.read(r.credDefs, credDefID), nil
+}
+
+func (r *Ledger) read(txns map[string]*txn, id string) *vdr.ReadReply {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	t, ok := txns[id]
+	if !ok {
+		return &vdr.ReadReply{}
+	}
+
+	return &vdr.ReadReply{Data: t.data, SeqNo: t.seqNo, TxnTime: t.txnTime}
+}
+
+func (r *Ledger) GetTxnAuthorAgreement() (*vdr.ReadReply, error) {
+	if r.taa == nil {
+		return &vdr.ReadReply{}, nil
+	}
+
+	d, _ := json.Marshal(r.taa)
+	return &vdr.ReadReply{Data: string(d)}, nil
+}
+
+func (r *Ledger) GetAcceptanceMethodList() (*vdr.ReadReply, error) {
+	if r.aml == nil {
+		return &vdr.ReadReply{}, nil
+	}
+
+	d, _ := json.Marshal(r.aml)
+	return &vdr.ReadReply{Data: string(d)}, nil
+}
+
+func (r *Ledger) GetAuthRules() (*vdr.ReadReply, error) {
+	d, _ := json.Marshal(authRules())
+	return &vdr.ReadReply{Data: string(d)}, nil
+}
+
+func (r *Ledger) GetTxnTypeAuthRule(typ, action, _ string) (*vdr.ReadReply, error) {
+	var out []map[string]interface{}
+	for _, rule := range authRules() {
+		if rule["auth_type"] == typ && rule["auth_action"] == action {
+			out = append(out, rule)
+		}
+	}
+
+	d, _ := json.Marshal(out)
+	return &vdr.ReadReply{Data: string(d)}, nil
+}
+
+// Genesis returns the ledger's genesis NYM transactions, one per line
+func (r *Ledger) Genesis() []byte {
+	return []byte(strings.Join(r.genesis, "\n"))
+}
+
+func (r *Ledger) RefreshPool() error {
+	return nil
+}
+
+func (r *Ledger) GetPoolStatus() (*vdr.PoolStatus, error) {
+	return &vdr.PoolStatus{}, nil
+}
+
+func (r *Ledger) Close() error {
+	return nil
+}
+
+// authRules returns the ADD rules the ledger enforces, in the form of the ledger's GET_AUTH_RULE reply
+func authRules() []map[string]interface{} {
+	privileged := map[string]interface{}{
+		"constraint_id": "OR",
+		"auth_constraints": []map[string]interface{}{
+			roleConstraint(trusteeRole, false),
+			roleConstraint(stewardRole, false),
+			roleConstraint(vdr.EndorserRole, false),
+		},
+	}
+
+	var out []map[string]interface{}
+	for _, typ := range []string{nymTxn, schemaTxn, claimDefTxn} {
+		out = append(out, authRule(typ, privileged))
+	}
+
+	return append(out, authRule(attribTxn, roleConstraint("*", true)))
+}
+
+func authRule(typ string, constraint map[string]interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"auth_type":   typ,
+		"auth_action": "ADD",
+		"field":       "*",
+		"old_value":   nil,
+		"new_value":   "*",
+		"constraint":  constraint,
+	}
+}
+
+func roleConstraint(role string, owner bool) map[string]interface{} {
+	return map[string]interface{}{
+		"constraint_id":    "ROLE",
+		"role":             role,
+		"sig_count":        1,
+		"need_to_be_owner": owner,
+	}
+}
+
+func request(from string, op map[string]interface{}) *vdr.Request {
+	return &vdr.Request{
+		Operation:       op,
+		Identifier:      from,
+		ReqID:           rand.Uint32(),
+		ProtocolVersion: 2,
+	}
+}
+
+// signingInput is the message signed for req, the JSON encoding of everything but its signatures
+func signingInput(req *vdr.Request) []byte {
+	op := map[string]interface{}{}
+	d, _ := json.Marshal(req.Operation)
+	_ = json.Unmarshal(d, &op)
+
+	d, _ = json.Marshal(map[string]interface{}{
+		"identifier":      req.Identifier,
+		"endorser":        req.Endorser,
+		"reqId":           req.ReqID,
+		"protocolVersion": req.ProtocolVersion,
+		"operation":       op,
+		"taaAcceptance":   req.TAAAcceptance,
+	})
+
+	return d
+}
+
+func sign(req *vdr.Request, signer vdr.Signer) (string, error) {
+	if signer == nil {
+		return "", errors.New("a signer is required for ledger writes")
+	}
+
+	sig, err := signer.Sign(signingInput(req))
+	if err != nil {
+		return "", errors.Wrap(err, "unable to sign request")
+	}
+
+	return base58.Encode(sig), nil
+}
+
+// publicKey returns the full public key for a NYM's verkey, which may be abbreviated relative to its DID
+func publicKey(did, verkey string) (ed25519.PublicKey, error) {
+	var key []byte
+	if strings.HasPrefix(verkey, "~") {
+		key = append(base58.Decode(did), base58.Decode(verkey[1:])...)
+	} else {
+		key = base58.Decode(verkey)
+	}
+
+	if len(key) != ed25519.PublicKeySize {
+		return nil, errors.Errorf("invalid verkey for %s", did)
+	}
+
+	return key, nil
+}
+
+func str(v interface{}) string {
+	if v == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("%v", v)
+}
@@ -0,0 +1,187 @@
+package sim
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+
+	"github.com/hyperledger/indy-vdr/wrappers/golang/vdr"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scoir/canis/pkg/datastore"
+	"github.com/scoir/canis/pkg/indy"
+)
+
+type taaStore struct {
+	acceptance *datastore.TAAAcceptance
+}
+
+func (r *taaStore) GetTAAAcceptance(_ string) (*datastore.TAAAcceptance, error) {
+	return r.acceptance, nil
+}
+
+func TestLedger_Nym(t *testing.T) {
+	trustee := NewIdentity("000000000000000000000000Trustee1")
+	endorser := NewIdentity("endorser")
+	other := NewIdentity("other")
+	target := New(WithTrustee(trustee))
+
+	err := target.CreateNym(endorser.DID, endorser.AbbreviatedVerkey(), vdr.EndorserRole, trustee.DID, trustee)
+	require.NoError(t, err)
+
+	rply, err := target.GetNym(endorser.DID)
+	require.NoError(t, err)
+	require.NotZero(t, rply.SeqNo)
+	n := &nym{}
+	require.NoError(t, json.Unmarshal([]byte(rply.Data.(string)), n))
+	require.Equal(t, vdr.EndorserRole, n.Role)
+
+	t.Run("abbreviated verkey signs", func(t *testing.T) {
+		err := target.SetEndpoint(endorser.DID, endorser.DID, "https://agent.example.com", endorser)
+		require.NoError(t, err)
+
+		rply, err := target.GetEndpoint(endorser.DID)
+		require.NoError(t, err)
+		require.JSONEq(t, `{"endpoint":{"endpoint":"https://agent.example.com"}}`, rply.Data.(string))
+	})
+	t.Run("wrong signer", func(t *testing.T) {
+		err := target.CreateNym(other.DID, other.Verkey, "", trustee.DID, endorser)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "invalid signature")
+	})
+	t.Run("unknown author", func(t *testing.T) {
+		err := target.CreateNym(other.DID, other.Verkey, "", other.DID, other)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "unknown signer")
+	})
+	t.Run("endorser can not create steward", func(t *testing.T) {
+		err := target.CreateNym(other.DID, other.Verkey, "2", endorser.DID, endorser)
+		require.Error(t, err)
+	})
+	t.Run("attrib by non owner", func(t *testing.T) {
+		err := target.SetEndpoint(endorser.DID, trustee.DID, "https://evil.example.com", trustee)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "not the owner")
+	})
+	t.Run("missing nym", func(t *testing.T) {
+		rply, err := target.GetNym(other.DID)
+		require.NoError(t, err)
+		require.Nil(t, rply.Data)
+	})
+}
+
+func TestLedger_SchemaAndCredDef(t *testing.T) {
+	trustee := NewIdentity("trustee")
+	issuer := NewIdentity("issuer")
+	holder := NewIdentity("holder")
+	target := New(WithTrustee(trustee))
+	require.NoError(t, target.CreateNym(issuer.DID, issuer.Verkey, vdr.EndorserRole, trustee.DID, trustee))
+	require.NoError(t, target.CreateNym(holder.DID, holder.Verkey, "", trustee.DID, trustee))
+
+	schemaID, err := target.CreateSchema(issuer.DID, "degree", "1.0", []string{"name", "gpa"}, issuer)
+	require.NoError(t, err)
+	require.Equal(t, indy.SchemaID("", issuer.DID, "degree", "1.0"), schemaID)
+
+	rply, err := target.GetSchema(schemaID)
+	require.NoError(t, err)
+	require.NotZero(t, rply.SeqNo)
+
+	t.Run("duplicate schema", func(t *testing.T) {
+		_, err := target.CreateSchema(issuer.DID, "degree", "1.0", []string{"name"}, issuer)
+		require.Error(t, err)
+	})
+	t.Run("schema by unprivileged DID", func(t *testing.T) {
+		_, err := target.CreateSchema(holder.DID, "degree", "1.0", []string{"name"}, holder)
+		require.Error(t, err)
+	})
+	t.Run("cred def", func(t *testing.T) {
+		primary := map[string]interface{}{"n": "123", "s": "456"}
+		credDefID, err := target.CreateClaimDef(issuer.DID, rply.SeqNo, primary, nil, issuer)
+		require.NoError(t, err)
+		require.Equal(t, indy.CredDefID("", issuer.DID, rply.SeqNo, "default"), credDefID)
+
+		cd, err := target.GetCredDef(credDefID)
+		require.NoError(t, err)
+
+		d, _ := json.Marshal(cd.Data)
+		out := map[string]interface{}{}
+		require.NoError(t, json.Unmarshal(d, &out))
+		require.Equal(t, primary, out["primary"])
+	})
+	t.Run("cred def for unknown schema", func(t *testing.T) {
+		_, err := target.CreateClaimDef(issuer.DID, 9999, map[string]interface{}{}, nil, issuer)
+		require.Error(t, err)
+	})
+}
+
+func TestLedger_Endorsed(t *testing.T) {
+	trustee := NewIdentity("trustee")
+	endorser := NewIdentity("endorser")
+	author := NewIdentity("author")
+	target := New(WithTrustee(trustee))
+	require.NoError(t, target.CreateNym(endorser.DID, endorser.Verkey, vdr.EndorserRole, trustee.DID, trustee))
+	require.NoError(t, target.CreateNym(author.DID, author.Verkey, "", trustee.DID, trustee))
+
+	req := func() *vdr.Request {
+		return &vdr.Request{
+			Identifier: author.DID,
+			Endorser:   endorser.DID,
+			ReqID:      rand.Uint32(),
+			Operation: map[string]interface{}{
+				"type": schemaTxn,
+				"data": map[string]interface{}{"name": "degree", "version": "1.0", "attr_names": []string{"name"}},
+			},
+		}
+	}
+
+	t.Run("author signature only", func(t *testing.T) {
+		d, err := target.MultiSignRequest(req(), author.DID, author)
+		require.NoError(t, err)
+
+		_, err = target.Submit(d)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "missing signature")
+	})
+	t.Run("endorsed", func(t *testing.T) {
+		r := req()
+		_, err := target.MultiSignRequest(r, author.DID, author)
+		require.NoError(t, err)
+		d, err := target.MultiSignRequest(r, endorser.DID, endorser)
+		require.NoError(t, err)
+
+		rply, err := target.Submit(d)
+		require.NoError(t, err)
+		require.NotZero(t, rply.SeqNo)
+
+		schema, err := target.GetSchema(indy.SchemaID("", author.DID, "degree", "1.0"))
+		require.NoError(t, err)
+		require.Equal(t, rply.SeqNo, schema.SeqNo)
+	})
+	t.Run("auth rules require endorsement", func(t *testing.T) {
+		rply, err := target.GetTxnTypeAuthRule(schemaTxn, "ADD", "*")
+		require.NoError(t, err)
+		require.Contains(t, rply.Data.(string), `"role":"101"`)
+	})
+}
+
+func TestLedger_TAA(t *testing.T) {
+	trustee := NewIdentity("trustee")
+	other := NewIdentity("other")
+	target := New(WithTrustee(trustee), WithTAA("agreement text", "1.0"))
+
+	err := target.CreateNym(other.DID, other.Verkey, "", trustee.DID, trustee)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "transaction author agreement")
+
+	taa, err := indy.GetTAA(target)
+	require.NoError(t, err)
+	require.Equal(t, "1.0", taa.Version)
+
+	store := &taaStore{acceptance: &datastore.TAAAcceptance{
+		Digest:    taa.Digest,
+		Mechanism: "for_session",
+		Time:      indy.AcceptanceTime(target.now()),
+	}}
+	client := indy.NewTAAClient(target, store, "")
+	require.NoError(t, client.CreateNym(other.DID, other.Verkey, "", trustee.DID, trustee))
+}
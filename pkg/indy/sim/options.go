@@ -0,0 +1,71 @@
+package sim
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/scoir/canis/pkg/indy"
+)
+
+// Option configures a simulated ledger
+type Option func(opts *Ledger)
+
+// WithNym adds a genesis NYM, typically the trustee or steward that writes everything else
+func WithNym(did, verkey, role string) Option {
+	return func(opts *Ledger) {
+		t := opts.next(nil)
+		opts.nyms[did] = &nym{
+			Identifier: did,
+			Dest:       did,
+			Verkey:     verkey,
+			Role:       role,
+			SeqNo:      t.seqNo,
+			TxnTime:    t.txnTime,
+		}
+
+		d, _ := json.Marshal(map[string]interface{}{
+			"reqSignature": map[string]interface{}{},
+			"txn": map[string]interface{}{
+				"data":     map[string]interface{}{"dest": did, "verkey": verkey, "role": role},
+				"metadata": map[string]interface{}{},
+				"type":     nymTxn,
+			},
+			"txnMetadata": map[string]interface{}{"seqNo": t.seqNo},
+			"ver":         "1",
+		})
+		opts.genesis = append(opts.genesis, string(d))
+	}
+}
+
+// WithTrustee adds id as a genesis trustee
+func WithTrustee(id *Identity) Option {
+	return WithNym(id.DID, id.Verkey, trusteeRole)
+}
+
+// WithTAA requires writes to accept the transaction author agreement text at version, with the
+// acceptance mechanisms a ledger commonly allows
+func WithTAA(text, version string) Option {
+	return func(opts *Ledger) {
+		opts.taa = &indy.TAA{
+			Text:           text,
+			Version:        version,
+			Digest:         indy.TAADigest(version, text),
+			RatificationTS: opts.now().Unix(),
+		}
+		opts.aml = &indy.AML{
+			Version: "1.0",
+			Mechanisms: map[string]string{
+				"for_session":       "Agreement accepted for the session",
+				"on_file":           "Agreement on file",
+				"service_agreement": "Agreement included in the service agreement",
+			},
+		}
+	}
+}
+
+// WithClock sets the source of transaction times.  It must precede any options that add transactions
+func WithClock(now func() time.Time) Option {
+	return func(opts *Ledger) {
+		opts.now = now
+	}
+}
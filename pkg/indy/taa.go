@@ -0,0 +1,279 @@
+package indy
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/hyperledger/indy-vdr/wrappers/golang/vdr"
+	"github.com/pkg/errors"
+
+	"github.com/scoir/canis/pkg/datastore"
+)
+
+const (
+	nymTxn      = "1"
+	attribTxn   = "100"
+	schemaTxn   = "101"
+	claimDefTxn = "102"
+)
+
+// TAA is the transaction author agreement currently in force on a ledger
+type TAA struct {
+	Text           string `json:"text"`
+	Version        string `json:"version"`
+	Digest         string `json:"digest"`
+	RatificationTS int64  `json:"ratification_ts"`
+}
+
+// AML is the list of acceptance mechanisms the ledger allows for its TAA
+type AML struct {
+	Version    string            `json:"version"`
+	Mechanisms map[string]string `json:"aml"`
+	Context    string            `json:"amlContext"`
+}
+
+// TAADigest returns the digest the ledger expects for an agreement
+func TAADigest(version, text string) string {
+	d := sha256.Sum256([]byte(version + text))
+	return hex.EncodeToString(d[:])
+}
+
+// TAAReader reads the transaction author agreement and acceptance mechanisms from a ledger
+type TAAReader interface {
+	GetTxnAuthorAgreement() (*vdr.ReadReply, error)
+	GetAcceptanceMethodList() (*vdr.ReadReply, error)
+}
+
+// GetTAA returns the ledger's transaction author agreement, or nil if the ledger does not require one
+func GetTAA(client TAAReader) (*TAA, error) {
+	rply, err := client.GetTxnAuthorAgreement()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get transaction author agreement from ledger")
+	}
+
+	out := &TAA{}
+	err = decodeReply(rply, out)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid transaction author agreement reply from ledger")
+	}
+
+	if out.Text == "" {
+		return nil, nil
+	}
+
+	if out.Digest == "" {
+		out.Digest = TAADigest(out.Version, out.Text)
+	}
+
+	return out, nil
+}
+
+// GetAML returns the ledger's acceptance mechanism list
+func GetAML(client TAAReader) (*AML, error) {
+	rply, err := client.GetAcceptanceMethodList()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get acceptance mechanism list from ledger")
+	}
+
+	out := &AML{}
+	err = decodeReply(rply, out)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid acceptance mechanism list reply from ledger")
+	}
+
+	return out, nil
+}
+
+func decodeReply(rply *vdr.ReadReply, out interface{}) error {
+	if rply == nil || rply.Data == nil {
+		return nil
+	}
+
+	var d []byte
+	switch data := rply.Data.(type) {
+	case string:
+		d = []byte(data)
+	default:
+		d, _ = json.Marshal(data)
+	}
+
+	return json.Unmarshal(d, out)
+}
+
+// TAAStore provides the operator's current TAA acceptance for a ledger
+type TAAStore interface {
+	GetTAAAcceptance(ledger string) (*datastore.TAAAcceptance, error)
+}
+
+// TAAClient attaches the operator's stored transaction author agreement acceptance to every ledger write
+// made through the wrapped client.  Writes are submitted unchanged when the ledger has no agreement and
+// fail when the ledger's agreement has not been accepted.
+// Schema and claim def IDs are returned along with ErrEndorsementPending when the write was queued for
+// an endorser, so callers can keep any private material for the pending transaction.
+type TAAClient struct {
+	IndyVDRClient
+	store     TAAStore
+	namespace string
+}
+
+// NewTAAClient wraps client for the ledger with namespace so writes carry the acceptance held in store
+func NewTAAClient(client IndyVDRClient, store TAAStore, namespace string) *TAAClient {
+	return &TAAClient{IndyVDRClient: client, store: store, namespace: namespace}
+}
+
+// acceptance returns the stored acceptance of the agreement currently on the ledger, or nil if the ledger
+// does not require one.  An error is returned if the agreement has not been accepted or has changed since
+func (r *TAAClient) acceptance() (map[string]interface{}, error) {
+	taa, err := GetTAA(r.IndyVDRClient)
+	if err != nil {
+		return nil, err
+	}
+
+	if taa == nil {
+		return nil, nil
+	}
+
+	a, err := r.store.GetTAAAcceptance(r.namespace)
+	if err != nil || a == nil {
+		return nil, errors.Errorf("transaction author agreement version %s for ledger %q has not been accepted: %v",
+			taa.Version, r.namespace, err)
+	}
+
+	if a.Digest != taa.Digest {
+		return nil, errors.Errorf("transaction author agreement for ledger %q has changed from version %s to %s, "+
+			"it must be re-accepted", r.namespace, a.Version, taa.Version)
+	}
+
+	return map[string]interface{}{
+		"mechanism": a.Mechanism,
+		"taaDigest": a.Digest,
+		"time":      a.Time,
+	}, nil
+}
+
+func (r *TAAClient) write(from string, op map[string]interface{}, signer vdr.Signer) error {
+	acceptance, err := r.acceptance()
+	if err != nil {
+		return err
+	}
+
+	req := &vdr.Request{
+		Operation:       op,
+		Identifier:      from,
+		ReqID:           rand.Uint32(),
+		ProtocolVersion: 2,
+		TAAAcceptance:   acceptance,
+	}
+
+	_, err = r.IndyVDRClient.SubmitWrite(req, signer)
+	return err
+}
+
+func (r *TAAClient) CreateNym(did, verkey, role, from string, signer vdr.Signer) error {
+	op := map[string]interface{}{
+		"type":   nymTxn,
+		"dest":   did,
+		"verkey": verkey,
+	}
+	if role != "" {
+		op["role"] = role
+	}
+
+	return errors.Wrap(r.write(from, op, signer), "unable to create nym")
+}
+
+func (r *TAAClient) CreateAttrib(did, from string, data map[string]interface{}, signer vdr.Signer) error {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return errors.Wrap(err, "unable to marshal attrib")
+	}
+
+	op := map[string]interface{}{
+		"type": attribTxn,
+		"dest": did,
+		"raw":  string(raw),
+	}
+
+	return errors.Wrap(r.write(from, op, signer), "unable to create attrib")
+}
+
+func (r *TAAClient) SetEndpoint(did, from string, ep string, signer vdr.Signer) error {
+	data := map[string]interface{}{
+		"endpoint": map[string]interface{}{
+			"endpoint": ep,
+		},
+	}
+
+	return r.CreateAttrib(did, from, data, signer)
+}
+
+func (r *TAAClient) CreateSchema(issuerDID, name, version string, attrs []string, signer vdr.Signer) (string, error) {
+	op := map[string]interface{}{
+		"type": schemaTxn,
+		"data": map[string]interface{}{
+			"name":       name,
+			"version":    version,
+			"attr_names": attrs,
+		},
+	}
+
+	id := fmt.Sprintf("%s:2:%s:%s", issuerDID, name, version)
+	err := r.write(issuerDID, op, signer)
+	if errors.Is(err, ErrEndorsementPending) {
+		return id, err
+	}
+	if err != nil {
+		return "", errors.Wrap(err, "unable to create schema")
+	}
+
+	return id, nil
+}
+
+func (r *TAAClient) CreateClaimDef(from string, ref uint32, pubKey, revocation map[string]interface{}, signer vdr.Signer) (string, error) {
+	data := map[string]interface{}{
+		"primary": pubKey,
+	}
+	if revocation != nil {
+		data["revocation"] = revocation
+	}
+
+	op := map[string]interface{}{
+		"type":           claimDefTxn,
+		"ref":            ref,
+		"signature_type": "CL",
+		"tag":            "default",
+		"data":           data,
+	}
+
+	id := fmt.Sprintf("%s:3:CL:%d:default", from, ref)
+	err := r.write(from, op, signer)
+	if errors.Is(err, ErrEndorsementPending) {
+		return id, err
+	}
+	if err != nil {
+		return "", errors.Wrap(err, "unable to create claim def")
+	}
+
+	return id, nil
+}
+
+func (r *TAAClient) SubmitWrite(req *vdr.Request, signer vdr.Signer) (*vdr.WriteReply, error) {
+	if req.TAAAcceptance == nil {
+		acceptance, err := r.acceptance()
+		if err != nil {
+			return nil, err
+		}
+		req.TAAAcceptance = acceptance
+	}
+
+	return r.IndyVDRClient.SubmitWrite(req, signer)
+}
+
+// AcceptanceTime returns t truncated to the day, the precision the ledger requires for TAA acceptance time
+func AcceptanceTime(t time.Time) int64 {
+	return t.UTC().Truncate(24 * time.Hour).Unix()
+}
@@ -0,0 +1,134 @@
+package indy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hyperledger/indy-vdr/wrappers/golang/vdr"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scoir/canis/pkg/datastore"
+	dmocks "github.com/scoir/canis/pkg/datastore/mocks"
+	"github.com/scoir/canis/pkg/indy/mocks"
+)
+
+func TestGetTAA(t *testing.T) {
+	t.Run("string data", func(t *testing.T) {
+		client := &mocks.IndyVDRClient{}
+		client.On("GetTxnAuthorAgreement").Return(&vdr.ReadReply{Data: `{"text":"agreement text","version":"1.0"}`}, nil)
+
+		taa, err := GetTAA(client)
+		require.NoError(t, err)
+		require.Equal(t, "1.0", taa.Version)
+		require.Equal(t, TAADigest("1.0", "agreement text"), taa.Digest)
+	})
+	t.Run("no agreement", func(t *testing.T) {
+		client := &mocks.IndyVDRClient{}
+		client.On("GetTxnAuthorAgreement").Return(&vdr.ReadReply{}, nil)
+
+		taa, err := GetTAA(client)
+		require.NoError(t, err)
+		require.Nil(t, taa)
+	})
+	t.Run("ledger error", func(t *testing.T) {
+		client := &mocks.IndyVDRClient{}
+		client.On("GetTxnAuthorAgreement").Return(nil, errors.New("boom"))
+
+		taa, err := GetTAA(client)
+		require.Error(t, err)
+		require.Nil(t, taa)
+	})
+}
+
+func TestTAAClient(t *testing.T) {
+	ledgerTAA := &vdr.ReadReply{Data: `{"text":"agreement text","version":"1.0"}`}
+
+	t.Run("acceptance attached", func(t *testing.T) {
+		client := &mocks.IndyVDRClient{}
+		client.On("GetTxnAuthorAgreement").Return(ledgerTAA, nil)
+		store := &dmocks.Store{}
+		store.On("GetTAAAcceptance", "").Return(&datastore.TAAAcceptance{
+			Version:   "1.0",
+			Digest:    TAADigest("1.0", "agreement text"),
+			Mechanism: "service_agreement",
+			Time:      1604188800,
+		}, nil)
+
+		match := func(req *vdr.Request) bool {
+			return req.Identifier == "did:sov:issuer" && req.TAAAcceptance["taaDigest"] == TAADigest("1.0", "agreement text") &&
+				req.TAAAcceptance["mechanism"] == "service_agreement"
+		}
+		client.On("SubmitWrite", mock.MatchedBy(match), nil).Return(&vdr.WriteReply{}, nil)
+
+		target := NewTAAClient(client, store, "")
+		id, err := target.CreateSchema("did:sov:issuer", "name", "1.0", []string{"a"}, nil)
+		require.NoError(t, err)
+		require.Equal(t, "did:sov:issuer:2:name:1.0", id)
+
+		id, err = target.CreateClaimDef("did:sov:issuer", 23, map[string]interface{}{}, nil, nil)
+		require.NoError(t, err)
+		require.Equal(t, "did:sov:issuer:3:CL:23:default", id)
+
+		err = target.CreateNym("did:sov:new", "verkey", "", "did:sov:issuer", nil)
+		require.NoError(t, err)
+		client.AssertNumberOfCalls(t, "SubmitWrite", 3)
+	})
+	t.Run("ledger without agreement", func(t *testing.T) {
+		client := &mocks.IndyVDRClient{}
+		client.On("GetTxnAuthorAgreement").Return(&vdr.ReadReply{}, nil)
+		store := &dmocks.Store{}
+
+		match := func(req *vdr.Request) bool {
+			return req.TAAAcceptance == nil
+		}
+		client.On("SubmitWrite", mock.MatchedBy(match), nil).Return(&vdr.WriteReply{}, nil)
+
+		target := NewTAAClient(client, store, "")
+		err := target.SetEndpoint("did:sov:new", "did:sov:new", "https://example.com", nil)
+		require.NoError(t, err)
+		store.AssertNotCalled(t, "GetTAAAcceptance", mock.Anything)
+	})
+	t.Run("no acceptance", func(t *testing.T) {
+		client := &mocks.IndyVDRClient{}
+		client.On("GetTxnAuthorAgreement").Return(ledgerTAA, nil)
+		store := &dmocks.Store{}
+		store.On("GetTAAAcceptance", "").Return(nil, errors.New("not found"))
+
+		target := NewTAAClient(client, store, "")
+		err := target.SetEndpoint("did:sov:new", "did:sov:new", "https://example.com", nil)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "has not been accepted")
+		client.AssertNotCalled(t, "SubmitWrite", mock.Anything, mock.Anything)
+	})
+	t.Run("stale acceptance", func(t *testing.T) {
+		client := &mocks.IndyVDRClient{}
+		client.On("GetTxnAuthorAgreement").Return(ledgerTAA, nil)
+		store := &dmocks.Store{}
+		store.On("GetTAAAcceptance", "").Return(&datastore.TAAAcceptance{
+			Version:   "0.9",
+			Digest:    TAADigest("0.9", "old agreement"),
+			Mechanism: "service_agreement",
+		}, nil)
+
+		target := NewTAAClient(client, store, "")
+		_, err := target.SubmitWrite(&vdr.Request{Identifier: "did:sov:issuer"}, nil)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "re-accepted")
+		client.AssertNotCalled(t, "SubmitWrite", mock.Anything, mock.Anything)
+	})
+	t.Run("ledger error", func(t *testing.T) {
+		client := &mocks.IndyVDRClient{}
+		client.On("GetTxnAuthorAgreement").Return(nil, errors.New("boom"))
+
+		target := NewTAAClient(client, &dmocks.Store{}, "")
+		err := target.CreateNym("did:sov:new", "verkey", "", "did:sov:issuer", nil)
+		require.Error(t, err)
+	})
+}
+
+func TestAcceptanceTime(t *testing.T) {
+	require.Equal(t, int64(0), AcceptanceTime(time.Unix(86399, 0)))
+	require.Equal(t, int64(86400), AcceptanceTime(time.Unix(86400, 0)))
+}
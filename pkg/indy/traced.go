@@ -0,0 +1,179 @@
+package indy
+
+import (
+	"context"
+
+	"github.com/hyperledger/indy-vdr/wrappers/golang/vdr"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/scoir/canis/pkg/tracing"
+)
+
+// TracedClient records a span for every ledger call made through the wrapped client
+type TracedClient struct {
+	client IndyVDRClient
+	ctx    context.Context
+}
+
+// ContextClient is implemented by ledger clients that can record their calls as part of the trace of a context
+type ContextClient interface {
+	WithContext(ctx context.Context) IndyVDRClient
+}
+
+// NewTracedClient wraps client with ledger call tracing
+func NewTracedClient(client IndyVDRClient) *TracedClient {
+	return &TracedClient{client: client, ctx: context.Background()}
+}
+
+// WithContext returns a client whose ledger call spans are children of the span in ctx
+func (r *TracedClient) WithContext(ctx context.Context) IndyVDRClient {
+	return &TracedClient{client: r.client, ctx: ctx}
+}
+
+// WithContext binds client to ctx if it records ledger calls, otherwise client is returned as is
+func WithContext(ctx context.Context, client IndyVDRClient) IndyVDRClient {
+	if cc, ok := client.(ContextClient); ok {
+		return cc.WithContext(ctx)
+	}
+
+	return client
+}
+
+func (r *TracedClient) startSpan(op string, attrs ...attribute.KeyValue) trace.Span {
+	_, span := tracing.Start(r.ctx, "indy.ledger "+op,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(append(attrs, attribute.String("peer.service", "indy"))...))
+	return span
+}
+
+func (r *TracedClient) CreateClaimDef(from string, ref uint32, pubKey, revocation map[string]interface{}, signer vdr.Signer) (string, error) {
+	span := r.startSpan("CreateClaimDef", attribute.String("indy.did", from))
+	id, err := r.client.CreateClaimDef(from, ref, pubKey, revocation, signer)
+	tracing.End(span, err)
+	return id, err
+}
+
+func (r *TracedClient) CreateNym(did, verkey, role, from string, signer vdr.Signer) error {
+	span := r.startSpan("CreateNym", attribute.String("indy.did", did))
+	err := r.client.CreateNym(did, verkey, role, from, signer)
+	tracing.End(span, err)
+	return err
+}
+
+func (r *TracedClient) CreateAttrib(did, from string, data map[string]interface{}, signer vdr.Signer) error {
+	span := r.startSpan("CreateAttrib", attribute.String("indy.did", did))
+	err := r.client.CreateAttrib(did, from, data, signer)
+	tracing.End(span, err)
+	return err
+}
+
+func (r *TracedClient) SetEndpoint(did, from string, ep string, signer vdr.Signer) error {
+	span := r.startSpan("SetEndpoint", attribute.String("indy.did", did))
+	err := r.client.SetEndpoint(did, from, ep, signer)
+	tracing.End(span, err)
+	return err
+}
+
+func (r *TracedClient) CreateSchema(issuerDID, name, version string, attrs []string, signer vdr.Signer) (string, error) {
+	span := r.startSpan("CreateSchema", attribute.String("indy.did", issuerDID))
+	id, err := r.client.CreateSchema(issuerDID, name, version, attrs, signer)
+	tracing.End(span, err)
+	return id, err
+}
+
+func (r *TracedClient) Genesis() []byte {
+	return r.client.Genesis()
+}
+
+func (r *TracedClient) Close() error {
+	return r.client.Close()
+}
+
+func (r *TracedClient) Submit(request []byte) (*vdr.ReadReply, error) {
+	span := r.startSpan("Submit")
+	rply, err := r.client.Submit(request)
+	tracing.End(span, err)
+	return rply, err
+}
+
+func (r *TracedClient) GetNym(did string) (*vdr.ReadReply, error) {
+	span := r.startSpan("GetNym", attribute.String("indy.did", did))
+	rply, err := r.client.GetNym(did)
+	tracing.End(span, err)
+	return rply, err
+}
+
+func (r *TracedClient) GetTxnAuthorAgreement() (*vdr.ReadReply, error) {
+	span := r.startSpan("GetTxnAuthorAgreement")
+	rply, err := r.client.GetTxnAuthorAgreement()
+	tracing.End(span, err)
+	return rply, err
+}
+
+func (r *TracedClient) GetAcceptanceMethodList() (*vdr.ReadReply, error) {
+	span := r.startSpan("GetAcceptanceMethodList")
+	rply, err := r.client.GetAcceptanceMethodList()
+	tracing.End(span, err)
+	return rply, err
+}
+
+func (r *TracedClient) GetEndpoint(did string) (*vdr.ReadReply, error) {
+	span := r.startSpan("GetEndpoint", attribute.String("indy.did", did))
+	rply, err := r.client.GetEndpoint(did)
+	tracing.End(span, err)
+	return rply, err
+}
+
+func (r *TracedClient) RefreshPool() error {
+	span := r.startSpan("RefreshPool")
+	err := r.client.RefreshPool()
+	tracing.End(span, err)
+	return err
+}
+
+func (r *TracedClient) GetPoolStatus() (*vdr.PoolStatus, error) {
+	return r.client.GetPoolStatus()
+}
+
+func (r *TracedClient) GetAttrib(did, raw string) (*vdr.ReadReply, error) {
+	span := r.startSpan("GetAttrib", attribute.String("indy.did", did))
+	rply, err := r.client.GetAttrib(did, raw)
+	tracing.End(span, err)
+	return rply, err
+}
+
+func (r *TracedClient) GetSchema(schemaID string) (*vdr.ReadReply, error) {
+	span := r.startSpan("GetSchema", attribute.String("indy.schema_id", schemaID))
+	rply, err := r.client.GetSchema(schemaID)
+	tracing.End(span, err)
+	return rply, err
+}
+
+func (r *TracedClient) GetCredDef(credDefID string) (*vdr.ReadReply, error) {
+	span := r.startSpan("GetCredDef", attribute.String("indy.cred_def_id", credDefID))
+	rply, err := r.client.GetCredDef(credDefID)
+	tracing.End(span, err)
+	return rply, err
+}
+
+func (r *TracedClient) GetAuthRules() (*vdr.ReadReply, error) {
+	span := r.startSpan("GetAuthRules")
+	rply, err := r.client.GetAuthRules()
+	tracing.End(span, err)
+	return rply, err
+}
+
+func (r *TracedClient) GetTxnTypeAuthRule(typ, action, field string) (*vdr.ReadReply, error) {
+	span := r.startSpan("GetTxnTypeAuthRule", attribute.String("indy.txn_type", typ))
+	rply, err := r.client.GetTxnTypeAuthRule(typ, action, field)
+	tracing.End(span, err)
+	return rply, err
+}
+
+func (r *TracedClient) SubmitWrite(req *vdr.Request, signer vdr.Signer) (*vdr.WriteReply, error) {
+	span := r.startSpan("SubmitWrite")
+	rply, err := r.client.SubmitWrite(req, signer)
+	tracing.End(span, err)
+	return rply, err
+}
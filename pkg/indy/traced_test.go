@@ -0,0 +1,51 @@
+package indy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hyperledger/indy-vdr/wrappers/golang/vdr"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/scoir/canis/pkg/indy/mocks"
+	"github.com/scoir/canis/pkg/tracing"
+)
+
+func TestTracedClient(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+	client := &mocks.IndyVDRClient{}
+	client.On("GetNym", "did").Return(&vdr.ReadReply{Data: "nym"}, nil)
+
+	ctx, parent := tracing.Start(context.Background(), "caller")
+	defer parent.End()
+
+	t.Run("ledger spans continue the caller's trace", func(t *testing.T) {
+		target := WithContext(ctx, NewTracedClient(client))
+		_, err := target.GetNym("did")
+		require.NoError(t, err)
+
+		spans := recorder.Ended()
+		require.Len(t, spans, 1)
+		require.Equal(t, "indy.ledger GetNym", spans[0].Name())
+		require.Equal(t, parent.SpanContext().SpanID(), spans[0].Parent().SpanID())
+	})
+
+	t.Run("cached clients pass the context through", func(t *testing.T) {
+		target := WithContext(ctx, NewCachingClient(NewTracedClient(client), NewLedgerCache(nil), ""))
+		_, err := target.GetNym("did")
+		require.NoError(t, err)
+
+		spans := recorder.Ended()
+		require.Len(t, spans, 2)
+		require.Equal(t, parent.SpanContext().TraceID(), spans[1].SpanContext().TraceID())
+	})
+
+	t.Run("clients without tracing are returned as is", func(t *testing.T) {
+		require.Equal(t, client, WithContext(ctx, client))
+	})
+}
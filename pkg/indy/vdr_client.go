@@ -27,3 +27,5 @@ type IndyVDRClient interface {
 	GetTxnTypeAuthRule(typ, action, field string) (*vdr.ReadReply, error)
 	SubmitWrite(req *vdr.Request, signer vdr.Signer) (*vdr.WriteReply, error)
 }
+
+var _ IndyVDRClient = (*vdr.Client)(nil)
@@ -22,6 +22,13 @@ type MockConfig struct {
 	VDRIErr               error
 	LedgerGenesisFunc     func() string
 	IndyRegistryFunc      func() string
+	TLSFunc               func() (*framework.TLSConfig, error)
+	TracingFunc           func() (*framework.TracingConfig, error)
+	LedgersFunc           func() ([]*framework.LedgerConfig, error)
+	LedgerCacheFunc       func() (*framework.LedgerCacheConfig, error)
+	PoolRefreshFunc       func() (*framework.PoolRefreshConfig, error)
+	MailboxFunc           func() (*framework.MailboxConfig, error)
+	PushFunc              func() (*framework.PushConfig, error)
 }
 
 func (m MockConfig) GetInt(s string) int {
@@ -159,3 +166,59 @@ func (m MockConfig) IndyRegistry() string {
 
 	return ""
 }
+
+func (m MockConfig) TLS() (*framework.TLSConfig, error) {
+	if m.TLSFunc != nil {
+		return m.TLSFunc()
+	}
+
+	return nil, nil
+}
+
+func (m MockConfig) Tracing() (*framework.TracingConfig, error) {
+	if m.TracingFunc != nil {
+		return m.TracingFunc()
+	}
+
+	return nil, nil
+}
+
+func (m MockConfig) Ledgers() ([]*framework.LedgerConfig, error) {
+	if m.LedgersFunc != nil {
+		return m.LedgersFunc()
+	}
+
+	return nil, nil
+}
+
+func (m MockConfig) LedgerCache() (*framework.LedgerCacheConfig, error) {
+	if m.LedgerCacheFunc != nil {
+		return m.LedgerCacheFunc()
+	}
+
+	return nil, nil
+}
+
+func (m MockConfig) PoolRefresh() (*framework.PoolRefreshConfig, error) {
+	if m.PoolRefreshFunc != nil {
+		return m.PoolRefreshFunc()
+	}
+
+	return nil, nil
+}
+
+func (m MockConfig) Mailbox() (*framework.MailboxConfig, error) {
+	if m.MailboxFunc != nil {
+		return m.MailboxFunc()
+	}
+
+	return nil, nil
+}
+
+func (m MockConfig) Push() (*framework.PushConfig, error) {
+	if m.PushFunc != nil {
+		return m.PushFunc()
+	}
+
+	return nil, nil
+}
@@ -2,9 +2,13 @@ package indy
 
 import (
 	"github.com/hyperledger/indy-vdr/wrappers/golang/vdr"
+
+	indywrapper "github.com/scoir/canis/pkg/indy"
 )
 
+// MockIndyClient stubs the reads used to resolve DIDs, other ledger calls are not implemented
 type MockIndyClient struct {
+	indywrapper.IndyVDRClient
 	GetNymErr      error
 	GetNymValue    *vdr.ReadReply
 	GetEndpointErr error
@@ -12,6 +12,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/scoir/canis/pkg/notifier"
+	"github.com/scoir/canis/pkg/tracing"
 )
 
 var startCmd = &cobra.Command{
@@ -22,6 +23,8 @@ var startCmd = &cobra.Command{
 }
 
 func runStart(_ *cobra.Command, _ []string) {
+	defer tracing.Setup("canis-webhook-notifier", prov.conf)()
+
 	log.Println("starting webhook notifier")
 
 	srv, err := notifier.New(prov)
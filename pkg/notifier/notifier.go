@@ -9,9 +9,11 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/scoir/canis/pkg/amqp"
 	"github.com/scoir/canis/pkg/datastore"
+	"github.com/scoir/canis/pkg/tracing"
 )
 
 type Server struct {
@@ -45,16 +47,22 @@ func (r *Server) listenAndServe() error {
 	}
 
 	for d := range msgs {
+		_, span := tracing.StartConsumer(QueueName, d.Headers)
+
 		note := &Notification{}
 		err := json.Unmarshal(d.Body, note)
 		if err != nil {
-			r.Error(errors.Wrap(err, "bad notification message"))
+			err = errors.Wrap(err, "bad notification message")
+			r.Error(err)
+			tracing.End(span, err)
 			continue
 		}
+		span.SetAttributes(attribute.String("notifier.topic", note.Topic))
 
 		hooks, err := r.store.ListWebhooks(note.Topic)
 		if err != nil {
 			r.Error(errors.Wrapf(err, "no webhooks for topic %s", note.Topic))
+			tracing.End(span, err)
 			continue
 		}
 
@@ -77,6 +85,7 @@ func (r *Server) listenAndServe() error {
 				r.Error(errors.Errorf("error response from hook. code: (%d): %s\n", resp.StatusCode, string(b)))
 			}
 		}
+		span.End()
 
 	}
 
@@ -8,6 +8,7 @@ import (
 //go:generate mockery -name=Provider -inpkg
 type Provider interface {
 	IndyVDR() (indy.VDRClient, error)
+	IndyLedger(namespace string) (indy.VDRClient, error)
 	Store() datastore.Store
 	Oracle() indy.Oracle
 }
@@ -1,6 +1,7 @@
 package indy
 
 import (
+	"context"
 	"encoding/base64"
 	"encoding/json"
 
@@ -12,6 +13,7 @@ import (
 
 	"github.com/scoir/canis/pkg/credential/engine/indy"
 	"github.com/scoir/canis/pkg/datastore"
+	indywrapper "github.com/scoir/canis/pkg/indy"
 	"github.com/scoir/canis/pkg/schema"
 	cursa "github.com/scoir/canis/pkg/ursa"
 )
@@ -21,9 +23,10 @@ const (
 )
 
 type Engine struct {
-	client indy.VDRClient
-	store  datastore.Store
-	oracle indy.Oracle
+	client  indy.VDRClient
+	ledgers func(namespace string) (indy.VDRClient, error)
+	store   datastore.Store
+	oracle  indy.Oracle
 }
 
 func New(prov Provider) (*Engine, error) {
@@ -35,6 +38,7 @@ func New(prov Provider) (*Engine, error) {
 		return nil, errors.Wrap(err, "unable to get indy vdr for indy proof engine")
 	}
 
+	eng.ledgers = prov.IndyLedger
 	eng.store = prov.Store()
 	eng.oracle = prov.Oracle()
 
@@ -57,7 +61,7 @@ type PresentationRequest struct {
 }
 
 // RequestPresentationAttach
-func (r *Engine) RequestPresentation(name string, definitions *presexch.PresentationDefinitions) (*decorator.AttachmentData, error) {
+func (r *Engine) RequestPresentation(_ context.Context, name string, definitions *presexch.PresentationDefinitions) (*decorator.AttachmentData, error) {
 
 	nonce, err := r.oracle.NewNonce()
 	if err != nil {
@@ -82,7 +86,7 @@ func (r *Engine) RequestPresentationFormat() string {
 	return Format
 }
 
-func (r *Engine) Verify(presentation, request []byte, theirDID string, myDID string) error {
+func (r *Engine) Verify(ctx context.Context, presentation, request []byte, theirDID string, myDID string) error {
 
 	indyProof := &schema.IndyProof{}
 	err := json.Unmarshal(presentation, indyProof)
@@ -139,7 +143,7 @@ func (r *Engine) Verify(presentation, request []byte, theirDID string, myDID str
 	credDefs := map[string]*vdr.ClaimDefData{}
 	for _, identifier := range indyProof.Identifiers {
 
-		credDef, err := r.getCredDef(identifier.CredDefID)
+		credDef, err := r.getCredDef(ctx, identifier.CredDefID)
 		if err != nil {
 			return errors.Wrapf(err, "unable to load cred def %s", identifier.CredDefID)
 		}
@@ -150,8 +154,25 @@ func (r *Engine) Verify(presentation, request []byte, theirDID string, myDID str
 	return r.verifyCryptoCredential(indyProof, proofRequest, credDefs)
 }
 
-func (r *Engine) getCredDef(credDefID string) (*vdr.ClaimDefData, error) {
-	rply, err := r.client.GetCredDef(credDefID)
+func (r *Engine) getCredDef(ctx context.Context, credDefID string) (*vdr.ClaimDefData, error) {
+	namespace, credDefID, err := indywrapper.LedgerID(credDefID)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid cred def ID")
+	}
+
+	client := r.client
+	if namespace != "" {
+		client, err = r.ledgers(namespace)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to get client for ledger %s", namespace)
+		}
+	}
+
+	if cc, ok := client.(indywrapper.ContextClient); ok {
+		client = cc.WithContext(ctx)
+	}
+
+	rply, err := client.GetCredDef(credDefID)
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to get cred def from ledger")
 	}
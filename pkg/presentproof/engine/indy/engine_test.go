@@ -1,19 +1,31 @@
 package indy
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"testing"
 
+	"github.com/btcsuite/btcutil/base58"
+	"github.com/golang/protobuf/proto"
+	"github.com/google/tink/go/insecurecleartextkeyset"
+	ed25519pb "github.com/google/tink/go/proto/ed25519_go_proto"
+	"github.com/hyperledger/aries-framework-go/pkg/didcomm/protocol/decorator"
+	kmsMock "github.com/hyperledger/aries-framework-go/pkg/mock/kms"
+	"github.com/hyperledger/aries-framework-go/pkg/storage/mem"
+	"github.com/hyperledger/indy-vdr/wrappers/golang/identifiers"
 	"github.com/hyperledger/indy-vdr/wrappers/golang/vdr"
 	"github.com/hyperledger/ursa-wrapper-go/pkg/libursa/ursa"
 	"github.com/stretchr/testify/require"
 
+	"github.com/scoir/canis/pkg/credential/engine/indy"
 	indymocks "github.com/scoir/canis/pkg/credential/engine/indy/mocks"
 	"github.com/scoir/canis/pkg/datastore"
 	dsmocks "github.com/scoir/canis/pkg/datastore/mocks"
+	"github.com/scoir/canis/pkg/indy/sim"
 	"github.com/scoir/canis/pkg/schema"
+	cursa "github.com/scoir/canis/pkg/ursa"
 )
 
 func TestNew(t *testing.T) {
@@ -182,7 +194,7 @@ func TestEngine_Verify(t *testing.T) {
 		prov.vdr.On("GetCredDef", "abc:3:cl:bar").Return(rply, nil)
 		prov.store.On("GetSchemaByExternalID", "123:2:cl:foo").Return(s, nil)
 
-		err = engine.Verify(proofData, reqData, "did:sov:123", "did:sov:abc")
+		err = engine.Verify(context.Background(), proofData, reqData, "did:sov:123", "did:sov:abc")
 		require.NoError(t, err)
 
 	})
@@ -346,3 +358,161 @@ func getProof(t *testing.T) proofData {
 
 	return out
 }
+
+func TestEngine_VerifyRoundTrip(t *testing.T) {
+	trustee := sim.NewIdentity("000000000000000000000000Trustee1")
+	ledger := sim.New(sim.WithTrustee(trustee))
+	cred := issueCredential(t, ledger, trustee)
+
+	store := &dsmocks.Store{}
+	store.On("GetSchemaByExternalID", cred.schema.ExternalSchemaID).Return(cred.schema, nil)
+	prov := &MockProvider{}
+	prov.On("IndyVDR").Return(ledger, nil)
+	prov.On("Oracle").Return(&cursa.CryptoOracle{})
+	prov.On("Store").Return(store)
+
+	engine, err := New(prov)
+	require.NoError(t, err)
+
+	nonce, err := (&cursa.CryptoOracle{}).NewNonce()
+	require.NoError(t, err)
+	proofReq := &schema.IndyProofRequest{
+		Name:    "degree",
+		Version: "1.0",
+		Nonce:   nonce,
+		RequestedAttributes: map[string]schema.IndyProofRequestAttr{
+			"name": {Name: "name"},
+		},
+		RequestedPredicates: map[string]schema.IndyProofRequestPredicate{},
+	}
+	requestedCreds := &schema.IndyRequestedCredentials{
+		RequestedAttributes: map[string]*schema.IndyRequestedAttribute{
+			"name": {CredID: "cred-1", Revealed: true},
+		},
+	}
+
+	proof, err := cred.prover.CreateProof(map[string]*schema.IndyCredential{"cred-1": cred.credential}, proofReq,
+		requestedCreds, cred.masterSecret, map[string]*datastore.Schema{cred.schema.ExternalSchemaID: cred.schema},
+		map[string]*vdr.ClaimDefData{cred.credential.CredDefID: cred.credDef})
+	require.NoError(t, err)
+	require.Equal(t, "Alice", proof.RequestedProof.RevealedAttrs["name"].Raw)
+
+	request, err := json.Marshal(proofReq)
+	require.NoError(t, err)
+	presentation, err := json.Marshal(proof)
+	require.NoError(t, err)
+
+	t.Run("valid proof", func(t *testing.T) {
+		err := engine.Verify(context.Background(), presentation, request, "", "")
+		require.NoError(t, err)
+	})
+	t.Run("different nonce", func(t *testing.T) {
+		other, err := (&cursa.CryptoOracle{}).NewNonce()
+		require.NoError(t, err)
+		otherReq := *proofReq
+		otherReq.Nonce = other
+		request, err := json.Marshal(otherReq)
+		require.NoError(t, err)
+
+		err = engine.Verify(context.Background(), presentation, request, "", "")
+		require.Error(t, err)
+	})
+	t.Run("tampered revealed value", func(t *testing.T) {
+		raw, enc := ursa.EncodeValue("Mallory")
+		tampered := *proof
+		tampered.RequestedProof = &schema.IndyRequestedProof{
+			RevealedAttrs: map[string]*schema.RevealedAttributeInfo{
+				"name": {SubProofIndex: 0, Raw: raw, Encoded: enc},
+			},
+		}
+		presentation, err := json.Marshal(tampered)
+		require.NoError(t, err)
+
+		err = engine.Verify(context.Background(), presentation, request, "", "")
+		require.Error(t, err)
+	})
+}
+
+type issuedCredential struct {
+	schema       *datastore.Schema
+	credDef      *vdr.ClaimDefData
+	credential   *schema.IndyCredential
+	prover       *cursa.Prover
+	masterSecret string
+}
+
+// issueCredential issues a degree credential to a new holder with the indy credential engine, using ledger for
+// the schema and cred def
+func issueCredential(t *testing.T, ledger *sim.Ledger, trustee *sim.Identity) *issuedCredential {
+	kh, err := kmsMock.CreateMockED25519KeyHandle()
+	require.NoError(t, err)
+	ks := insecurecleartextkeyset.KeysetMaterial(kh)
+	priv := &ed25519pb.Ed25519PrivateKey{}
+	require.NoError(t, proto.Unmarshal(ks.Key[0].KeyData.Value, priv))
+
+	pub := priv.PublicKey.KeyValue
+	did := base58.Encode(pub[:16])
+	require.NoError(t, ledger.CreateNym(did, base58.Encode(pub), vdr.EndorserRole, trustee.DID, trustee))
+	issuerDID := &datastore.DID{
+		DID:     &identifiers.DID{DIDVal: identifiers.DIDValue{MethodSpecificID: did, Method: "sov"}},
+		KeyPair: &datastore.KeyPair{ID: "issuer-key"},
+	}
+
+	prov := &indy.MockProvider{}
+	prov.On("IndyVDR").Return(ledger, nil)
+	prov.On("KMS").Return(&kmsMock.KeyManager{GetKeyValue: kh})
+	prov.On("StorageProvider").Return(mem.NewProvider())
+	prov.On("Oracle").Return(&cursa.CryptoOracle{})
+	issuer, err := indy.New(prov)
+	require.NoError(t, err)
+
+	s := &datastore.Schema{
+		Name:       "degree",
+		Version:    "1.0",
+		Attributes: []*datastore.Attribute{{Name: "name"}, {Name: "gpa"}},
+	}
+	s.ExternalSchemaID, err = issuer.CreateSchema(context.Background(), issuerDID, s)
+	require.NoError(t, err)
+	require.NoError(t, issuer.RegisterSchema(context.Background(), issuerDID, s))
+
+	offerID, offerAttachment, err := issuer.CreateCredentialOffer(context.Background(), issuerDID, "", s, nil)
+	require.NoError(t, err)
+	d, err := offerAttachment.Fetch()
+	require.NoError(t, err)
+	offer := &schema.IndyCredentialOffer{}
+	require.NoError(t, json.Unmarshal(d, offer))
+
+	rply, err := ledger.GetCredDef(offer.CredDefID)
+	require.NoError(t, err)
+	credDef := &vdr.ClaimDefData{ID: offer.CredDefID}
+	require.NoError(t, credDef.UnmarshalReadReply(rply))
+
+	holderProv := &indy.MockProvider{}
+	holderProv.On("StorageProvider").Return(mem.NewProvider())
+	prover, err := cursa.NewProver(holderProv)
+	require.NoError(t, err)
+	ms, err := prover.CreateMasterSecret("holder")
+	require.NoError(t, err)
+	credRequest, credRequestMetadata, err := prover.CreateCredentialRequest("did:sov:holder", credDef, offer, ms)
+	require.NoError(t, err)
+
+	values := map[string]interface{}{"name": "Alice", "gpa": "3.9"}
+	credAttachment, err := issuer.IssueCredential(context.Background(), issuerDID, s, offerID, decorator.AttachmentData{JSON: credRequest}, values)
+	require.NoError(t, err)
+	d, err = credAttachment.Fetch()
+	require.NoError(t, err)
+	cred := &schema.IndyCredential{}
+	require.NoError(t, json.Unmarshal(d, cred))
+
+	sig, err := prover.ProcessCredentialSignature(cred, credRequest, ms, credRequestMetadata.MasterSecretBlindingData, credDef.PKey())
+	require.NoError(t, err)
+	cred.Signature = []byte(sig)
+
+	return &issuedCredential{
+		schema:       s,
+		credDef:      credDef,
+		credential:   cred,
+		prover:       prover,
+		masterSecret: ms,
+	}
+}
@@ -1,6 +1,7 @@
 package jsonld
 
 import (
+	"context"
 	"encoding/base64"
 	"encoding/json"
 
@@ -67,7 +68,7 @@ type RequestPresentation struct {
 }
 
 // RequestPresentation
-func (r *Engine) RequestPresentation(name string, definitions *presexch.PresentationDefinitions) (*decorator.AttachmentData, error) {
+func (r *Engine) RequestPresentation(_ context.Context, name string, definitions *presexch.PresentationDefinitions) (*decorator.AttachmentData, error) {
 
 	rp := &RequestPresentation{
 		Domain:      CanisOperationalDomain,
@@ -90,6 +91,6 @@ func (r *Engine) RequestPresentationFormat() string {
 	return DIFPresentationExchange
 }
 
-func (r *Engine) Verify(presentation, request []byte, theirDID string, myDID string) error {
+func (r *Engine) Verify(_ context.Context, presentation, request []byte, theirDID string, myDID string) error {
 	return errors.New("not implemented")
 }
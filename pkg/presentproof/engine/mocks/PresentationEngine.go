@@ -3,11 +3,13 @@
 package mocks
 
 import (
+	context "context"
+
 	decorator "github.com/hyperledger/aries-framework-go/pkg/didcomm/protocol/decorator"
 
 	mock "github.com/stretchr/testify/mock"
 
-	schema "github.com/scoir/canis/pkg/schema"
+	presexch "github.com/hyperledger/aries-framework-go/pkg/doc/presexch"
 )
 
 // PresentationEngine is an autogenerated mock type for the PresentationEngine type
@@ -29,13 +31,13 @@ func (_m *PresentationEngine) Accept(typ string) bool {
 	return r0
 }
 
-// RequestPresentation provides a mock function with given fields: name, version, attrInfo, predicateInfo
-func (_m *PresentationEngine) RequestPresentation(name string, version string, attrInfo map[string]*schema.IndyProofRequestAttr, predicateInfo map[string]*schema.IndyProofRequestPredicate) (*decorator.AttachmentData, error) {
-	ret := _m.Called(name, version, attrInfo, predicateInfo)
+// RequestPresentation provides a mock function with given fields: ctx, name, definitions
+func (_m *PresentationEngine) RequestPresentation(ctx context.Context, name string, definitions *presexch.PresentationDefinitions) (*decorator.AttachmentData, error) {
+	ret := _m.Called(ctx, name, definitions)
 
 	var r0 *decorator.AttachmentData
-	if rf, ok := ret.Get(0).(func(string, string, map[string]*schema.IndyProofRequestAttr, map[string]*schema.IndyProofRequestPredicate) *decorator.AttachmentData); ok {
-		r0 = rf(name, version, attrInfo, predicateInfo)
+	if rf, ok := ret.Get(0).(func(context.Context, string, *presexch.PresentationDefinitions) *decorator.AttachmentData); ok {
+		r0 = rf(ctx, name, definitions)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*decorator.AttachmentData)
@@ -43,8 +45,8 @@ func (_m *PresentationEngine) RequestPresentation(name string, version string, a
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, string, map[string]*schema.IndyProofRequestAttr, map[string]*schema.IndyProofRequestPredicate) error); ok {
-		r1 = rf(name, version, attrInfo, predicateInfo)
+	if rf, ok := ret.Get(1).(func(context.Context, string, *presexch.PresentationDefinitions) error); ok {
+		r1 = rf(ctx, name, definitions)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -66,13 +68,13 @@ func (_m *PresentationEngine) RequestPresentationFormat() string {
 	return r0
 }
 
-// Verify provides a mock function with given fields: presentation, request, theirDID, myDID
-func (_m *PresentationEngine) Verify(presentation []byte, request []byte, theirDID string, myDID string) error {
-	ret := _m.Called(presentation, request, theirDID, myDID)
+// Verify provides a mock function with given fields: ctx, presentation, request, theirDID, myDID
+func (_m *PresentationEngine) Verify(ctx context.Context, presentation []byte, request []byte, theirDID string, myDID string) error {
+	ret := _m.Called(ctx, presentation, request, theirDID, myDID)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func([]byte, []byte, string, string) error); ok {
-		r0 = rf(presentation, request, theirDID, myDID)
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, []byte, string, string) error); ok {
+		r0 = rf(ctx, presentation, request, theirDID, myDID)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -3,11 +3,13 @@
 package mocks
 
 import (
+	context "context"
+
 	decorator "github.com/hyperledger/aries-framework-go/pkg/didcomm/protocol/decorator"
 
 	mock "github.com/stretchr/testify/mock"
 
-	schema "github.com/scoir/canis/pkg/schema"
+	presexch "github.com/hyperledger/aries-framework-go/pkg/doc/presexch"
 )
 
 // PresentationRegistry is an autogenerated mock type for the PresentationRegistry type
@@ -15,13 +17,13 @@ type PresentationRegistry struct {
 	mock.Mock
 }
 
-// RequestPresentation provides a mock function with given fields: name, version, typ, attrInfo, predicateInfo
-func (_m *PresentationRegistry) RequestPresentation(name string, version string, typ string, attrInfo map[string]*schema.IndyProofRequestAttr, predicateInfo map[string]*schema.IndyProofRequestPredicate) (*decorator.AttachmentData, error) {
-	ret := _m.Called(name, version, typ, attrInfo, predicateInfo)
+// RequestPresentation provides a mock function with given fields: ctx, name, typ, definitions
+func (_m *PresentationRegistry) RequestPresentation(ctx context.Context, name string, typ string, definitions *presexch.PresentationDefinitions) (*decorator.AttachmentData, error) {
+	ret := _m.Called(ctx, name, typ, definitions)
 
 	var r0 *decorator.AttachmentData
-	if rf, ok := ret.Get(0).(func(string, string, string, map[string]*schema.IndyProofRequestAttr, map[string]*schema.IndyProofRequestPredicate) *decorator.AttachmentData); ok {
-		r0 = rf(name, version, typ, attrInfo, predicateInfo)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *presexch.PresentationDefinitions) *decorator.AttachmentData); ok {
+		r0 = rf(ctx, name, typ, definitions)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*decorator.AttachmentData)
@@ -29,8 +31,8 @@ func (_m *PresentationRegistry) RequestPresentation(name string, version string,
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, string, string, map[string]*schema.IndyProofRequestAttr, map[string]*schema.IndyProofRequestPredicate) error); ok {
-		r1 = rf(name, version, typ, attrInfo, predicateInfo)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, *presexch.PresentationDefinitions) error); ok {
+		r1 = rf(ctx, name, typ, definitions)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -38,13 +40,13 @@ func (_m *PresentationRegistry) RequestPresentation(name string, version string,
 	return r0, r1
 }
 
-// Verify provides a mock function with given fields: format, presentation, request, theirDID, myDID
-func (_m *PresentationRegistry) Verify(format string, presentation []byte, request []byte, theirDID string, myDID string) error {
-	ret := _m.Called(format, presentation, request, theirDID, myDID)
+// Verify provides a mock function with given fields: ctx, format, presentation, request, theirDID, myDID
+func (_m *PresentationRegistry) Verify(ctx context.Context, format string, presentation []byte, request []byte, theirDID string, myDID string) error {
+	ret := _m.Called(ctx, format, presentation, request, theirDID, myDID)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string, []byte, []byte, string, string) error); ok {
-		r0 = rf(format, presentation, request, theirDID, myDID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, []byte, []byte, string, string) error); ok {
+		r0 = rf(ctx, format, presentation, request, theirDID, myDID)
 	} else {
 		r0 = ret.Error(0)
 	}
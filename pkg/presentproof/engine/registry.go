@@ -1,12 +1,16 @@
 package engine
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/hyperledger/aries-framework-go/pkg/didcomm/protocol/decorator"
 	"github.com/hyperledger/aries-framework-go/pkg/doc/presexch"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/scoir/canis/pkg/datastore"
+	"github.com/scoir/canis/pkg/tracing"
 )
 
 const PresentProofType = "https://didcomm.org/present-proof/2.0/request-presentation"
@@ -14,15 +18,15 @@ const PresentProofType = "https://didcomm.org/present-proof/2.0/request-presenta
 //go:generate mockery -name=PresentationEngine
 type PresentationEngine interface {
 	Accept(typ string) bool
-	RequestPresentation(name string, definitions *presexch.PresentationDefinitions) (*decorator.AttachmentData, error)
+	RequestPresentation(ctx context.Context, name string, definitions *presexch.PresentationDefinitions) (*decorator.AttachmentData, error)
 	RequestPresentationFormat() string
-	Verify(presentation, request []byte, theirDID string, myDID string) error
+	Verify(ctx context.Context, presentation, request []byte, theirDID string, myDID string) error
 }
 
 //go:generate mockery -name=PresentationRegistry
 type PresentationRegistry interface {
-	RequestPresentation(name, typ string, definitions *presexch.PresentationDefinitions) (*decorator.AttachmentData, error)
-	Verify(format string, presentation, request []byte, theirDID string, myDID string) error
+	RequestPresentation(ctx context.Context, name, typ string, definitions *presexch.PresentationDefinitions) (*decorator.AttachmentData, error)
+	Verify(ctx context.Context, format string, presentation, request []byte, theirDID string, myDID string) error
 }
 
 type Option func(opts *Registry)
@@ -47,27 +51,37 @@ func New(prov provider, opts ...Option) *Registry {
 }
 
 // RequestPresentation
-func (r *Registry) RequestPresentation(name, typ string, definitions *presexch.PresentationDefinitions) (*decorator.AttachmentData, error) {
+func (r *Registry) RequestPresentation(ctx context.Context, name, typ string, definitions *presexch.PresentationDefinitions) (_ *decorator.AttachmentData, err error) {
+	ctx, span := startSpan(ctx, "RequestPresentation", typ)
+	defer func() { tracing.End(span, err) }()
 
 	e, err := r.resolveEngine(typ)
 	if err != nil {
 		return nil, err
 	}
 
-	return e.RequestPresentation(name, definitions)
+	return e.RequestPresentation(ctx, name, definitions)
 }
 
-func (r *Registry) Verify(format string, presentation, request []byte, theirDID string, myDID string) error {
+func (r *Registry) Verify(ctx context.Context, format string, presentation, request []byte, theirDID string, myDID string) (err error) {
+	ctx, span := startSpan(ctx, "Verify", format)
+	defer func() { tracing.End(span, err) }()
 
 	e, err := r.resolveEngine(format)
 	if err != nil {
 		return err
 	}
 
-	return e.Verify(presentation, request, theirDID, myDID)
+	return e.Verify(ctx, presentation, request, theirDID, myDID)
 
 }
 
+// startSpan traces a presentation engine call as part of the trace of ctx and returns the context for the engine
+func startSpan(ctx context.Context, op, format string) (context.Context, trace.Span) {
+	return tracing.Start(ctx, "presentproof.engine "+op,
+		trace.WithAttributes(attribute.String("presentation.format", format)))
+}
+
 func (r *Registry) resolveEngine(method string) (PresentationEngine, error) {
 	for _, e := range r.engines {
 		if e.Accept(method) {
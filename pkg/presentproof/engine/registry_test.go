@@ -1,6 +1,7 @@
 package engine
 
 import (
+	"context"
 	"encoding/base64"
 	"testing"
 
@@ -99,7 +100,7 @@ func TestVerify(t *testing.T) {
 			DoesAccept: true,
 		}))
 
-		err := reg.Verify("format", []byte{}, []byte{}, "did:sov:123", "did:sov:abc")
+		err := reg.Verify(context.Background(), "format", []byte{}, []byte{}, "did:sov:123", "did:sov:abc")
 		require.NoError(t, err)
 	})
 	t.Run("no engine error", func(t *testing.T) {
@@ -110,7 +111,7 @@ func TestVerify(t *testing.T) {
 			DoesAccept: false,
 		}))
 
-		err := reg.Verify("format", []byte{}, []byte{}, "did:sov:123", "did:sov:abc")
+		err := reg.Verify(context.Background(), "format", []byte{}, []byte{}, "did:sov:123", "did:sov:abc")
 		require.Error(t, err)
 	})
 }
@@ -135,7 +136,7 @@ func (r *engineMock) RequestPresentation(name, version string, attrInfo map[stri
 	return nil, nil
 }
 
-func (r *engineMock) Verify(presentation, request []byte, theirDID string, myDID string) error {
+func (r *engineMock) Verify(_ context.Context, presentation, request []byte, theirDID string, myDID string) error {
 	return r.VerifyErr
 }
 
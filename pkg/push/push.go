@@ -0,0 +1,118 @@
+/*
+Copyright Scoir Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package push
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/scoir/canis/pkg/framework"
+)
+
+// SignatureHeader carries the hex HMAC-SHA256 of the request body when the webhook has a secret
+const SignatureHeader = "X-Canis-Signature"
+
+const defaultTimeout = 10 * time.Second
+
+// Notifier wakes up the device registered with a push token so it connects and collects what is waiting
+// for it.  Notifications never carry message content
+type Notifier interface {
+	Wake(token string) error
+}
+
+// New returns the notifier for the configuration, one that drops notifications when push is not configured
+func New(conf *framework.PushConfig) Notifier {
+	if conf == nil || conf.WebhookURL == "" {
+		return Nop{}
+	}
+
+	return NewWebhook(conf)
+}
+
+// Nop is the notifier used when push notifications are disabled
+type Nop struct{}
+
+// Wake does nothing
+func (Nop) Wake(_ string) error {
+	return nil
+}
+
+// Wakeup is the body posted to the webhook
+type Wakeup struct {
+	Token     string `json:"token"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+// Webhook posts wake-ups to an HTTP endpoint that bridges them to a push service such as FCM or APNs
+type Webhook struct {
+	url    string
+	secret []byte
+	client *http.Client
+}
+
+// NewWebhook returns a notifier that posts to the configured webhook
+func NewWebhook(conf *framework.PushConfig) *Webhook {
+	timeout := conf.Timeout
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+
+	return &Webhook{
+		url:    conf.WebhookURL,
+		secret: []byte(conf.Secret),
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+// Wake posts a wake-up for token to the webhook
+func (r *Webhook) Wake(token string) error {
+	d, err := json.Marshal(&Wakeup{
+		Token:     token,
+		Timestamp: time.Now().Unix(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "unable to marshal wake-up")
+	}
+
+	req, err := http.NewRequest(http.MethodPost, r.url, bytes.NewBuffer(d))
+	if err != nil {
+		return errors.Wrap(err, "unable to create wake-up request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if len(r.secret) > 0 {
+		req.Header.Set(SignatureHeader, Sign(r.secret, d))
+	}
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "unable to post wake-up to %s", r.url)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return errors.Errorf("push webhook %s returned %s", r.url, resp.Status)
+	}
+
+	return nil
+}
+
+// Sign returns the signature of a webhook body, bridges use it to check wake-ups came from Canis
+func Sign(secret, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	_, _ = mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,72 @@
+/*
+Copyright Scoir Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package push
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/scoir/canis/pkg/framework"
+)
+
+func TestNew(t *testing.T) {
+	require.IsType(t, Nop{}, New(nil))
+	require.IsType(t, Nop{}, New(&framework.PushConfig{}))
+	require.IsType(t, &Webhook{}, New(&framework.PushConfig{WebhookURL: "http://localhost"}))
+}
+
+func TestWebhook_Wake(t *testing.T) {
+	t.Run("happy path", func(t *testing.T) {
+		wakeups := make(chan *Wakeup, 1)
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			d, err := ioutil.ReadAll(req.Body)
+			require.NoError(t, err)
+			require.Equal(t, Sign([]byte("secret"), d), req.Header.Get(SignatureHeader))
+
+			wakeup := &Wakeup{}
+			require.NoError(t, json.Unmarshal(d, wakeup))
+			w.WriteHeader(http.StatusNoContent)
+			wakeups <- wakeup
+		}))
+		defer srv.Close()
+
+		target := NewWebhook(&framework.PushConfig{WebhookURL: srv.URL, Secret: "secret"})
+		err := target.Wake("device-token")
+		require.NoError(t, err)
+
+		wakeup := <-wakeups
+		require.Equal(t, "device-token", wakeup.Token)
+		require.NotZero(t, wakeup.Timestamp)
+	})
+
+	t.Run("unsigned", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			require.Empty(t, req.Header.Get(SignatureHeader))
+		}))
+		defer srv.Close()
+
+		target := NewWebhook(&framework.PushConfig{WebhookURL: srv.URL})
+		require.NoError(t, target.Wake("device-token"))
+	})
+
+	t.Run("bridge error", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			w.WriteHeader(http.StatusBadGateway)
+		}))
+		defer srv.Close()
+
+		target := NewWebhook(&framework.PushConfig{WebhookURL: srv.URL})
+		err := target.Wake("device-token")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "502")
+	})
+}
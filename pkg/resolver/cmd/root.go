@@ -67,9 +67,14 @@ func initConfig() {
 		log.Fatalln("unable to create VDR client")
 	}
 
+	lc, err := conf.LedgerCache()
+	if err != nil {
+		log.Fatalln("invalid ledgerCache configuration", err)
+	}
+
 	prov = &Provider{
 		conf:       conf,
-		indyClient: cl,
+		indyClient: indywrapper.NewCachingClient(indywrapper.NewTracedClient(cl), indywrapper.NewLedgerCache(lc), ""),
 	}
 
 }
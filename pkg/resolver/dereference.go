@@ -0,0 +1,111 @@
+package resolver
+
+import (
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// diddocContent is the ATTRIB holding DID document content added to the document built from the NYM
+const diddocContent = "diddocContent"
+
+var fragmentSections = []string{
+	"publicKey",
+	"verificationMethod",
+	"authentication",
+	"assertionMethod",
+	"keyAgreement",
+	"capabilityInvocation",
+	"capabilityDelegation",
+	"service",
+}
+
+// mergeDIDDocContent adds content to doc.  Contexts and arrays such as verificationMethod and service are
+// extended, other properties are only set when not already in doc so content can not replace the NYM keys
+func mergeDIDDocContent(doc, content map[string]interface{}) {
+	for k, v := range content {
+		if k == "id" {
+			continue
+		}
+
+		existing, ok := doc[k]
+		if !ok || existing == nil {
+			doc[k] = v
+			continue
+		}
+
+		if _, isArray := existing.([]interface{}); isArray || k == "@context" {
+			doc[k] = append(values(existing), values(v)...)
+		}
+	}
+}
+
+func values(v interface{}) []interface{} {
+	if a, ok := v.([]interface{}); ok {
+		return a
+	}
+
+	return []interface{}{v}
+}
+
+// dereferenceFragment returns the verification method or service in doc identified by fragment
+func dereferenceFragment(doc map[string]interface{}, did, fragment string) (map[string]interface{}, error) {
+	for _, section := range fragmentSections {
+		if obj, ok := findByID(doc, section, did, fragment); ok {
+			return obj, nil
+		}
+	}
+
+	return nil, resolutionError(NotFound, errors.Errorf("%s#%s not found in DID document", did, fragment))
+}
+
+// dereferenceService returns the endpoint of the service in doc named service, with relativeRef appended
+func dereferenceService(doc map[string]interface{}, did, service, relativeRef string) (string, error) {
+	obj, ok := findByID(doc, "service", did, service)
+	if !ok {
+		return "", resolutionError(NotFound, errors.Errorf("service %s not found in DID document", service))
+	}
+
+	ep, ok := obj["serviceEndpoint"].(string)
+	if !ok || ep == "" {
+		return "", resolutionError(NotFound, errors.Errorf("service %s has no endpoint", service))
+	}
+
+	if relativeRef != "" {
+		ep = strings.TrimSuffix(ep, "/") + "/" + strings.TrimPrefix(relativeRef, "/")
+	}
+
+	return ep, nil
+}
+
+func findByID(doc map[string]interface{}, section, did, fragment string) (map[string]interface{}, bool) {
+	items, _ := doc[section].([]interface{})
+	for _, item := range items {
+		obj, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		id, _ := obj["id"].(string)
+		if id == "#"+fragment || id == did+"#"+fragment {
+			return obj, true
+		}
+	}
+
+	return nil, false
+}
+
+func (r *HTTPIndyResolver) dereferencingResult(obj map[string]interface{}, accept string,
+	contentMetadata map[string]interface{}, start time.Time) map[string]interface{} {
+
+	md := r.resolutionMetadata(start)
+	md["contentType"] = accept
+
+	return map[string]interface{}{
+		"@context":              context,
+		"contentStream":         obj,
+		"dereferencingMetadata": md,
+		"contentMetadata":       contentMetadata,
+	}
+}
@@ -2,9 +2,13 @@ package resolver
 
 import (
 	"encoding/json"
+	"expvar"
 	"fmt"
 	"log"
+	"mime"
 	"net/http"
+	"net/url"
+	"strings"
 	"time"
 
 	"github.com/btcsuite/btcutil/base58"
@@ -17,17 +21,40 @@ import (
 	"goji.io/pat"
 
 	indywrapper "github.com/scoir/canis/pkg/indy"
-	"github.com/scoir/canis/pkg/util"
 )
 
 const (
-	context  = "https://www.w3.org/ns/did-resolution/v1"
+	context  = "https://w3id.org/did-resolution/v1"
 	schemaV1 = "https://w3id.org/did/v1"
 	keyType  = "Ed25519VerificationKey2018"
 	driverID = "did:%s"
 	driver   = "CanisHttpIndyDriver"
 )
 
+// Representations of a resolved DID negotiated with the Accept header
+const (
+	DIDLDJSON        = "application/did+ld+json"
+	DIDJSON          = "application/did+json"
+	ResolutionResult = `application/ld+json;profile="https://w3id.org/did-resolution"`
+)
+
+// Errors reported in didResolutionMetadata
+const (
+	InvalidDID                 = "invalidDid"
+	NotFound                   = "notFound"
+	MethodNotSupported         = "methodNotSupported"
+	RepresentationNotSupported = "representationNotSupported"
+	InternalError              = "internalError"
+)
+
+var errorStatus = map[string]int{
+	InvalidDID:                 http.StatusBadRequest,
+	NotFound:                   http.StatusNotFound,
+	MethodNotSupported:         http.StatusNotImplemented,
+	RepresentationNotSupported: http.StatusNotAcceptable,
+	InternalError:              http.StatusInternalServerError,
+}
+
 type HTTPIndyResolver struct {
 	addr       string
 	methodName string
@@ -39,10 +66,24 @@ type provider interface {
 }
 
 type didResolution struct {
-	Context          interface{}            `json:"@context"`
-	DIDDocument      map[string]interface{} `json:"didDocument"`
-	ResolverMetadata map[string]interface{} `json:"resolverMetadata"`
-	MethodMetadata   map[string]interface{} `json:"methodMetadata"`
+	Context               interface{}            `json:"@context"`
+	DIDDocument           map[string]interface{} `json:"didDocument"`
+	DIDResolutionMetadata map[string]interface{} `json:"didResolutionMetadata"`
+	DIDDocumentMetadata   map[string]interface{} `json:"didDocumentMetadata"`
+}
+
+// ResolutionError is a failure to resolve or dereference a DID with its didResolutionMetadata error code
+type ResolutionError struct {
+	Code string
+	Err  error
+}
+
+func (r *ResolutionError) Error() string {
+	return fmt.Sprintf("%s: %v", r.Code, r.Err)
+}
+
+func resolutionError(code string, err error) *ResolutionError {
+	return &ResolutionError{Code: code, Err: err}
 }
 
 func NewHTTPIndyResolver(addr, method string, ctx provider) *HTTPIndyResolver {
@@ -56,73 +97,180 @@ func NewHTTPIndyResolver(addr, method string, ctx provider) *HTTPIndyResolver {
 }
 
 func (r *HTTPIndyResolver) Start() error {
+	log.Println("http indy resolver listening on", r.addr)
+	return http.ListenAndServe(r.addr, r.handler())
+}
+
+func (r *HTTPIndyResolver) handler() http.Handler {
 	mux := goji.NewMux()
 	mux.Handle(pat.Get("/did/:did"), http.HandlerFunc(r.resolve))
+	mux.Handle(pat.Get("/1.0/identifiers/:did"), http.HandlerFunc(r.resolve))
+	mux.Handle(pat.Get("/debug/vars"), expvar.Handler())
 
-	log.Println("http indy resolver listening on", r.addr)
-	return http.ListenAndServe(r.addr, mux)
+	return mux
 }
 
+// resolve resolves or dereferences a DID URL.  The fragment and query of the DID URL may be percent
+// encoded in the path or, for the query, sent as the request query
 func (r *HTTPIndyResolver) resolve(w http.ResponseWriter, req *http.Request) {
-	did := pat.Param(req, "did")
+	start := time.Now()
+
+	didURL, err := url.PathUnescape(pat.Param(req, "did"))
+	if err != nil {
+		r.writeError(w, resolutionError(InvalidDID, err), start)
+		return
+	}
+
+	if req.URL.RawQuery != "" {
+		didURL = didURL + "?" + req.URL.RawQuery
+	}
+
+	accept := negotiate(req.Header.Get("Accept"))
+	if accept == "" {
+		r.writeError(w, resolutionError(RepresentationNotSupported,
+			errors.Errorf("unsupported representation %s", req.Header.Get("Accept"))), start)
+		return
+	}
+
+	did, query, fragment, err := splitDIDURL(didURL)
+	if err != nil {
+		r.writeError(w, resolutionError(InvalidDID, err), start)
+		return
+	}
 
 	out, err := r.Read(did)
 	if err != nil {
-		util.WriteError(w, err.Error())
+		r.writeError(w, err, start)
 		return
 	}
 
-	d, _ := json.MarshalIndent(out, " ", " ")
-	util.WriteSuccess(w, d)
+	if service := query.Get("service"); service != "" {
+		ep, err := dereferenceService(out.DIDDocument, did, service, query.Get("relativeRef"))
+		if err != nil {
+			r.writeError(w, err, start)
+			return
+		}
+
+		http.Redirect(w, req, ep, http.StatusSeeOther)
+		return
+	}
+
+	if fragment != "" {
+		obj, err := dereferenceFragment(out.DIDDocument, did, fragment)
+		if err != nil {
+			r.writeError(w, err, start)
+			return
+		}
+
+		r.write(w, accept, http.StatusOK, r.dereferencingResult(obj, accept, out.DIDDocumentMetadata, start), obj)
+		return
+	}
+
+	out.DIDResolutionMetadata["contentType"] = accept
+	r.write(w, accept, http.StatusOK, out, out.DIDDocument)
+}
+
+// write sends the resolution (or dereferencing) result for the resolution result representation,
+// otherwise only the content
+func (r *HTTPIndyResolver) write(w http.ResponseWriter, accept string, code int, result, content interface{}) {
+	body := content
+	if accept == ResolutionResult {
+		body = result
+	}
+
+	d, _ := json.MarshalIndent(body, " ", " ")
+	w.Header().Set("Content-Type", accept)
+	w.WriteHeader(code)
+	_, _ = w.Write(d)
+}
+
+func (r *HTTPIndyResolver) writeError(w http.ResponseWriter, err error, start time.Time) {
+	rerr, ok := err.(*ResolutionError)
+	if !ok {
+		rerr = resolutionError(InternalError, err)
+	}
+
+	out := &didResolution{
+		Context:               context,
+		DIDResolutionMetadata: r.resolutionMetadata(start),
+		DIDDocumentMetadata:   map[string]interface{}{},
+	}
+	out.DIDResolutionMetadata["error"] = rerr.Code
+	out.DIDResolutionMetadata["errorMessage"] = rerr.Err.Error()
+
+	r.write(w, ResolutionResult, errorStatus[rerr.Code], out, out)
+}
+
+func (r *HTTPIndyResolver) resolutionMetadata(start time.Time) map[string]interface{} {
+	end := time.Now()
+	return map[string]interface{}{
+		"driverId":  fmt.Sprintf(driverID, r.methodName),
+		"driver":    driver,
+		"retrieved": end,
+		"duration":  end.Sub(start).Milliseconds(),
+	}
 }
 
 func (r *HTTPIndyResolver) Read(did string) (*didResolution, error) {
 	start := time.Now()
 	parsedDID, err := diddoc.Parse(did)
 	if err != nil {
-		return nil, fmt.Errorf("parsing did failed in indy resolver: (%w)", err)
+		return nil, resolutionError(InvalidDID, fmt.Errorf("parsing did failed in indy resolver: (%w)", err))
 	}
 
 	if parsedDID.Method != r.methodName {
-		return nil, fmt.Errorf("invalid indy method ID: %s", parsedDID.MethodSpecificID)
+		return nil, resolutionError(MethodNotSupported, fmt.Errorf("unsupported DID method: %s", parsedDID.Method))
 	}
 
 	rply, err := r.client.GetNym(parsedDID.MethodSpecificID)
 	if err != nil {
-		return nil, err
+		return nil, resolutionError(InternalError, errors.Wrap(err, "unable to read nym from ledger"))
+	}
+
+	data, ok := rply.Data.(string)
+	if !ok || data == "" {
+		return nil, resolutionError(NotFound, errors.Errorf("DID %s not found on ledger", did))
 	}
 
 	nymResp := map[string]interface{}{}
-	err = json.Unmarshal([]byte(rply.Data.(string)), &nymResp)
+	err = json.Unmarshal([]byte(data), &nymResp)
 	if err != nil {
-		return nil, err
+		return nil, resolutionError(InternalError, errors.Wrap(err, "invalid nym on ledger"))
 	}
 
-	//TODO: support multiple pubkeys
 	txnTime := time.Unix(int64(rply.TxnTime), 0)
 	verkey, _ := nymResp["verkey"].(string)
 	pubKeyValue := base58.Decode(verkey)
 
 	KID, err := localkms.CreateKID(pubKeyValue, kms.ED25519Type)
 	if err != nil {
-		return nil, err
+		return nil, resolutionError(InternalError, err)
 	}
 
 	pubKey := diddoc.NewPublicKeyFromBytes("#"+KID, keyType, "#id", pubKeyValue)
 	verMethod := diddoc.NewReferencedVerificationMethod(pubKey, diddoc.Authentication, true)
 
+	content, contentResp, err := r.getDIDDocContent(parsedDID.MethodSpecificID)
+	if err != nil {
+		return nil, resolutionError(InternalError, err)
+	}
+
 	var svc []diddoc.Service
-	serviceEndpoint, attrResp, err := r.getEndpoint(parsedDID.MethodSpecificID)
-	if err == nil {
-		s := diddoc.Service{
-			ID:              "#agent",
-			Type:            vdriapi.DIDCommServiceType,
-			ServiceEndpoint: serviceEndpoint,
-			Priority:        0,
-			RecipientKeys:   []string{verkey},
-		}
+	var attrResp map[string]interface{}
+	if content == nil {
+		var serviceEndpoint string
+		serviceEndpoint, attrResp, err = r.getEndpoint(parsedDID.MethodSpecificID)
+		if err == nil {
+			s := diddoc.Service{
+				ID:              "#agent",
+				Type:            vdriapi.DIDCommServiceType,
+				ServiceEndpoint: serviceEndpoint,
+				Priority:        0,
+				RecipientKeys:   []string{verkey},
+			}
 
-		svc = append(svc, s)
+			svc = append(svc, s)
+		}
 	}
 
 	doc := &diddoc.Doc{
@@ -135,28 +283,29 @@ func (r *HTTPIndyResolver) Read(did string) (*didResolution, error) {
 		Updated:        &txnTime,
 	}
 
-	end := time.Now()
-	dur := end.Sub(start)
 	out := &didResolution{
-		Context:     context,
-		DIDDocument: map[string]interface{}{},
-		ResolverMetadata: map[string]interface{}{
-			"driverId":  fmt.Sprintf(driverID, r.methodName),
-			"driver":    driver,
-			"retrieved": end,
-			"duration":  dur.Milliseconds(),
-		},
-		MethodMetadata: map[string]interface{}{
-			"nymResponse":  nymResp,
-			"attrResponse": attrResp,
+		Context:               context,
+		DIDDocument:           map[string]interface{}{},
+		DIDResolutionMetadata: r.resolutionMetadata(start),
+		DIDDocumentMetadata: map[string]interface{}{
+			"created": txnTime,
+			"updated": txnTime,
+			"method": map[string]interface{}{
+				"nymResponse":           nymResp,
+				"attrResponse":          attrResp,
+				"diddocContentResponse": contentResp,
+			},
 		},
 	}
 
 	d, _ := json.Marshal(doc)
 	err = json.Unmarshal(d, &out.DIDDocument)
 	if err != nil {
-		return nil, errors.Wrap(err, "unable to unmarshal doc")
+		return nil, resolutionError(InternalError, errors.Wrap(err, "unable to unmarshal doc"))
 	}
+
+	mergeDIDDocContent(out.DIDDocument, content)
+
 	return out, nil
 }
 
@@ -184,3 +333,84 @@ func (r *HTTPIndyResolver) getEndpoint(did string) (string, map[string]interface
 
 	return ep, resp, nil
 }
+
+// getDIDDocContent reads the diddocContent ATTRIB of did, returning a nil content if there is none
+func (r *HTTPIndyResolver) getDIDDocContent(did string) (map[string]interface{}, map[string]interface{}, error) {
+	rply, err := r.client.GetAttrib(did, diddocContent)
+	if err != nil || rply.Data == nil {
+		return nil, nil, nil
+	}
+
+	data, ok := rply.Data.(string)
+	if !ok || data == "" {
+		return nil, nil, nil
+	}
+
+	resp := map[string]interface{}{}
+	err = json.Unmarshal([]byte(data), &resp)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "invalid diddocContent on ledger")
+	}
+
+	// the content may be stored as an object or as a JSON encoded string
+	switch content := resp[diddocContent].(type) {
+	case map[string]interface{}:
+		return content, resp, nil
+	case string:
+		m := map[string]interface{}{}
+		err = json.Unmarshal([]byte(content), &m)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "invalid diddocContent on ledger")
+		}
+		return m, resp, nil
+	}
+
+	return nil, nil, nil
+}
+
+// negotiate returns the representation for an Accept header, empty if none are supported
+func negotiate(accept string) string {
+	if strings.TrimSpace(accept) == "" {
+		return ResolutionResult
+	}
+
+	for _, rng := range strings.Split(accept, ",") {
+		mt, params, err := mime.ParseMediaType(strings.TrimSpace(rng))
+		if err != nil {
+			continue
+		}
+
+		switch mt {
+		case DIDLDJSON, DIDJSON:
+			return mt
+		case "application/ld+json":
+			if params["profile"] == "https://w3id.org/did-resolution" {
+				return ResolutionResult
+			}
+		case "application/json", "application/*", "*/*":
+			return ResolutionResult
+		}
+	}
+
+	return ""
+}
+
+// splitDIDURL splits a DID URL into the DID, its query parameters and fragment
+func splitDIDURL(didURL string) (string, url.Values, string, error) {
+	did, fragment := didURL, ""
+	if i := strings.Index(did, "#"); i >= 0 {
+		did, fragment = did[:i], did[i+1:]
+	}
+
+	query := url.Values{}
+	if i := strings.Index(did, "?"); i >= 0 {
+		var err error
+		query, err = url.ParseQuery(did[i+1:])
+		if err != nil {
+			return "", nil, "", errors.Wrap(err, "invalid DID URL query")
+		}
+		did = did[:i]
+	}
+
+	return did, query, fragment, nil
+}
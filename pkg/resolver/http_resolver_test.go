@@ -0,0 +1,155 @@
+package resolver
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hyperledger/indy-vdr/wrappers/golang/vdr"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	indywrapper "github.com/scoir/canis/pkg/indy"
+	"github.com/scoir/canis/pkg/indy/mocks"
+)
+
+const (
+	testDID = "did:sov:WgWxqztrNooG92RXvxSTWv"
+	testNym = `{"dest":"WgWxqztrNooG92RXvxSTWv","verkey":"H3C2AVvLMv6gmMNam3uVAjZpfkcJCwDwnZn6z3wXmqPV"}`
+)
+
+type testProvider struct {
+	client indywrapper.IndyVDRClient
+}
+
+func (r *testProvider) IndyVDR() indywrapper.IndyVDRClient {
+	return r.client
+}
+
+func newTestResolver(client *mocks.IndyVDRClient) http.Handler {
+	return NewHTTPIndyResolver(":0", "sov", &testProvider{client: client}).handler()
+}
+
+func get(h http.Handler, path, accept string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	return w
+}
+
+func TestResolve(t *testing.T) {
+	client := &mocks.IndyVDRClient{}
+	client.On("GetNym", "WgWxqztrNooG92RXvxSTWv").Return(&vdr.ReadReply{Data: testNym, TxnTime: 1604188800}, nil)
+	client.On("GetAttrib", "WgWxqztrNooG92RXvxSTWv", "diddocContent").Return(&vdr.ReadReply{}, nil)
+	client.On("GetEndpoint", "WgWxqztrNooG92RXvxSTWv").Return(&vdr.ReadReply{
+		Data: `{"endpoint":{"endpoint":"https://agent.example.com"}}`,
+	}, nil)
+	client.On("GetNym", "missing").Return(&vdr.ReadReply{}, nil)
+	client.On("GetNym", "broken").Return(nil, errors.New("boom"))
+	h := newTestResolver(client)
+
+	t.Run("resolution result by default", func(t *testing.T) {
+		w := get(h, "/1.0/identifiers/"+testDID, "")
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Equal(t, ResolutionResult, w.Header().Get("Content-Type"))
+
+		out := &didResolution{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), out))
+		require.Equal(t, testDID, out.DIDDocument["id"])
+		require.Equal(t, ResolutionResult, out.DIDResolutionMetadata["contentType"])
+		require.NotNil(t, out.DIDDocumentMetadata["created"])
+	})
+	t.Run("did document", func(t *testing.T) {
+		w := get(h, "/did/"+testDID, "application/did+ld+json")
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Equal(t, DIDLDJSON, w.Header().Get("Content-Type"))
+
+		doc := map[string]interface{}{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &doc))
+		require.Equal(t, testDID, doc["id"])
+	})
+	t.Run("unsupported representation", func(t *testing.T) {
+		w := get(h, "/did/"+testDID, "text/html")
+		require.Equal(t, http.StatusNotAcceptable, w.Code)
+		require.Contains(t, w.Body.String(), RepresentationNotSupported)
+	})
+	t.Run("not found", func(t *testing.T) {
+		w := get(h, "/did/did:sov:missing", "")
+		require.Equal(t, http.StatusNotFound, w.Code)
+		require.Contains(t, w.Body.String(), `"error": "notFound"`)
+	})
+	t.Run("invalid did", func(t *testing.T) {
+		w := get(h, "/did/notadid", "")
+		require.Equal(t, http.StatusBadRequest, w.Code)
+		require.Contains(t, w.Body.String(), InvalidDID)
+	})
+	t.Run("method not supported", func(t *testing.T) {
+		w := get(h, "/did/did:web:example.com", "")
+		require.Equal(t, http.StatusNotImplemented, w.Code)
+		require.Contains(t, w.Body.String(), MethodNotSupported)
+	})
+	t.Run("ledger error", func(t *testing.T) {
+		w := get(h, "/did/did:sov:broken", "")
+		require.Equal(t, http.StatusInternalServerError, w.Code)
+		require.Contains(t, w.Body.String(), InternalError)
+	})
+	t.Run("service", func(t *testing.T) {
+		w := get(h, "/did/"+testDID+"?service=agent&relativeRef=/inbox", "")
+		require.Equal(t, http.StatusSeeOther, w.Code)
+		require.Equal(t, "https://agent.example.com/inbox", w.Header().Get("Location"))
+	})
+	t.Run("unknown service", func(t *testing.T) {
+		w := get(h, "/did/"+testDID+"?service=hub", "")
+		require.Equal(t, http.StatusNotFound, w.Code)
+	})
+	t.Run("fragment", func(t *testing.T) {
+		w := get(h, "/did/"+testDID+"%23agent", "application/did+json")
+		require.Equal(t, http.StatusOK, w.Code)
+
+		obj := map[string]interface{}{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &obj))
+		require.Equal(t, "https://agent.example.com", obj["serviceEndpoint"])
+	})
+	t.Run("fragment dereferencing result", func(t *testing.T) {
+		w := get(h, "/did/"+testDID+"%23agent", "")
+		require.Equal(t, http.StatusOK, w.Code)
+
+		out := map[string]interface{}{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &out))
+		require.NotNil(t, out["contentStream"])
+		require.NotNil(t, out["dereferencingMetadata"])
+	})
+	t.Run("unknown fragment", func(t *testing.T) {
+		w := get(h, "/did/"+testDID+"%23nope", "")
+		require.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestResolveDIDDocContent(t *testing.T) {
+	client := &mocks.IndyVDRClient{}
+	client.On("GetNym", "WgWxqztrNooG92RXvxSTWv").Return(&vdr.ReadReply{Data: testNym}, nil)
+	client.On("GetAttrib", "WgWxqztrNooG92RXvxSTWv", "diddocContent").Return(&vdr.ReadReply{
+		Data: `{"diddocContent":{"@context":["https://identity.foundation/didcomm-messaging/service-endpoint/v1"],
+"id":"did:sov:other","service":[{"id":"#didcomm","type":"DIDCommMessaging","serviceEndpoint":"https://example.com"}]}}`,
+	}, nil)
+
+	out, err := NewHTTPIndyResolver(":0", "sov", &testProvider{client: client}).Read(testDID)
+	require.NoError(t, err)
+	require.Equal(t, testDID, out.DIDDocument["id"])
+	require.Len(t, out.DIDDocument["@context"], 2)
+	require.Len(t, out.DIDDocument["service"], 1)
+	client.AssertNotCalled(t, "GetEndpoint", "WgWxqztrNooG92RXvxSTWv")
+}
+
+func TestNegotiate(t *testing.T) {
+	require.Equal(t, ResolutionResult, negotiate(""))
+	require.Equal(t, ResolutionResult, negotiate("*/*"))
+	require.Equal(t, ResolutionResult, negotiate(`application/ld+json;profile="https://w3id.org/did-resolution"`))
+	require.Equal(t, DIDJSON, negotiate("text/html, application/did+json"))
+	require.Equal(t, "", negotiate("application/ld+json"))
+}
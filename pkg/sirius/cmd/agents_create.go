@@ -19,6 +19,7 @@ import (
 
 var schemaNames []string
 var publicDID bool
+var publicDIDMethod string
 
 var agentsCreateCmd = &cobra.Command{
 	Use:   "create AGENT_NAME",
@@ -31,6 +32,8 @@ func init() {
 	agentsCmd.AddCommand(agentsCreateCmd)
 	agentsCreateCmd.Flags().StringArrayVar(&schemaNames, "schema-name", []string{}, "list of schema this agent is allowed to issue")
 	agentsCreateCmd.Flags().BoolVar(&publicDID, "public-did", false, "assign a public DID to this agent if flag is set")
+	agentsCreateCmd.Flags().StringVar(&publicDIDMethod, "public-did-method", "", "DID method of the public DID, sov (default) or web")
+	agentsCreateCmd.Flags().StringVar(&ledger, "ledger", "", "did:indy namespace of the ledger to use, the default ledger if not set")
 }
 
 func agentsCreate(_ *cobra.Command, args []string) error {
@@ -47,6 +50,8 @@ func agentsCreate(_ *cobra.Command, args []string) error {
 			Name:                  agentName,
 			EndorsableSchemaNames: schemaNames,
 			PublicDid:             publicDID,
+			PublicDidMethod:       publicDIDMethod,
+			Ledger:                ledger,
 		},
 	}
 
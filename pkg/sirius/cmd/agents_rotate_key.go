@@ -0,0 +1,53 @@
+/*
+Copyright Scoir Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	api "github.com/scoir/canis/pkg/apiserver/api/protogen"
+)
+
+var gracePeriod time.Duration
+
+var agentsRotateKeyCmd = &cobra.Command{
+	Use:       "rotate-key AGENT_NAME",
+	Short:     "Replace the key of the specified agent's public DID.",
+	RunE:      agentsRotateKey,
+	Args:      cobra.ExactValidArgs(1),
+	ValidArgs: []string{"AGENT_NAME"},
+}
+
+func init() {
+	agentsCmd.AddCommand(agentsRotateKeyCmd)
+	agentsRotateKeyCmd.Flags().DurationVar(&gracePeriod, "grace-period", 0, "how long the replaced key can still unpack messages, 24h if not set")
+}
+
+func agentsRotateKey(_ *cobra.Command, args []string) error {
+	cli, err := ctx.GetAPIAdminClient()
+	if err != nil {
+		log.Fatalln("invalid server configuration", err)
+	}
+
+	req := &api.RotateAgentKeyRequest{
+		Id:          args[0],
+		GracePeriod: int64(gracePeriod / time.Second),
+	}
+	resp, err := cli.RotateAgentKey(context.Background(), req)
+	if err != nil {
+		return errors.Wrap(err, "unable to rotate agent key")
+	}
+
+	fmt.Println(resp.Did, resp.Verkey)
+	return nil
+}
@@ -0,0 +1,20 @@
+/*
+Copyright Scoir Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var auditCmd = &cobra.Command{
+	Use:   "audit",
+	Short: "Inspect the audit log of administrative operations for Canis instance",
+}
+
+func init() {
+	rootCmd.AddCommand(auditCmd)
+}
@@ -0,0 +1,125 @@
+/*
+Copyright Scoir Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	api "github.com/scoir/canis/pkg/apiserver/api/protogen"
+	"github.com/scoir/canis/pkg/audit"
+	"github.com/scoir/canis/pkg/datastore"
+)
+
+const auditPageSize = 100
+
+var auditFrom, auditTo, auditOutput string
+
+var auditExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export audit events as JSON lines and verify the hash chain.",
+	RunE:  auditExport,
+	Args:  cobra.ExactArgs(0),
+}
+
+func init() {
+	auditCmd.AddCommand(auditExportCmd)
+	auditExportCmd.Flags().StringVar(&auditFrom, "from", "", "export events at or after this time (RFC3339)")
+	auditExportCmd.Flags().StringVar(&auditTo, "to", "", "export events before this time (RFC3339)")
+	auditExportCmd.Flags().StringVar(&auditOutput, "output", "", "file to write events to (default is stdout)")
+}
+
+func auditExport(_ *cobra.Command, _ []string) error {
+	cli, err := ctx.GetAPIAdminClient()
+	if err != nil {
+		log.Fatalln("invalid server configuration", err)
+	}
+
+	ctx := context.Background()
+
+	req := &api.ListAuditEventsRequest{
+		PageSize: auditPageSize,
+	}
+
+	if auditFrom != "" {
+		t, err := time.Parse(time.RFC3339, auditFrom)
+		if err != nil {
+			return errors.Wrapf(err, "invalid from time %s", auditFrom)
+		}
+		req.StartTime = timestamppb.New(t)
+	}
+
+	if auditTo != "" {
+		t, err := time.Parse(time.RFC3339, auditTo)
+		if err != nil {
+			return errors.Wrapf(err, "invalid to time %s", auditTo)
+		}
+		req.EndTime = timestamppb.New(t)
+	}
+
+	var out io.Writer = os.Stdout
+	if auditOutput != "" {
+		f, err := os.Create(auditOutput)
+		if err != nil {
+			return errors.Wrapf(err, "unable to create %s", auditOutput)
+		}
+		defer f.Close()
+		out = f
+	}
+
+	enc := json.NewEncoder(out)
+	var events []*datastore.AuditEvent
+	for {
+		resp, err := cli.ListAuditEvents(ctx, req)
+		if err != nil {
+			return errors.Wrap(err, "unable to list audit events")
+		}
+
+		for _, e := range resp.Events {
+			event := &datastore.AuditEvent{
+				Sequence:   e.Sequence,
+				Timestamp:  e.Timestamp.AsTime(),
+				Caller:     e.Caller,
+				RPC:        e.Rpc,
+				AgentName:  e.AgentName,
+				ExternalID: e.ExternalId,
+				Outcome:    e.Outcome,
+				Error:      e.Error,
+				PrevHash:   e.PrevHash,
+				Hash:       e.Hash,
+			}
+
+			err = enc.Encode(event)
+			if err != nil {
+				return errors.Wrap(err, "unable to write audit event")
+			}
+			events = append(events, event)
+		}
+
+		req.Start += int64(len(resp.Events))
+		if len(resp.Events) == 0 || req.Start >= resp.Count {
+			break
+		}
+	}
+
+	err = audit.Verify(events)
+	if err != nil {
+		return errors.Wrap(err, "audit log failed verification")
+	}
+
+	fmt.Fprintf(os.Stderr, "EXPORTED %d AUDIT EVENTS, HASH CHAIN VERIFIED\n", len(events))
+	return nil
+}
@@ -0,0 +1,20 @@
+/*
+Copyright Scoir Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var endorsementsCmd = &cobra.Command{
+	Use:   "endorsements",
+	Short: "Manage ledger transactions waiting on an endorser for Canis instance",
+}
+
+func init() {
+	rootCmd.AddCommand(endorsementsCmd)
+}
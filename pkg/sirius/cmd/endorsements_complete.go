@@ -0,0 +1,83 @@
+/*
+Copyright Scoir Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"log"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	api "github.com/scoir/canis/pkg/apiserver/api/protogen"
+)
+
+var endorsedRequestFile string
+
+var endorsementsCompleteCmd = &cobra.Command{
+	Use:   "complete ID",
+	Short: "Mark an endorsement completed, submitting the endorsed transaction if one is given.",
+	Args:  cobra.ExactArgs(1),
+	RunE:  endorsementsComplete,
+}
+
+var endorsementsRejectCmd = &cobra.Command{
+	Use:   "reject ID",
+	Short: "Mark an endorsement rejected by the endorser.",
+	Args:  cobra.ExactArgs(1),
+	RunE:  endorsementsReject,
+}
+
+func init() {
+	endorsementsCmd.AddCommand(endorsementsCompleteCmd)
+	endorsementsCmd.AddCommand(endorsementsRejectCmd)
+	endorsementsCompleteCmd.Flags().StringVar(&endorsedRequestFile, "endorsed", "", "file containing the transaction signed by the endorser")
+}
+
+func endorsementsComplete(_ *cobra.Command, args []string) error {
+	cli, err := ctx.GetAPIAdminClient()
+	if err != nil {
+		log.Fatalln("invalid server configuration", err)
+	}
+
+	req := &api.CompleteEndorsementRequest{
+		Id: args[0],
+	}
+
+	if endorsedRequestFile != "" {
+		d, err := ioutil.ReadFile(endorsedRequestFile)
+		if err != nil {
+			return errors.Wrapf(err, "unable to read %s", endorsedRequestFile)
+		}
+		req.EndorsedRequest = string(d)
+	}
+
+	_, err = cli.CompleteEndorsement(context.Background(), req)
+	if err != nil {
+		return errors.Wrap(err, "unable to complete endorsement")
+	}
+
+	fmt.Printf("ENDORSEMENT %s COMPLETED\n", args[0])
+	return nil
+}
+
+func endorsementsReject(_ *cobra.Command, args []string) error {
+	cli, err := ctx.GetAPIAdminClient()
+	if err != nil {
+		log.Fatalln("invalid server configuration", err)
+	}
+
+	_, err = cli.RejectEndorsement(context.Background(), &api.RejectEndorsementRequest{Id: args[0]})
+	if err != nil {
+		return errors.Wrap(err, "unable to reject endorsement")
+	}
+
+	fmt.Printf("ENDORSEMENT %s REJECTED\n", args[0])
+	return nil
+}
@@ -0,0 +1,62 @@
+/*
+Copyright Scoir Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package cmd
+
+import (
+	"context"
+	"log"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	api "github.com/scoir/canis/pkg/apiserver/api/protogen"
+)
+
+var endorsementStatus string
+
+var endorsementsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List ledger transactions queued for endorsement.",
+	Args:  cobra.ExactArgs(0),
+	RunE:  endorsementsList,
+}
+
+func init() {
+	endorsementsCmd.AddCommand(endorsementsListCmd)
+	endorsementsListCmd.Flags().StringVar(&endorsementStatus, "status", "pending", "only list endorsements with this status (pending, completed, rejected)")
+}
+
+func endorsementsList(cmd *cobra.Command, _ []string) error {
+	cli, err := ctx.GetAPIAdminClient()
+	if err != nil {
+		log.Fatalln("invalid server configuration", err)
+	}
+
+	resp, err := cli.ListEndorsements(context.Background(), &api.ListEndorsementsRequest{
+		Status:   endorsementStatus,
+		PageSize: 100,
+	})
+	if err != nil {
+		return errors.Wrap(err, "unable to list endorsements")
+	}
+
+	tab := tabwriter.NewWriter(os.Stdout, 10, 4, 3, ' ', 0)
+	cmd.SetOut(tab)
+
+	cmd.Print(strings.Join([]string{"ID", "TXN TYPE", "AUTHOR", "ENDORSER", "STATUS", "CREATED"}, "\t"), "\n")
+	for _, e := range resp.Endorsements {
+		cmd.Print(strings.Join([]string{e.Id, e.TxnType, e.AuthorDid, e.EndorserDid, e.Status,
+			e.Created.AsTime().Format(time.RFC3339)}, "\t"), "\n")
+	}
+
+	err = tab.Flush()
+	return err
+}
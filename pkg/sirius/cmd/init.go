@@ -28,6 +28,7 @@ var serverInitCmd = &cobra.Command{
 func init() {
 	rootCmd.AddCommand(serverInitCmd)
 	serverInitCmd.Flags().StringVar(&seed, "seed", "", "seed for public DID")
+	serverInitCmd.Flags().StringVar(&ledger, "ledger", "", "did:indy namespace of the ledger to use, the default ledger if not set")
 }
 
 func initCluster(_ *cobra.Command, _ []string) {
@@ -40,7 +41,7 @@ func initCluster(_ *cobra.Command, _ []string) {
 		log.Fatalln("invalid server configuration", err)
 	}
 
-	_, err = cli.SeedPublicDID(context.Background(), &api.SeedPublicDIDRequest{Seed: seed})
+	_, err = cli.SeedPublicDID(context.Background(), &api.SeedPublicDIDRequest{Seed: seed, Ledger: ledger})
 	if err != nil {
 		log.Fatalln("error seeding public DID for API server", err)
 	}
@@ -0,0 +1,20 @@
+/*
+Copyright Scoir Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var ledgerCmd = &cobra.Command{
+	Use:   "ledger",
+	Short: "Manage the Indy ledger pools and public DIDs used by Canis instance",
+}
+
+func init() {
+	rootCmd.AddCommand(ledgerCmd)
+}
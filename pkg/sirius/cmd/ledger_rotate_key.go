@@ -0,0 +1,50 @@
+/*
+Copyright Scoir Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	api "github.com/scoir/canis/pkg/apiserver/api/protogen"
+)
+
+var ledgerRotateKeyCmd = &cobra.Command{
+	Use:   "rotate-key",
+	Short: "Replace the key of the Canis instance public DID on a ledger.",
+	RunE:  ledgerRotateKey,
+}
+
+func init() {
+	ledgerCmd.AddCommand(ledgerRotateKeyCmd)
+	ledgerRotateKeyCmd.Flags().StringVar(&ledger, "ledger", "", "did:indy namespace of the ledger, the default ledger if not set")
+	ledgerRotateKeyCmd.Flags().DurationVar(&gracePeriod, "grace-period", 0, "how long the replaced key can still unpack messages, 24h if not set")
+}
+
+func ledgerRotateKey(_ *cobra.Command, _ []string) error {
+	cli, err := ctx.GetAPIAdminClient()
+	if err != nil {
+		log.Fatalln("invalid server configuration", err)
+	}
+
+	req := &api.RotatePublicDIDKeyRequest{
+		Ledger:      ledger,
+		GracePeriod: int64(gracePeriod / time.Second),
+	}
+	resp, err := cli.RotatePublicDIDKey(context.Background(), req)
+	if err != nil {
+		return errors.Wrap(err, "unable to rotate public DID key")
+	}
+
+	fmt.Println(resp.Did, resp.Verkey)
+	return nil
+}
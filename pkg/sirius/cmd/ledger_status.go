@@ -0,0 +1,74 @@
+/*
+Copyright Scoir Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package cmd
+
+import (
+	"context"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	api "github.com/scoir/canis/pkg/apiserver/api/protogen"
+)
+
+var refreshPools bool
+
+var ledgerStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show the validator count, last refresh and last successful read and write of each ledger pool.",
+	Args:  cobra.ExactArgs(0),
+	RunE:  ledgerStatus,
+}
+
+func init() {
+	ledgerCmd.AddCommand(ledgerStatusCmd)
+	ledgerStatusCmd.Flags().BoolVar(&refreshPools, "refresh", false, "refresh the validator list of each connected pool first")
+}
+
+func ledgerStatus(cmd *cobra.Command, _ []string) error {
+	cli, err := ctx.GetAPIAdminClient()
+	if err != nil {
+		log.Fatalln("invalid server configuration", err)
+	}
+
+	resp, err := cli.GetLedgerStatus(context.Background(), &api.GetLedgerStatusRequest{Refresh: refreshPools})
+	if err != nil {
+		return errors.Wrap(err, "unable to get ledger status")
+	}
+
+	tab := tabwriter.NewWriter(os.Stdout, 10, 4, 3, ' ', 0)
+	cmd.SetOut(tab)
+
+	cmd.Print(strings.Join([]string{"LEDGER", "CONNECTED", "VALIDATORS", "LAST REFRESH", "LAST READ", "LAST WRITE", "ERROR"}, "\t"), "\n")
+	for _, l := range resp.Ledgers {
+		name := l.Ledger
+		if name == "" {
+			name = "(default)"
+		}
+
+		cmd.Print(strings.Join([]string{name, strconv.FormatBool(l.Connected), strconv.Itoa(int(l.Validators)),
+			formatTime(l.LastRefresh), formatTime(l.LastRead), formatTime(l.LastWrite), l.LastError}, "\t"), "\n")
+	}
+
+	err = tab.Flush()
+	return err
+}
+
+func formatTime(t *timestamppb.Timestamp) string {
+	if t == nil {
+		return "-"
+	}
+
+	return t.AsTime().Format(time.RFC3339)
+}
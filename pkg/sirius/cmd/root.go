@@ -26,6 +26,7 @@ var (
 	schemaName string
 	subject    string
 	attrValues []string
+	ledger     string
 )
 
 var rootCmd = &cobra.Command{
@@ -43,6 +43,8 @@ func init() {
 
 	schemaCreateCmd.Flags().StringVar(&schemaType, "type", "", "the schema type name")
 	_ = schemaCreateCmd.MarkFlagRequired("type")
+
+	schemaCreateCmd.Flags().StringVar(&ledger, "ledger", "", "did:indy namespace of the ledger to use, the default ledger if not set")
 }
 
 func schemaCreate(_ *cobra.Command, args []string) error {
@@ -79,6 +81,7 @@ func schemaCreate(_ *cobra.Command, args []string) error {
 			Format:     format,
 			Context:    schemaCtx,
 			Attributes: attrs,
+			Ledger:     ledger,
 		},
 	}
 
@@ -0,0 +1,52 @@
+/*
+Copyright Scoir Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	api "github.com/scoir/canis/pkg/apiserver/api/protogen"
+)
+
+var schemaImportCmd = &cobra.Command{
+	Use:   "import LEDGER_SCHEMA_ID",
+	Short: "Imports a schema already written to a ledger.",
+	RunE:  schemaImport,
+	Args:  cobra.ExactArgs(1),
+}
+
+func init() {
+	schemaCmd.AddCommand(schemaImportCmd)
+	schemaImportCmd.Flags().StringVar(&schemaName, "name", "", "name to store the schema under, the ledger schema name if not set")
+	schemaImportCmd.Flags().StringVar(&ledger, "ledger", "", "did:indy namespace of the ledger holding an unqualified schema ID, the default ledger if not set")
+}
+
+func schemaImport(_ *cobra.Command, args []string) error {
+	cli, err := ctx.GetAPIAdminClient()
+	if err != nil {
+		log.Fatalln("invalid server configuration", err)
+	}
+
+	req := &api.ImportSchemaRequest{
+		SchemaId: args[0],
+		Ledger:   ledger,
+		Name:     schemaName,
+	}
+
+	resp, err := cli.ImportSchema(context.Background(), req)
+	if err != nil {
+		return errors.Wrapf(err, "unable to import schema %s", args[0])
+	}
+
+	fmt.Printf("SCHEMA %s IMPORTED AS %s\n", args[0], resp.Id)
+	return nil
+}
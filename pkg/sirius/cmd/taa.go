@@ -0,0 +1,21 @@
+/*
+Copyright Scoir Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var taaCmd = &cobra.Command{
+	Use:   "taa",
+	Short: "Review and accept the ledger transaction author agreement for Canis instance",
+}
+
+func init() {
+	rootCmd.AddCommand(taaCmd)
+	taaCmd.PersistentFlags().StringVar(&ledger, "ledger", "", "did:indy namespace of the ledger to use, the default ledger if not set")
+}
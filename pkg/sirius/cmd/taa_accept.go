@@ -0,0 +1,48 @@
+/*
+Copyright Scoir Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	api "github.com/scoir/canis/pkg/apiserver/api/protogen"
+)
+
+var taaAcceptCmd = &cobra.Command{
+	Use:   "accept VERSION MECHANISM",
+	Short: "Accept a version of the ledger transaction author agreement using one of the allowed mechanisms.",
+	RunE:  taaAccept,
+	Args:  cobra.ExactArgs(2),
+}
+
+func init() {
+	taaCmd.AddCommand(taaAcceptCmd)
+}
+
+func taaAccept(_ *cobra.Command, args []string) error {
+	cli, err := ctx.GetAPIAdminClient()
+	if err != nil {
+		log.Fatalln("invalid server configuration", err)
+	}
+
+	resp, err := cli.AcceptTransactionAuthorAgreement(context.Background(), &api.AcceptTransactionAuthorAgreementRequest{
+		Version:   args[0],
+		Mechanism: args[1],
+		Ledger:    ledger,
+	})
+	if err != nil {
+		return errors.Wrap(err, "unable to accept transaction author agreement")
+	}
+
+	fmt.Printf("ACCEPTED TRANSACTION AUTHOR AGREEMENT %s (%s)\n", resp.Acceptance.Version, resp.Acceptance.Digest)
+	return nil
+}
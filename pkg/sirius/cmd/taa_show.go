@@ -0,0 +1,62 @@
+/*
+Copyright Scoir Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	api "github.com/scoir/canis/pkg/apiserver/api/protogen"
+)
+
+var taaShowCmd = &cobra.Command{
+	Use:   "show",
+	Short: "Show the ledger transaction author agreement, acceptance mechanisms and current acceptance.",
+	RunE:  taaShow,
+	Args:  cobra.ExactArgs(0),
+}
+
+func init() {
+	taaCmd.AddCommand(taaShowCmd)
+}
+
+func taaShow(_ *cobra.Command, _ []string) error {
+	cli, err := ctx.GetAPIAdminClient()
+	if err != nil {
+		log.Fatalln("invalid server configuration", err)
+	}
+
+	resp, err := cli.GetTransactionAuthorAgreement(context.Background(), &api.GetTransactionAuthorAgreementRequest{Ledger: ledger})
+	if err != nil {
+		return errors.Wrap(err, "unable to get transaction author agreement")
+	}
+
+	if resp.Agreement == nil {
+		fmt.Println("LEDGER DOES NOT REQUIRE A TRANSACTION AUTHOR AGREEMENT")
+		return nil
+	}
+
+	fmt.Printf("VERSION: %s\nDIGEST: %s\n\n%s\n\n", resp.Agreement.Version, resp.Agreement.Digest, resp.Agreement.Text)
+	fmt.Println("ACCEPTANCE MECHANISMS:")
+	for _, m := range resp.Mechanisms {
+		fmt.Printf("  %s: %s\n", m.Name, m.Description)
+	}
+
+	if resp.Acceptance == nil {
+		fmt.Println("\nNOT ACCEPTED")
+		return nil
+	}
+
+	fmt.Printf("\nACCEPTED: %s by %s on %s\n", resp.Acceptance.Mechanism, resp.Acceptance.AcceptedBy,
+		time.Unix(resp.Acceptance.Time, 0).UTC().Format("2006-01-02"))
+	return nil
+}
@@ -0,0 +1,54 @@
+/*
+Copyright Scoir Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package tracing
+
+import (
+	"context"
+
+	"github.com/streadway/amqp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// InjectAMQP writes the trace context of ctx into AMQP message headers
+func InjectAMQP(ctx context.Context, headers amqp.Table) {
+	otel.GetTextMapPropagator().Inject(ctx, tableCarrier(headers))
+}
+
+// ExtractAMQP returns ctx with the trace context carried in AMQP message headers
+func ExtractAMQP(ctx context.Context, headers amqp.Table) context.Context {
+	return otel.GetTextMapPropagator().Extract(ctx, tableCarrier(headers))
+}
+
+// StartConsumer starts a consumer span for a message received from queue, continuing the trace carried in its
+// headers.  The caller ends the span once the message is handled
+func StartConsumer(queue string, headers amqp.Table) (context.Context, trace.Span) {
+	return Start(ExtractAMQP(context.Background(), headers), queue+" process",
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(attribute.String("messaging.system", "rabbitmq"),
+			attribute.String("messaging.destination", queue)))
+}
+
+type tableCarrier amqp.Table
+
+func (r tableCarrier) Get(key string) string {
+	v, _ := r[key].(string)
+	return v
+}
+
+func (r tableCarrier) Set(key, value string) {
+	r[key] = value
+}
+
+func (r tableCarrier) Keys() []string {
+	out := make([]string, 0, len(r))
+	for k := range r {
+		out = append(out, k)
+	}
+	return out
+}
@@ -0,0 +1,100 @@
+/*
+Copyright Scoir Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package tracing
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// maxThreadContexts bounds how many DIDComm threads have their trace context remembered
+const maxThreadContexts = 1024
+
+var threads = newThreadContexts(maxThreadContexts)
+
+// SetThreadContext remembers the trace context of ctx for the DIDComm thread of message
+func SetThreadContext(ctx context.Context, message []byte) {
+	thid := threadID(message)
+	if thid == "" {
+		return
+	}
+
+	threads.set(thid, trace.SpanContextFromContext(ctx))
+}
+
+// ThreadContext returns a context continuing the trace of the last message received on the DIDComm thread
+// thid.  Aries calls protocol handlers without a context, they use this to continue the trace of the message
+func ThreadContext(thid string) context.Context {
+	sc, ok := threads.get(thid)
+	if !ok {
+		return context.Background()
+	}
+
+	return trace.ContextWithRemoteSpanContext(context.Background(), sc)
+}
+
+// threadID returns the thread ID of a DIDComm message, which is its own ID when it starts a thread
+func threadID(message []byte) string {
+	msg := &struct {
+		ID     string `json:"@id"`
+		Thread struct {
+			ID string `json:"thid"`
+		} `json:"~thread"`
+	}{}
+
+	if err := json.Unmarshal(message, msg); err != nil {
+		return ""
+	}
+
+	if msg.Thread.ID != "" {
+		return msg.Thread.ID
+	}
+
+	return msg.ID
+}
+
+// threadContexts is a bounded map of thread IDs to span contexts, the oldest thread is forgotten first
+type threadContexts struct {
+	lock  sync.Mutex
+	max   int
+	ctxs  map[string]trace.SpanContext
+	order []string
+}
+
+func newThreadContexts(max int) *threadContexts {
+	return &threadContexts{max: max, ctxs: map[string]trace.SpanContext{}}
+}
+
+func (r *threadContexts) set(thid string, sc trace.SpanContext) {
+	if !sc.IsValid() {
+		return
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if _, ok := r.ctxs[thid]; !ok {
+		if len(r.order) == r.max {
+			delete(r.ctxs, r.order[0])
+			r.order = r.order[1:]
+		}
+		r.order = append(r.order, thid)
+	}
+
+	r.ctxs[thid] = sc
+}
+
+func (r *threadContexts) get(thid string) (trace.SpanContext, bool) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	sc, ok := r.ctxs[thid]
+	return sc, ok
+}
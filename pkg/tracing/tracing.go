@@ -0,0 +1,105 @@
+/*
+Copyright Scoir Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package tracing
+
+import (
+	"context"
+	"log"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/scoir/canis/pkg/config"
+	"github.com/scoir/canis/pkg/framework"
+)
+
+const instrumentationName = "github.com/scoir/canis"
+
+// Init installs the global tracer provider for service and returns a function that flushes and
+// stops it.  Trace context is always propagated, spans are only exported when an exporter is configured
+func Init(service string, conf *framework.TracingConfig) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	if conf == nil || conf.Exporter == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	var exp sdktrace.SpanExporter
+	var err error
+	switch conf.Exporter {
+	case "otlp":
+		opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(conf.Endpoint)}
+		if conf.Insecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		exp, err = otlptracegrpc.New(context.Background(), opts...)
+	case "stdout":
+		exp, err = stdouttrace.New(stdouttrace.WithPrettyPrint())
+	default:
+		return nil, errors.Errorf("unknown tracing exporter %s", conf.Exporter)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to create %s trace exporter", conf.Exporter)
+	}
+
+	sampler := sdktrace.ParentBased(sdktrace.AlwaysSample())
+	if conf.SampleRatio > 0 {
+		sampler = sdktrace.ParentBased(sdktrace.TraceIDRatioBased(conf.SampleRatio))
+	}
+
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exp),
+		sdktrace.WithSampler(sampler),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceNameKey.String(service))),
+	)
+	otel.SetTracerProvider(tp)
+
+	return tp.Shutdown, nil
+}
+
+// Start starts a span from the global tracer provider
+func Start(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationName).Start(ctx, name, opts...)
+}
+
+// End records err on span, if there is one, and ends it
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// Setup initializes tracing for service from the tracing section of conf, exiting if it is invalid.  The
+// returned function flushes any buffered spans.
+func Setup(service string, conf config.Config) func() {
+	tc, err := conf.Tracing()
+	if err != nil {
+		log.Fatalln("invalid tracing key in configuration", err)
+	}
+
+	shutdown, err := Init(service, tc)
+	if err != nil {
+		log.Fatalln("unable to initialize tracing", err)
+	}
+
+	return func() {
+		err := shutdown(context.Background())
+		if err != nil {
+			log.Println("error shutting down tracing", err)
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/streadway/amqp"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/scoir/canis/pkg/framework"
+)
+
+func setup(t *testing.T) {
+	shutdown, err := Init("test", nil)
+	require.NoError(t, err)
+	require.NoError(t, shutdown(context.Background()))
+	otel.SetTracerProvider(sdktrace.NewTracerProvider())
+}
+
+func TestInit(t *testing.T) {
+	_, err := Init("test", &framework.TracingConfig{Exporter: "zipkin"})
+	require.Error(t, err)
+
+	shutdown, err := Init("test", &framework.TracingConfig{Exporter: "stdout"})
+	require.NoError(t, err)
+	require.NoError(t, shutdown(context.Background()))
+}
+
+func TestAMQPPropagation(t *testing.T) {
+	setup(t)
+
+	ctx, span := Start(context.Background(), "publish")
+	defer span.End()
+
+	headers := amqp.Table{}
+	InjectAMQP(ctx, headers)
+	require.Contains(t, headers, "traceparent")
+
+	out := ExtractAMQP(context.Background(), headers)
+	require.Equal(t, span.SpanContext().TraceID(), trace.SpanContextFromContext(out).TraceID())
+
+	out = ExtractAMQP(context.Background(), nil)
+	require.False(t, trace.SpanContextFromContext(out).IsValid())
+}
+
+func TestGRPCPropagation(t *testing.T) {
+	setup(t)
+
+	ctx, span := Start(context.Background(), "caller")
+	defer span.End()
+
+	var sent metadata.MD
+	invoker := func(ctx context.Context, _ string, _, _ interface{}, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+		sent, _ = metadata.FromOutgoingContext(ctx)
+		return nil
+	}
+
+	err := UnaryClientInterceptor()(ctx, "/test.Service/Call", nil, nil, nil, invoker)
+	require.NoError(t, err)
+	require.NotEmpty(t, sent.Get("traceparent"))
+
+	var got trace.SpanContext
+	handler := func(ctx context.Context, _ interface{}) (interface{}, error) {
+		got = trace.SpanContextFromContext(ctx)
+		return nil, nil
+	}
+
+	_, err = UnaryServerInterceptor()(metadata.NewIncomingContext(context.Background(), sent), nil,
+		&grpc.UnaryServerInfo{FullMethod: "/test.Service/Call"}, handler)
+	require.NoError(t, err)
+	require.Equal(t, span.SpanContext().TraceID(), got.TraceID())
+}
+
+func TestStartConsumer(t *testing.T) {
+	setup(t)
+
+	ctx, span := Start(context.Background(), "publish")
+	defer span.End()
+
+	headers := amqp.Table{}
+	InjectAMQP(ctx, headers)
+
+	out, consumer := StartConsumer("test-queue", headers)
+	defer consumer.End()
+	require.Equal(t, span.SpanContext().TraceID(), trace.SpanContextFromContext(out).TraceID())
+	require.NotEqual(t, span.SpanContext().SpanID(), trace.SpanContextFromContext(out).SpanID())
+}
+
+func TestThreadContext(t *testing.T) {
+	setup(t)
+
+	ctx, span := Start(context.Background(), "receive")
+	defer span.End()
+
+	SetThreadContext(ctx, []byte(`{"@id": "msg-1", "~thread": {"thid": "thread-1"}}`))
+	got := trace.SpanContextFromContext(ThreadContext("thread-1"))
+	require.Equal(t, span.SpanContext().TraceID(), got.TraceID())
+	require.True(t, got.IsRemote())
+
+	SetThreadContext(ctx, []byte(`{"@id": "thread-2"}`))
+	require.True(t, trace.SpanContextFromContext(ThreadContext("thread-2")).IsValid())
+
+	require.False(t, trace.SpanContextFromContext(ThreadContext("unknown")).IsValid())
+
+	SetThreadContext(context.Background(), []byte(`{"@id": "untraced"}`))
+	require.False(t, trace.SpanContextFromContext(ThreadContext("untraced")).IsValid())
+
+	t.Run("bounded", func(t *testing.T) {
+		target := newThreadContexts(2)
+		target.set("a", span.SpanContext())
+		target.set("b", span.SpanContext())
+		target.set("c", span.SpanContext())
+
+		_, ok := target.get("a")
+		require.False(t, ok)
+		_, ok = target.get("c")
+		require.True(t, ok)
+	})
+}
@@ -71,6 +71,36 @@ func (r *Prover) GetMasterSecret(masterSecretID string) (string, error) {
 	return string(ms), nil
 }
 
+// ImportMasterSecret stores a master secret exported from another wallet, failing if a different master secret
+// already exists with the same ID
+func (r *Prover) ImportMasterSecret(masterSecretID, masterSecret string) error {
+	existing, err := r.GetMasterSecret(masterSecretID)
+	if err == nil {
+		if existing != masterSecret {
+			return errors.Errorf("master secret %s already exists", masterSecretID)
+		}
+
+		return nil
+	}
+
+	err = r.store.Put(masterSecretID, []byte(masterSecret))
+	if err != nil {
+		return errors.Wrap(err, "unable to store imported master secret")
+	}
+
+	return nil
+}
+
+// DeleteMasterSecret removes a master secret, used to undo a failed import
+func (r *Prover) DeleteMasterSecret(masterSecretID string) error {
+	err := r.store.Delete(masterSecretID)
+	if err != nil && !errors.Is(err, storage.ErrDataNotFound) {
+		return errors.Wrap(err, "unable to delete master secret")
+	}
+
+	return nil
+}
+
 func (r *Prover) CreateCredentialRequest(proverDID string, credDef *vdr.ClaimDefData, offer *schema.IndyCredentialOffer,
 	masterSecret string) (*datastore.CredentialRequest, *datastore.CredentialRequestMetadata, error) {
 